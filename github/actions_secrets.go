@@ -7,9 +7,14 @@ package github
 
 import (
 	"context"
+	crypto_rand "crypto/rand"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
+
+	"golang.org/x/crypto/nacl/box"
 )
 
 // PublicKey represents the public key that should be used to encrypt secrets.
@@ -179,6 +184,35 @@ type EncryptedSecret struct {
 	SelectedRepositoryIDs SelectedRepoIDs `json:"selected_repository_ids,omitempty"`
 }
 
+// encryptSecret encrypts value with the given public key using a libsodium
+// compatible sealed box, returning an EncryptedSecret named name.
+func encryptSecret(publicKey *PublicKey, name, value string) (*EncryptedSecret, error) {
+	if publicKey == nil {
+		return nil, errors.New("public key must be provided")
+	}
+
+	decodedKey, err := base64.StdEncoding.DecodeString(publicKey.GetKey())
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode public key: %v", err)
+	}
+	if len(decodedKey) != 32 {
+		return nil, fmt.Errorf("public key has length %v, want 32", len(decodedKey))
+	}
+
+	var boxKey [32]byte
+	copy(boxKey[:], decodedKey)
+	encryptedBytes, err := box.SealAnonymous(nil, []byte(value), &boxKey, crypto_rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EncryptedSecret{
+		Name:           name,
+		KeyID:          publicKey.GetKeyID(),
+		EncryptedValue: base64.StdEncoding.EncodeToString(encryptedBytes),
+	}, nil
+}
+
 // CreateOrUpdateRepoSecret creates or updates a repository secret with an encrypted value.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#create-or-update-a-repository-secret
@@ -296,11 +330,17 @@ func (s *ActionsService) ListSelectedReposForOrgSecret(ctx context.Context, org,
 }
 
 // SetSelectedReposForOrgSecret sets the repositories that have access to a secret.
+// The given ids replace the current list; an empty list removes all repositories.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#set-selected-repositories-for-an-organization-secret
 func (s *ActionsService) SetSelectedReposForOrgSecret(ctx context.Context, org, name string, ids SelectedRepoIDs) (*Response, error) {
 	u := fmt.Sprintf("orgs/%v/actions/secrets/%v/repositories", org, name)
 
+	if ids == nil {
+		// GitHub expects an array, so send [] rather than null.
+		ids = SelectedRepoIDs{}
+	}
+
 	type repoIDs struct {
 		SelectedIDs SelectedRepoIDs `json:"selected_repository_ids"`
 	}
@@ -410,6 +450,32 @@ func (s *ActionsService) CreateOrUpdateEnvSecret(ctx context.Context, repoID int
 	return s.client.Do(ctx, req, nil)
 }
 
+// CreateOrUpdateEnvSecretByName creates or updates an environment secret of
+// the repository identified by owner and repo. It looks up the repository ID
+// and the environment's public key, and encrypts plaintext before sending it,
+// so the caller does not need to perform the encryption itself.
+//
+// GitHub API docs: https://docs.github.com/en/rest/reference/actions#create-or-update-an-environment-secret
+func (s *ActionsService) CreateOrUpdateEnvSecretByName(ctx context.Context, owner, repo, env, secretName, plaintext string) (*Response, error) {
+	r, resp, err := s.client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return resp, err
+	}
+	repoID := int(r.GetID())
+
+	publicKey, resp, err := s.GetEnvPublicKey(ctx, repoID, env)
+	if err != nil {
+		return resp, err
+	}
+
+	eSecret, err := encryptSecret(publicKey, secretName, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.CreateOrUpdateEnvSecret(ctx, repoID, env, eSecret)
+}
+
 // DeleteEnvSecret deletes a secret in an environment using the secret name.
 //
 // GitHub API docs: https://docs.github.com/en/rest/reference/actions#delete-an-environment-secret
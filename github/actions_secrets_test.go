@@ -7,6 +7,8 @@ package github
 
 import (
 	"context"
+	crypto_rand "crypto/rand"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -14,6 +16,7 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"golang.org/x/crypto/nacl/box"
 )
 
 func TestPublicKey_UnmarshalJSON(t *testing.T) {
@@ -525,6 +528,22 @@ func TestActionsService_SetSelectedReposForOrgSecret(t *testing.T) {
 	})
 }
 
+func TestActionsService_SetSelectedReposForOrgSecret_empty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/secrets/NAME/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"selected_repository_ids":[]}`+"\n")
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.SetSelectedReposForOrgSecret(ctx, "o", "NAME", nil)
+	if err != nil {
+		t.Errorf("Actions.SetSelectedReposForOrgSecret returned error: %v", err)
+	}
+}
+
 func TestActionsService_AddSelectedRepoToOrgSecret(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -786,6 +805,81 @@ func TestActionsService_CreateOrUpdateEnvSecret(t *testing.T) {
 	})
 }
 
+func TestActionsService_CreateOrUpdateEnvSecretByName(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	publicKey, privateKey, err := box.GenerateKey(crypto_rand.Reader)
+	if err != nil {
+		t.Fatalf("box.GenerateKey returned error: %v", err)
+	}
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+	mux.HandleFunc("/repositories/1/environments/e/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"key_id":"1234","key":%q}`, base64.StdEncoding.EncodeToString(publicKey[:]))
+	})
+	mux.HandleFunc("/repositories/1/environments/e/secrets/secret", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		v := new(EncryptedSecret)
+		json.NewDecoder(r.Body).Decode(v)
+
+		if got, want := v.KeyID, "1234"; got != want {
+			t.Errorf("Request key_id = %v, want %v", got, want)
+		}
+		encrypted, err := base64.StdEncoding.DecodeString(v.EncryptedValue)
+		if err != nil {
+			t.Fatalf("unable to decode encrypted_value: %v", err)
+		}
+		decrypted, ok := box.OpenAnonymous(nil, encrypted, publicKey, privateKey)
+		if !ok {
+			t.Fatal("unable to decrypt encrypted_value")
+		}
+		if got, want := string(decrypted), "s3cr3t"; got != want {
+			t.Errorf("Decrypted value = %v, want %v", got, want)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err = client.Actions.CreateOrUpdateEnvSecretByName(ctx, "o", "r", "e", "secret", "s3cr3t")
+	if err != nil {
+		t.Errorf("Actions.CreateOrUpdateEnvSecretByName returned error: %v", err)
+	}
+
+	const methodName = "CreateOrUpdateEnvSecretByName"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Actions.CreateOrUpdateEnvSecretByName(ctx, "\n", "\n", "\n", "secret", "s3cr3t")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Actions.CreateOrUpdateEnvSecretByName(ctx, "o", "r", "e", "secret", "s3cr3t")
+	})
+}
+
+func TestActionsService_CreateOrUpdateEnvSecretByName_invalidKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1}`)
+	})
+	mux.HandleFunc("/repositories/1/environments/e/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key_id":"1234","key":"c2hvcnQ="}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.CreateOrUpdateEnvSecretByName(ctx, "o", "r", "e", "secret", "s3cr3t")
+	if err == nil {
+		t.Error("Actions.CreateOrUpdateEnvSecretByName returned nil error, want error")
+	}
+}
+
 func TestActionsService_DeleteEnvSecret(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
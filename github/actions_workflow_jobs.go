@@ -7,11 +7,17 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 )
 
+// ErrWorkflowJobLogsExpired is returned by DownloadWorkflowJobLogs when GitHub
+// responds with 410 Gone because the logs of the job are no longer retained.
+var ErrWorkflowJobLogsExpired = errors.New("workflow job logs have expired")
+
 // TaskStep represents a single task step from a sequence of tasks of a job.
 type TaskStep struct {
 	Name        *string    `json:"name,omitempty"`
@@ -38,6 +44,14 @@ type WorkflowJob struct {
 	Name        *string     `json:"name,omitempty"`
 	Steps       []*TaskStep `json:"steps,omitempty"`
 	CheckRunURL *string     `json:"check_run_url,omitempty"`
+	// RunAttempt is the attempt number of the workflow run this job belongs to.
+	RunAttempt *int64 `json:"run_attempt,omitempty"`
+	// RunnerID, RunnerName, RunnerGroupID and RunnerGroupName describe the
+	// runner the job ran on. They are nil if the job has not been picked up yet.
+	RunnerID        *int64  `json:"runner_id,omitempty"`
+	RunnerName      *string `json:"runner_name,omitempty"`
+	RunnerGroupID   *int64  `json:"runner_group_id,omitempty"`
+	RunnerGroupName *string `json:"runner_group_name,omitempty"`
 }
 
 // Jobs represents a slice of repository action workflow job.
@@ -82,6 +96,30 @@ func (s *ActionsService) ListWorkflowJobs(ctx context.Context, owner, repo strin
 	return jobs, resp, nil
 }
 
+// ListWorkflowJobsAttempt lists the jobs of a specific attempt of a workflow run.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#list-jobs-for-a-workflow-run-attempt
+func (s *ActionsService) ListWorkflowJobsAttempt(ctx context.Context, owner, repo string, runID, attemptNumber int64, opts *ListOptions) (*Jobs, *Response, error) {
+	u := fmt.Sprintf("repos/%s/%s/actions/runs/%v/attempts/%v/jobs", owner, repo, runID, attemptNumber)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	jobs := new(Jobs)
+	resp, err := s.client.Do(ctx, req, jobs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return jobs, resp, nil
+}
+
 // GetWorkflowJobByID gets a specific job in a workflow run by ID.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-a-job-for-a-workflow-run
@@ -120,6 +158,45 @@ func (s *ActionsService) GetWorkflowJobLogs(ctx context.Context, owner, repo str
 	return parsedURL, newResponse(resp), err
 }
 
+// DownloadWorkflowJobLogs writes the plain text logs of a workflow job to w.
+// It follows the redirect returned by GetWorkflowJobLogs and fetches the logs
+// without the client's transport, so the client's credentials are not sent to
+// the storage backend. If the logs have expired, ErrWorkflowJobLogsExpired is
+// returned.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#download-job-logs-for-a-workflow-run
+func (s *ActionsService) DownloadWorkflowJobLogs(ctx context.Context, owner, repo string, jobID int64, w io.Writer) (*Response, error) {
+	logsURL, resp, err := s.GetWorkflowJobLogs(ctx, owner, repo, jobID, true)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusGone {
+			err = ErrWorkflowJobLogsExpired
+		}
+		return resp, err
+	}
+
+	req, err := http.NewRequest("GET", logsURL.String(), nil)
+	if err != nil {
+		return resp, err
+	}
+	req = withContext(ctx, req)
+
+	logsResp, err := redirectClient().Do(req)
+	if err != nil {
+		return resp, err
+	}
+	defer logsResp.Body.Close()
+
+	if logsResp.StatusCode == http.StatusGone {
+		return resp, ErrWorkflowJobLogsExpired
+	}
+	if err := CheckResponse(logsResp); err != nil {
+		return resp, err
+	}
+
+	_, err = io.Copy(w, logsResp.Body)
+	return resp, err
+}
+
 func (s *ActionsService) getWorkflowLogsFromURL(ctx context.Context, u string, followRedirects bool) (*http.Response, error) {
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -89,6 +90,55 @@ func TestActionsService_ListWorkflowJobs_Filter(t *testing.T) {
 	}
 }
 
+func TestActionsService_ListWorkflowJobsAttempt(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/29679449/attempts/2/jobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":1,"jobs":[{"id":399444496,"run_id":29679449,"run_attempt":2,"runner_name":"runner-1","runner_group_name":"default","steps":[{"name":"build","number":1}]}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	ctx := context.Background()
+	jobs, _, err := client.Actions.ListWorkflowJobsAttempt(ctx, "o", "r", 29679449, 2, opts)
+	if err != nil {
+		t.Errorf("Actions.ListWorkflowJobsAttempt returned error: %v", err)
+	}
+
+	want := &Jobs{
+		TotalCount: Int(1),
+		Jobs: []*WorkflowJob{
+			{
+				ID:              Int64(399444496),
+				RunID:           Int64(29679449),
+				RunAttempt:      Int64(2),
+				RunnerName:      String("runner-1"),
+				RunnerGroupName: String("default"),
+				Steps:           []*TaskStep{{Name: String("build"), Number: Int64(1)}},
+			},
+		},
+	}
+	if !cmp.Equal(jobs, want) {
+		t.Errorf("Actions.ListWorkflowJobsAttempt returned %+v, want %+v", jobs, want)
+	}
+
+	const methodName = "ListWorkflowJobsAttempt"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.ListWorkflowJobsAttempt(ctx, "\n", "\n", 29679449, 2, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Actions.ListWorkflowJobsAttempt(ctx, "o", "r", 29679449, 2, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestActionsService_GetWorkflowJobByID(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -166,6 +216,64 @@ func TestActionsService_GetWorkflowJobLogs(t *testing.T) {
 	})
 }
 
+func TestActionsService_DownloadWorkflowJobLogs(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+	client.client.Transport = authTransport()
+
+	mux.HandleFunc("/repos/o/r/actions/jobs/399444496/logs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, serverURL+baseURLPath+"/logs/399444496.txt", http.StatusFound)
+	})
+	mux.HandleFunc("/logs/399444496.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testNoAuthorization(t, r)
+		fmt.Fprint(w, "2021-01-02T15:04:05Z step 1\n2021-01-02T15:04:06Z step 2\n")
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	resp, err := client.Actions.DownloadWorkflowJobLogs(ctx, "o", "r", 399444496, &buf)
+	if err != nil {
+		t.Errorf("Actions.DownloadWorkflowJobLogs returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusFound {
+		t.Errorf("Actions.DownloadWorkflowJobLogs returned status: %d, want %d", resp.StatusCode, http.StatusFound)
+	}
+	if got, want := buf.String(), "2021-01-02T15:04:05Z step 1\n2021-01-02T15:04:06Z step 2\n"; got != want {
+		t.Errorf("Actions.DownloadWorkflowJobLogs wrote %q, want %q", got, want)
+	}
+
+	const methodName = "DownloadWorkflowJobLogs"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Actions.DownloadWorkflowJobLogs(ctx, "\n", "\n", 399444496, &buf)
+		return err
+	})
+}
+
+func TestActionsService_DownloadWorkflowJobLogs_expired(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/jobs/399444496/logs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusGone)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	resp, err := client.Actions.DownloadWorkflowJobLogs(ctx, "o", "r", 399444496, &buf)
+	if err != ErrWorkflowJobLogsExpired {
+		t.Errorf("Actions.DownloadWorkflowJobLogs returned error %v, want %v", err, ErrWorkflowJobLogsExpired)
+	}
+	if resp.StatusCode != http.StatusGone {
+		t.Errorf("Actions.DownloadWorkflowJobLogs returned status: %d, want %d", resp.StatusCode, http.StatusGone)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Actions.DownloadWorkflowJobLogs wrote %q, want nothing", buf.String())
+	}
+}
+
 func TestActionsService_GetWorkflowJobLogs_StatusMovedPermanently_dontFollowRedirects(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -264,7 +372,12 @@ func TestWorkflowJob_Marshal(t *testing.T) {
 				CompletedAt: &Timestamp{referenceTime},
 			},
 		},
-		CheckRunURL: String("c"),
+		CheckRunURL:     String("c"),
+		RunAttempt:      Int64(2),
+		RunnerID:        Int64(3),
+		RunnerName:      String("rn"),
+		RunnerGroupID:   Int64(4),
+		RunnerGroupName: String("rgn"),
 	}
 
 	want := `{
@@ -288,7 +401,12 @@ func TestWorkflowJob_Marshal(t *testing.T) {
 			"started_at": ` + referenceTimeStr + `,
 			"completed_at": ` + referenceTimeStr + `
 		}],
-		"check_run_url": "c"
+		"check_run_url": "c",
+		"run_attempt": 2,
+		"runner_id": 3,
+		"runner_name": "rn",
+		"runner_group_id": 4,
+		"runner_group_name": "rgn"
 	}`
 
 	testJSONMarshal(t, u, want)
@@ -53,6 +53,21 @@ type ListWorkflowRunsOptions struct {
 	Branch string `url:"branch,omitempty"`
 	Event  string `url:"event,omitempty"`
 	Status string `url:"status,omitempty"`
+
+	// Created filters workflow runs by creation date, using GitHub's search
+	// date syntax, e.g. ">=2021-01-01" or "2021-01-01..2021-01-31".
+	Created string `url:"created,omitempty"`
+
+	// HeadSHA filters workflow runs by the SHA of the head commit.
+	HeadSHA string `url:"head_sha,omitempty"`
+
+	// CheckSuiteID filters workflow runs by the ID of their check suite.
+	CheckSuiteID int64 `url:"check_suite_id,omitempty"`
+
+	// ExcludePullRequests omits the pull_requests field from the returned
+	// workflow runs when set to true.
+	ExcludePullRequests bool `url:"exclude_pull_requests,omitempty"`
+
 	ListOptions
 }
 
@@ -234,3 +249,111 @@ func (s *ActionsService) GetWorkflowRunUsageByID(ctx context.Context, owner, rep
 
 	return workflowRunUsage, resp, nil
 }
+
+// PendingDeploymentEnvironment represents the environment of a pending
+// deployment.
+type PendingDeploymentEnvironment struct {
+	ID      *int64  `json:"id,omitempty"`
+	NodeID  *string `json:"node_id,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	URL     *string `json:"url,omitempty"`
+	HTMLURL *string `json:"html_url,omitempty"`
+}
+
+// PendingDeployment represents a deployment of a workflow run that is waiting
+// for the protection rules of its environment to pass.
+type PendingDeployment struct {
+	Environment           *PendingDeploymentEnvironment `json:"environment,omitempty"`
+	WaitTimer             *int64                        `json:"wait_timer,omitempty"`
+	WaitTimerStartedAt    *Timestamp                    `json:"wait_timer_started_at,omitempty"`
+	CurrentUserCanApprove *bool                         `json:"current_user_can_approve,omitempty"`
+	Reviewers             []*RequiredReviewer           `json:"reviewers,omitempty"`
+}
+
+// pendingDeploymentsRequest is used internally by PendingDeployments to
+// review the pending deployments of a workflow run.
+type pendingDeploymentsRequest struct {
+	EnvironmentIDs []int64 `json:"environment_ids"`
+	State          string  `json:"state"`
+	Comment        string  `json:"comment"`
+}
+
+// EnvironmentApprovals represents a review of the pending deployments of a
+// workflow run.
+type EnvironmentApprovals struct {
+	Environments []*Environment `json:"environments,omitempty"`
+	// Possible values for State are: approved, rejected.
+	State   *string `json:"state,omitempty"`
+	User    *User   `json:"user,omitempty"`
+	Comment *string `json:"comment,omitempty"`
+}
+
+// GetPendingDeployments gets the deployments of a workflow run that are
+// waiting for the protection rules of their environments to pass.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions#get-pending-deployments-for-a-workflow-run
+func (s *ActionsService) GetPendingDeployments(ctx context.Context, owner, repo string, runID int64) ([]*PendingDeployment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/pending_deployments", owner, repo, runID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var deployments []*PendingDeployment
+	resp, err := s.client.Do(ctx, req, &deployments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return deployments, resp, nil
+}
+
+// PendingDeployments approves or rejects the pending deployments of a
+// workflow run to the environments with the given IDs. The state must be
+// either "approved" or "rejected". Required reviewers with read access to
+// the repository contents and deployments can use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions#review-pending-deployments-for-a-workflow-run
+func (s *ActionsService) PendingDeployments(ctx context.Context, owner, repo string, runID int64, envIDs []int64, state, comment string) ([]*Deployment, *Response, error) {
+	if state != "approved" && state != "rejected" {
+		return nil, nil, fmt.Errorf("invalid pending deployment state %q", state)
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/pending_deployments", owner, repo, runID)
+
+	body := &pendingDeploymentsRequest{EnvironmentIDs: envIDs, State: state, Comment: comment}
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var deployments []*Deployment
+	resp, err := s.client.Do(ctx, req, &deployments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return deployments, resp, nil
+}
+
+// GetWorkflowRunApprovals gets the reviews of the pending deployments of a
+// workflow run.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions#get-the-review-history-for-a-workflow-run
+func (s *ActionsService) GetWorkflowRunApprovals(ctx context.Context, owner, repo string, runID int64) ([]*EnvironmentApprovals, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/approvals", owner, repo, runID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var approvals []*EnvironmentApprovals
+	resp, err := s.client.Do(ctx, req, &approvals)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return approvals, resp, nil
+}
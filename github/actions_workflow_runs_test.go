@@ -335,6 +335,67 @@ func TestActionService_ListRepositoryWorkflowRuns(t *testing.T) {
 	})
 }
 
+func TestActionService_ListRepositoryWorkflowRuns_filters(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"created":               "2021-01-01..2021-01-31",
+			"head_sha":              "acb5820ced9479c074f688cc328bf03f341a511d",
+			"check_suite_id":        "42",
+			"exclude_pull_requests": "true",
+		})
+		if got, want := r.URL.RawQuery, "check_suite_id=42&created=2021-01-01..2021-01-31&exclude_pull_requests=true&head_sha=acb5820ced9479c074f688cc328bf03f341a511d"; got != want {
+			t.Errorf("Request query = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{"total_count":1,"workflow_runs":[{"id":298499444,"head_sha":"acb5820ced9479c074f688cc328bf03f341a511d"}]}`)
+	})
+
+	opts := &ListWorkflowRunsOptions{
+		Created:             "2021-01-01..2021-01-31",
+		HeadSHA:             "acb5820ced9479c074f688cc328bf03f341a511d",
+		CheckSuiteID:        42,
+		ExcludePullRequests: true,
+	}
+	ctx := context.Background()
+	runs, _, err := client.Actions.ListRepositoryWorkflowRuns(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Actions.ListRepositoryWorkflowRuns returned error: %v", err)
+	}
+
+	expected := &WorkflowRuns{
+		TotalCount: Int(1),
+		WorkflowRuns: []*WorkflowRun{
+			{ID: Int64(298499444), HeadSHA: String("acb5820ced9479c074f688cc328bf03f341a511d")},
+		},
+	}
+	if !cmp.Equal(runs, expected) {
+		t.Errorf("Actions.ListRepositoryWorkflowRuns returned %+v, want %+v", runs, expected)
+	}
+}
+
+func TestActionService_ListRepositoryWorkflowRuns_createdRange(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"created": ">=2021-01-01"})
+		if got, want := r.URL.RawQuery, "created=%3E%3D2021-01-01"; got != want {
+			t.Errorf("Request query = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{"total_count":0,"workflow_runs":[]}`)
+	})
+
+	opts := &ListWorkflowRunsOptions{Created: ">=2021-01-01"}
+	ctx := context.Background()
+	if _, _, err := client.Actions.ListRepositoryWorkflowRuns(ctx, "o", "r", opts); err != nil {
+		t.Errorf("Actions.ListRepositoryWorkflowRuns returned error: %v", err)
+	}
+}
+
 func TestActionService_DeleteWorkflowRunLogs(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -413,6 +474,167 @@ func TestActionsService_GetWorkflowRunUsageByID(t *testing.T) {
 	})
 }
 
+func TestActionsService_GetPendingDeployments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/399444496/pending_deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{
+			"environment": {"id": 1, "node_id": "nid", "name": "production", "url": "u", "html_url": "h"},
+			"wait_timer": 30,
+			"wait_timer_started_at": "2020-11-23T22:00:40Z",
+			"current_user_can_approve": true,
+			"reviewers": [{"type": "User", "reviewer": {"login": "octocat", "id": 1}}, {"type": "Team", "reviewer": {"name": "justice-league", "id": 2}}]
+		}]`)
+	})
+
+	ctx := context.Background()
+	deployments, _, err := client.Actions.GetPendingDeployments(ctx, "o", "r", 399444496)
+	if err != nil {
+		t.Errorf("Actions.GetPendingDeployments returned error: %v", err)
+	}
+
+	want := []*PendingDeployment{{
+		Environment: &PendingDeploymentEnvironment{
+			ID:      Int64(1),
+			NodeID:  String("nid"),
+			Name:    String("production"),
+			URL:     String("u"),
+			HTMLURL: String("h"),
+		},
+		WaitTimer:             Int64(30),
+		WaitTimerStartedAt:    &Timestamp{time.Date(2020, time.November, 23, 22, 00, 40, 0, time.UTC)},
+		CurrentUserCanApprove: Bool(true),
+		Reviewers: []*RequiredReviewer{
+			{Type: String("User"), Reviewer: &User{Login: String("octocat"), ID: Int64(1)}},
+			{Type: String("Team"), Reviewer: &Team{Name: String("justice-league"), ID: Int64(2)}},
+		},
+	}}
+	if !cmp.Equal(deployments, want) {
+		t.Errorf("Actions.GetPendingDeployments returned %+v, want %+v", deployments, want)
+	}
+
+	const methodName = "GetPendingDeployments"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.GetPendingDeployments(ctx, "\n", "\n", 399444496)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Actions.GetPendingDeployments(ctx, "o", "r", 399444496)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActionsService_PendingDeployments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/399444496/pending_deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"environment_ids":[1,2],"state":"approved","comment":"Ship it!"}`+"\n")
+		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+	})
+
+	ctx := context.Background()
+	deployments, _, err := client.Actions.PendingDeployments(ctx, "o", "r", 399444496, []int64{1, 2}, "approved", "Ship it!")
+	if err != nil {
+		t.Errorf("Actions.PendingDeployments returned error: %v", err)
+	}
+
+	want := []*Deployment{{ID: Int64(1)}, {ID: Int64(2)}}
+	if !cmp.Equal(deployments, want) {
+		t.Errorf("Actions.PendingDeployments returned %+v, want %+v", deployments, want)
+	}
+
+	const methodName = "PendingDeployments"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.PendingDeployments(ctx, "\n", "\n", 399444496, []int64{1, 2}, "approved", "Ship it!")
+		return err
+	})
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.PendingDeployments(ctx, "o", "r", 399444496, []int64{1, 2}, "approve", "Ship it!")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Actions.PendingDeployments(ctx, "o", "r", 399444496, []int64{1, 2}, "approved", "Ship it!")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActionsService_GetWorkflowRunApprovals(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/399444496/approvals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{
+			"environments": [{"id": 1, "name": "production"}],
+			"state": "approved",
+			"user": {"login": "octocat"},
+			"comment": "Ship it!"
+		}]`)
+	})
+
+	ctx := context.Background()
+	approvals, _, err := client.Actions.GetWorkflowRunApprovals(ctx, "o", "r", 399444496)
+	if err != nil {
+		t.Errorf("Actions.GetWorkflowRunApprovals returned error: %v", err)
+	}
+
+	want := []*EnvironmentApprovals{{
+		Environments: []*Environment{{ID: Int64(1), Name: String("production")}},
+		State:        String("approved"),
+		User:         &User{Login: String("octocat")},
+		Comment:      String("Ship it!"),
+	}}
+	if !cmp.Equal(approvals, want) {
+		t.Errorf("Actions.GetWorkflowRunApprovals returned %+v, want %+v", approvals, want)
+	}
+
+	const methodName = "GetWorkflowRunApprovals"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.GetWorkflowRunApprovals(ctx, "\n", "\n", 399444496)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Actions.GetWorkflowRunApprovals(ctx, "o", "r", 399444496)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPendingDeployment_Marshal(t *testing.T) {
+	testJSONMarshal(t, &PendingDeployment{}, "{}")
+
+	u := &PendingDeployment{
+		Environment:           &PendingDeploymentEnvironment{ID: Int64(1), Name: String("production")},
+		WaitTimer:             Int64(30),
+		WaitTimerStartedAt:    &Timestamp{referenceTime},
+		CurrentUserCanApprove: Bool(false),
+	}
+
+	want := `{
+		"environment": {"id": 1, "name": "production"},
+		"wait_timer": 30,
+		"wait_timer_started_at": ` + referenceTimeStr + `,
+		"current_user_can_approve": false
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
 func TestWorkflowRun_Marshal(t *testing.T) {
 	testJSONMarshal(t, &WorkflowRun{}, "{}")
 
@@ -12,10 +12,11 @@ import (
 
 // Workflow represents a repository action workflow.
 type Workflow struct {
-	ID        *int64     `json:"id,omitempty"`
-	NodeID    *string    `json:"node_id,omitempty"`
-	Name      *string    `json:"name,omitempty"`
-	Path      *string    `json:"path,omitempty"`
+	ID     *int64  `json:"id,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	Path   *string `json:"path,omitempty"`
+	// State is one of the WorkflowState values.
 	State     *string    `json:"state,omitempty"`
 	CreatedAt *Timestamp `json:"created_at,omitempty"`
 	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
@@ -24,6 +25,29 @@ type Workflow struct {
 	BadgeURL  *string    `json:"badge_url,omitempty"`
 }
 
+// WorkflowState represents the state of a workflow.
+type WorkflowState string
+
+// This is the set of states a workflow can have.
+const (
+	WorkflowStateActive             WorkflowState = "active"
+	WorkflowStateDeleted            WorkflowState = "deleted"
+	WorkflowStateDisabledFork       WorkflowState = "disabled_fork"
+	WorkflowStateDisabledInactivity WorkflowState = "disabled_inactivity"
+	WorkflowStateDisabledManually   WorkflowState = "disabled_manually"
+)
+
+// IsValid reports whether s is one of the workflow states known to the
+// GitHub API.
+func (s WorkflowState) IsValid() bool {
+	switch s {
+	case WorkflowStateActive, WorkflowStateDeleted, WorkflowStateDisabledFork,
+		WorkflowStateDisabledInactivity, WorkflowStateDisabledManually:
+		return true
+	}
+	return false
+}
+
 // Workflows represents a slice of repository action workflows.
 type Workflows struct {
 	TotalCount *int        `json:"total_count,omitempty"`
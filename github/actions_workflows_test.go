@@ -65,7 +65,7 @@ func TestActionsService_GetWorkflowByID(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/actions/workflows/72844", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		fmt.Fprint(w, `{"id":72844,"created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}`)
+		fmt.Fprint(w, `{"id":72844,"state":"disabled_manually","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}`)
 	})
 
 	ctx := context.Background()
@@ -76,12 +76,16 @@ func TestActionsService_GetWorkflowByID(t *testing.T) {
 
 	want := &Workflow{
 		ID:        Int64(72844),
+		State:     String("disabled_manually"),
 		CreatedAt: &Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)},
 		UpdatedAt: &Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)},
 	}
 	if !cmp.Equal(workflow, want) {
 		t.Errorf("Actions.GetWorkflowByID returned %+v, want %+v", workflow, want)
 	}
+	if got, want := WorkflowState(workflow.GetState()), WorkflowStateDisabledManually; got != want {
+		t.Errorf("Actions.GetWorkflowByID returned state %q, want %q", got, want)
+	}
 
 	const methodName = "GetWorkflowByID"
 	testBadOptions(t, methodName, func() (err error) {
@@ -629,3 +633,20 @@ func TestCreateWorkflowDispatchEventRequest_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestWorkflowState_IsValid(t *testing.T) {
+	for _, s := range []WorkflowState{
+		WorkflowStateActive,
+		WorkflowStateDeleted,
+		WorkflowStateDisabledFork,
+		WorkflowStateDisabledInactivity,
+		WorkflowStateDisabledManually,
+	} {
+		if !s.IsValid() {
+			t.Errorf("WorkflowState(%q).IsValid() = false, want true", s)
+		}
+	}
+	if WorkflowState("enabled").IsValid() {
+		t.Error(`WorkflowState("enabled").IsValid() = true, want false`)
+	}
+}
@@ -447,6 +447,31 @@ func TestActivityService_EventParsePayload_untyped(t *testing.T) {
 
 	want := map[string]interface{}{"field": "val"}
 	got, err := event.ParsePayload()
+	if typeErr, ok := err.(*UnknownEventTypeError); !ok || typeErr.Type != "UnrecognizedEvent" {
+		t.Fatalf("ParsePayload returned error %v, want *UnknownEventTypeError for UnrecognizedEvent", err)
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Event.ParsePayload returned %+v, want %+v", got, want)
+	}
+
+	// The deprecated Payload doesn't panic for unknown event types.
+	if got := event.Payload(); !cmp.Equal(got, want) {
+		t.Errorf("Event.Payload returned %+v, want %+v", got, want)
+	}
+}
+
+func TestActivityService_EventParsePayload_workflowRun(t *testing.T) {
+	raw := []byte(`{"type": "WorkflowRunEvent","payload":{"action":"completed","workflow_run":{"id":1,"conclusion":"success"}}}`)
+	var event *Event
+	if err := json.Unmarshal(raw, &event); err != nil {
+		t.Fatalf("Unmarshal Event returned error: %v", err)
+	}
+
+	want := &WorkflowRunEvent{
+		Action:      String("completed"),
+		WorkflowRun: &WorkflowRun{ID: Int64(1), Conclusion: String("success")},
+	}
+	got, err := event.ParsePayload()
 	if err != nil {
 		t.Fatalf("ParsePayload returned unexpected error: %v", err)
 	}
@@ -455,6 +480,36 @@ func TestActivityService_EventParsePayload_untyped(t *testing.T) {
 	}
 }
 
+func TestActivityService_EventParsePayload_workflowJobAndDiscussion(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want interface{}
+	}{
+		{
+			raw:  `{"type": "WorkflowJobEvent","payload":{"action":"queued","workflow_job":{"id":1}}}`,
+			want: &WorkflowJobEvent{Action: String("queued"), WorkflowJob: &WorkflowJob{ID: Int64(1)}},
+		},
+		{
+			raw:  `{"type": "DiscussionEvent","payload":{"action":"created","discussion":{"number":1,"category":{"name":"Q&A"}}}}`,
+			want: &DiscussionEvent{Action: String("created"), Discussion: &Discussion{Number: Int(1), DiscussionCategory: &DiscussionCategory{Name: String("Q&A")}}},
+		},
+	}
+
+	for _, tt := range tests {
+		var event *Event
+		if err := json.Unmarshal([]byte(tt.raw), &event); err != nil {
+			t.Fatalf("Unmarshal Event returned error: %v", err)
+		}
+		got, err := event.ParsePayload()
+		if err != nil {
+			t.Fatalf("ParsePayload returned unexpected error: %v", err)
+		}
+		if !cmp.Equal(got, tt.want) {
+			t.Errorf("Event.ParsePayload returned %+v, want %+v", got, tt.want)
+		}
+	}
+}
+
 func TestActivityService_EventParsePayload_installation(t *testing.T) {
 	raw := []byte(`{"type": "PullRequestEvent","payload":{"installation":{"id":1}}}`)
 	var event *Event
@@ -38,17 +38,27 @@ type NotificationSubject struct {
 
 // NotificationListOptions specifies the optional parameters to the
 // ActivityService.ListNotifications method.
+//
+// ETag, if set, is sent in an If-None-Match header, typically holding the
+// Response.ETag of the previous call. If the notifications have not changed
+// since, GitHub responds with 304 Not Modified, which is returned as an
+// *ErrorResponse, and the request does not count against the rate limit.
 type NotificationListOptions struct {
 	All           bool      `url:"all,omitempty"`
 	Participating bool      `url:"participating,omitempty"`
 	Since         time.Time `url:"since,omitempty"`
 	Before        time.Time `url:"before,omitempty"`
+	ETag          string    `url:"-"`
 
 	ListOptions
 }
 
 // ListNotifications lists all notifications for the authenticated user.
 //
+// Clients polling for notifications should wait for the returned
+// Response.PollInterval between calls, and pass the returned Response.ETag
+// as opts.ETag to make each call conditional.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#list-notifications-for-the-authenticated-user
 func (s *ActivityService) ListNotifications(ctx context.Context, opts *NotificationListOptions) ([]*Notification, *Response, error) {
 	u := "notifications"
@@ -61,6 +71,9 @@ func (s *ActivityService) ListNotifications(ctx context.Context, opts *Notificat
 	if err != nil {
 		return nil, nil, err
 	}
+	if opts != nil && opts.ETag != "" {
+		req.Header.Set("If-None-Match", opts.ETag)
+	}
 
 	var notifications []*Notification
 	resp, err := s.client.Do(ctx, req, &notifications)
@@ -86,6 +99,9 @@ func (s *ActivityService) ListRepositoryNotifications(ctx context.Context, owner
 	if err != nil {
 		return nil, nil, err
 	}
+	if opts != nil && opts.ETag != "" {
+		req.Header.Set("If-None-Match", opts.ETag)
+	}
 
 	var notifications []*Notification
 	resp, err := s.client.Do(ctx, req, &notifications)
@@ -59,6 +59,48 @@ func TestActivityService_ListNotification(t *testing.T) {
 	})
 }
 
+func TestActivityService_ListNotification_conditional(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set("X-Poll-Interval", "60")
+		w.Header().Set("ETag", `"abc"`)
+		if r.Header.Get("If-None-Match") == `"abc"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprint(w, `[{"id":"1"}]`)
+	})
+
+	ctx := context.Background()
+	notifications, resp, err := client.Activity.ListNotifications(ctx, nil)
+	if err != nil {
+		t.Fatalf("Activity.ListNotifications returned error: %v", err)
+	}
+	if want := []*Notification{{ID: String("1")}}; !cmp.Equal(notifications, want) {
+		t.Errorf("Activity.ListNotifications returned %+v, want %+v", notifications, want)
+	}
+	if got, want := resp.PollInterval, 60*time.Second; got != want {
+		t.Errorf("Response.PollInterval = %v, want %v", got, want)
+	}
+	if got, want := resp.ETag, `"abc"`; got != want {
+		t.Errorf("Response.ETag = %v, want %v", got, want)
+	}
+
+	_, resp, err = client.Activity.ListNotifications(ctx, &NotificationListOptions{ETag: resp.ETag})
+	if err == nil {
+		t.Fatal("Activity.ListNotifications returned no error, want 304 Not Modified")
+	}
+	if got, want := resp.StatusCode, http.StatusNotModified; got != want {
+		t.Errorf("Activity.ListNotifications returned status %v, want %v", got, want)
+	}
+	if got, want := resp.PollInterval, 60*time.Second; got != want {
+		t.Errorf("Response.PollInterval = %v, want %v", got, want)
+	}
+}
+
 func TestActivityService_ListRepositoryNotification(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -287,6 +329,28 @@ func TestActivityService_SetThreadSubscription(t *testing.T) {
 	})
 }
 
+func TestActivityService_SetThreadSubscription_ignored(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications/threads/1/subscription", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"ignored":true}`+"\n")
+		fmt.Fprint(w, `{"subscribed":false,"ignored":true,"reason":null,"thread_url":"t"}`)
+	})
+
+	ctx := context.Background()
+	sub, _, err := client.Activity.SetThreadSubscription(ctx, "1", &Subscription{Ignored: Bool(true)})
+	if err != nil {
+		t.Errorf("Activity.SetThreadSubscription returned error: %v", err)
+	}
+
+	want := &Subscription{Subscribed: Bool(false), Ignored: Bool(true), ThreadURL: String("t")}
+	if !cmp.Equal(sub, want) {
+		t.Errorf("Activity.SetThreadSubscription returned %+v, want %+v", sub, want)
+	}
+}
+
 func TestActivityService_DeleteThreadSubscription(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
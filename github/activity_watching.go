@@ -145,3 +145,28 @@ func (s *ActivityService) DeleteRepositorySubscription(ctx context.Context, owne
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// Watch subscribes the authenticated user to all notifications from the
+// specified repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#set-a-repository-subscription
+func (s *ActivityService) Watch(ctx context.Context, owner, repo string) (*Subscription, *Response, error) {
+	return s.SetRepositorySubscription(ctx, owner, repo, &Subscription{Subscribed: Bool(true), Ignored: Bool(false)})
+}
+
+// Ignore blocks all notifications from the specified repository for the
+// authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#set-a-repository-subscription
+func (s *ActivityService) Ignore(ctx context.Context, owner, repo string) (*Subscription, *Response, error) {
+	return s.SetRepositorySubscription(ctx, owner, repo, &Subscription{Subscribed: Bool(false), Ignored: Bool(true)})
+}
+
+// Unwatch stops the authenticated user from watching the specified
+// repository. Notifications will still be received for threads the user
+// participates in or is mentioned in.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#delete-a-repository-subscription
+func (s *ActivityService) Unwatch(ctx context.Context, owner, repo string) (*Response, error) {
+	return s.DeleteRepositorySubscription(ctx, owner, repo)
+}
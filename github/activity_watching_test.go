@@ -258,6 +258,104 @@ func TestActivityService_DeleteRepositorySubscription(t *testing.T) {
 	})
 }
 
+func TestActivityService_Watch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/subscription", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"subscribed":true,"ignored":false}`+"\n")
+		fmt.Fprint(w, `{"subscribed":true,"ignored":false}`)
+	})
+
+	ctx := context.Background()
+	sub, _, err := client.Activity.Watch(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Activity.Watch returned error: %v", err)
+	}
+
+	want := &Subscription{Subscribed: Bool(true), Ignored: Bool(false)}
+	if !cmp.Equal(sub, want) {
+		t.Errorf("Activity.Watch returned %+v, want %+v", sub, want)
+	}
+
+	const methodName = "Watch"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Activity.Watch(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Activity.Watch(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActivityService_Ignore(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/subscription", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"subscribed":false,"ignored":true}`+"\n")
+		fmt.Fprint(w, `{"subscribed":false,"ignored":true}`)
+	})
+
+	ctx := context.Background()
+	sub, _, err := client.Activity.Ignore(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Activity.Ignore returned error: %v", err)
+	}
+
+	want := &Subscription{Subscribed: Bool(false), Ignored: Bool(true)}
+	if !cmp.Equal(sub, want) {
+		t.Errorf("Activity.Ignore returned %+v, want %+v", sub, want)
+	}
+
+	const methodName = "Ignore"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Activity.Ignore(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Activity.Ignore(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActivityService_Unwatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/subscription", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Activity.Unwatch(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Activity.Unwatch returned error: %v", err)
+	}
+
+	const methodName = "Unwatch"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Activity.Unwatch(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Activity.Unwatch(ctx, "o", "r")
+	})
+}
+
 func TestSubscription_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Subscription{}, "{}")
 
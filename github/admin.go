@@ -80,6 +80,11 @@ func (m Enterprise) String() string {
 	return Stringify(m)
 }
 
+// LDAPSyncStatus represents the status of an LDAP sync job.
+type LDAPSyncStatus struct {
+	Status *string `json:"status,omitempty"`
+}
+
 // UpdateUserLDAPMapping updates the mapping between a GitHub user and an LDAP user.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise/ldap/#update-ldap-mapping-for-a-user
@@ -99,6 +104,25 @@ func (s *AdminService) UpdateUserLDAPMapping(ctx context.Context, user string, m
 	return m, resp, nil
 }
 
+// SyncUserLDAPMapping queues a job to sync the LDAP mapping of a GitHub user.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-server@3.3/rest/reference/enterprise-admin#sync-ldap-mapping-for-a-user
+func (s *AdminService) SyncUserLDAPMapping(ctx context.Context, user string) (*LDAPSyncStatus, *Response, error) {
+	u := fmt.Sprintf("admin/ldap/users/%v/sync", user)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(LDAPSyncStatus)
+	resp, err := s.client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
+
 // UpdateTeamLDAPMapping updates the mapping between a GitHub team and an LDAP group.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise/ldap/#update-ldap-mapping-for-a-team
@@ -117,3 +141,22 @@ func (s *AdminService) UpdateTeamLDAPMapping(ctx context.Context, team int64, ma
 
 	return m, resp, nil
 }
+
+// SyncTeamLDAPMapping queues a job to sync the LDAP mapping of a GitHub team.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-server@3.3/rest/reference/enterprise-admin#sync-ldap-mapping-for-a-team
+func (s *AdminService) SyncTeamLDAPMapping(ctx context.Context, team int64) (*LDAPSyncStatus, *Response, error) {
+	u := fmt.Sprintf("admin/ldap/teams/%v/sync", team)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(LDAPSyncStatus)
+	resp, err := s.client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+)
+
+// Announcement represents the global announcement banner of a GitHub
+// Enterprise Server instance.
+type Announcement struct {
+	// Message is the announcement text, in GitHub Flavored Markdown.
+	Message *string `json:"announcement,omitempty"`
+	// Expiration is the time at which the banner is removed. The banner
+	// never expires if it is not set.
+	Expiration *Timestamp `json:"expires_at,omitempty"`
+	// UserDismissible reports whether users can dismiss the banner.
+	UserDismissible *bool `json:"user_dismissible,omitempty"`
+}
+
+func (a Announcement) String() string {
+	return Stringify(a)
+}
+
+// GetAnnouncement gets the global announcement banner.
+//
+// GitHub Enterprise API docs: https://docs.github.com/en/enterprise-server@3.3/rest/reference/enterprise-admin#get-the-global-announcement-banner
+func (s *AdminService) GetAnnouncement(ctx context.Context) (*Announcement, *Response, error) {
+	req, err := s.client.NewRequest("GET", "enterprise/announcement", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(Announcement)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// SetAnnouncement sets the global announcement banner.
+//
+// GitHub Enterprise API docs: https://docs.github.com/en/enterprise-server@3.3/rest/reference/enterprise-admin#set-the-global-announcement-banner
+func (s *AdminService) SetAnnouncement(ctx context.Context, announcement *Announcement) (*Announcement, *Response, error) {
+	req, err := s.client.NewRequest("PATCH", "enterprise/announcement", announcement)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(Announcement)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// RemoveAnnouncement removes the global announcement banner.
+//
+// GitHub Enterprise API docs: https://docs.github.com/en/enterprise-server@3.3/rest/reference/enterprise-admin#remove-the-global-announcement-banner
+func (s *AdminService) RemoveAnnouncement(ctx context.Context) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", "enterprise/announcement", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
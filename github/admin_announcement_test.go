@@ -0,0 +1,127 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAdminService_GetAnnouncement(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprise/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"announcement":"Maintenance tonight","expires_at":`+referenceTimeStr+`,"user_dismissible":true}`)
+	})
+
+	ctx := context.Background()
+	announcement, _, err := client.Admin.GetAnnouncement(ctx)
+	if err != nil {
+		t.Errorf("Admin.GetAnnouncement returned error: %v", err)
+	}
+
+	want := &Announcement{
+		Message:         String("Maintenance tonight"),
+		Expiration:      &Timestamp{referenceTime},
+		UserDismissible: Bool(true),
+	}
+	if !cmp.Equal(announcement, want) {
+		t.Errorf("Admin.GetAnnouncement returned %+v, want %+v", announcement, want)
+	}
+
+	const methodName = "GetAnnouncement"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Admin.GetAnnouncement(ctx)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestAdminService_SetAnnouncement(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Announcement{
+		Message:    String("Maintenance tonight"),
+		Expiration: &Timestamp{referenceTime},
+	}
+
+	mux.HandleFunc("/enterprise/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"announcement":"Maintenance tonight","expires_at":`+referenceTimeStr+`}`+"\n")
+		fmt.Fprint(w, `{"announcement":"Maintenance tonight","expires_at":`+referenceTimeStr+`,"user_dismissible":false}`)
+	})
+
+	ctx := context.Background()
+	announcement, _, err := client.Admin.SetAnnouncement(ctx, input)
+	if err != nil {
+		t.Errorf("Admin.SetAnnouncement returned error: %v", err)
+	}
+
+	want := &Announcement{
+		Message:         String("Maintenance tonight"),
+		Expiration:      &Timestamp{referenceTime},
+		UserDismissible: Bool(false),
+	}
+	if !cmp.Equal(announcement, want) {
+		t.Errorf("Admin.SetAnnouncement returned %+v, want %+v", announcement, want)
+	}
+
+	const methodName = "SetAnnouncement"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Admin.SetAnnouncement(ctx, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestAdminService_RemoveAnnouncement(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprise/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Admin.RemoveAnnouncement(ctx); err != nil {
+		t.Errorf("Admin.RemoveAnnouncement returned error: %v", err)
+	}
+
+	const methodName = "RemoveAnnouncement"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Admin.RemoveAnnouncement(ctx)
+	})
+}
+
+func TestAnnouncement_Marshal(t *testing.T) {
+	testJSONMarshal(t, &Announcement{}, "{}")
+
+	a := &Announcement{
+		Message:         String("m"),
+		Expiration:      &Timestamp{referenceTime},
+		UserDismissible: Bool(true),
+	}
+
+	want := `{
+		"announcement": "m",
+		"expires_at": ` + referenceTimeStr + `,
+		"user_dismissible": true
+	}`
+
+	testJSONMarshal(t, a, want)
+}
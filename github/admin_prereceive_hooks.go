@@ -0,0 +1,132 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// PreReceiveEnvironment represents the environment in which a pre-receive
+// hook script runs on a GitHub Enterprise Server instance.
+type PreReceiveEnvironment struct {
+	ID                 *int64     `json:"id,omitempty"`
+	Name               *string    `json:"name,omitempty"`
+	ImageURL           *string    `json:"image_url,omitempty"`
+	URL                *string    `json:"url,omitempty"`
+	HTMLURL            *string    `json:"html_url,omitempty"`
+	DefaultEnvironment *bool      `json:"default_environment,omitempty"`
+	CreatedAt          *Timestamp `json:"created_at,omitempty"`
+	HooksCount         *int       `json:"hooks_count,omitempty"`
+}
+
+// ListPreReceiveHooks lists all pre-receive hooks of the instance.
+//
+// GitHub Enterprise API docs: https://docs.github.com/en/enterprise-server@3.3/rest/reference/enterprise-admin#list-pre-receive-hooks
+func (s *AdminService) ListPreReceiveHooks(ctx context.Context, opts *ListOptions) ([]*PreReceiveHook, *Response, error) {
+	u, err := addOptions("admin/pre-receive-hooks", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	var hooks []*PreReceiveHook
+	resp, err := s.client.Do(ctx, req, &hooks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return hooks, resp, nil
+}
+
+// GetPreReceiveHook returns a single pre-receive hook of the instance.
+//
+// GitHub Enterprise API docs: https://docs.github.com/en/enterprise-server@3.3/rest/reference/enterprise-admin#get-a-pre-receive-hook
+func (s *AdminService) GetPreReceiveHook(ctx context.Context, id int64) (*PreReceiveHook, *Response, error) {
+	u := fmt.Sprintf("admin/pre-receive-hooks/%d", id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	h := new(PreReceiveHook)
+	resp, err := s.client.Do(ctx, req, h)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return h, resp, nil
+}
+
+// CreatePreReceiveHook creates a pre-receive hook for the instance. Name,
+// Script, ScriptRepository.FullName and Environment.ID are required.
+//
+// GitHub Enterprise API docs: https://docs.github.com/en/enterprise-server@3.3/rest/reference/enterprise-admin#create-a-pre-receive-hook
+func (s *AdminService) CreatePreReceiveHook(ctx context.Context, hook *PreReceiveHook) (*PreReceiveHook, *Response, error) {
+	req, err := s.client.NewRequest("POST", "admin/pre-receive-hooks", hook)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	h := new(PreReceiveHook)
+	resp, err := s.client.Do(ctx, req, h)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return h, resp, nil
+}
+
+// UpdatePreReceiveHook updates a pre-receive hook of the instance.
+//
+// GitHub Enterprise API docs: https://docs.github.com/en/enterprise-server@3.3/rest/reference/enterprise-admin#update-a-pre-receive-hook
+func (s *AdminService) UpdatePreReceiveHook(ctx context.Context, id int64, hook *PreReceiveHook) (*PreReceiveHook, *Response, error) {
+	u := fmt.Sprintf("admin/pre-receive-hooks/%d", id)
+	req, err := s.client.NewRequest("PATCH", u, hook)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	h := new(PreReceiveHook)
+	resp, err := s.client.Do(ctx, req, h)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return h, resp, nil
+}
+
+// DeletePreReceiveHook deletes a pre-receive hook of the instance.
+//
+// GitHub Enterprise API docs: https://docs.github.com/en/enterprise-server@3.3/rest/reference/enterprise-admin#delete-a-pre-receive-hook
+func (s *AdminService) DeletePreReceiveHook(ctx context.Context, id int64) (*Response, error) {
+	u := fmt.Sprintf("admin/pre-receive-hooks/%d", id)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	return s.client.Do(ctx, req, nil)
+}
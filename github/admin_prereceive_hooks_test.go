@@ -0,0 +1,191 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAdminService_ListPreReceiveHooks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/pre-receive-hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1,"name":"Check Commits","enforcement":"disabled"}]`)
+	})
+
+	opt := &ListOptions{Page: 2}
+	ctx := context.Background()
+	hooks, _, err := client.Admin.ListPreReceiveHooks(ctx, opt)
+	if err != nil {
+		t.Errorf("Admin.ListPreReceiveHooks returned error: %v", err)
+	}
+
+	want := []*PreReceiveHook{{ID: Int64(1), Name: String("Check Commits"), Enforcement: String("disabled")}}
+	if !cmp.Equal(hooks, want) {
+		t.Errorf("Admin.ListPreReceiveHooks returned %+v, want %+v", hooks, want)
+	}
+
+	const methodName = "ListPreReceiveHooks"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Admin.ListPreReceiveHooks(ctx, opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestAdminService_GetPreReceiveHook(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/pre-receive-hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"name": "Check Commits",
+			"enforcement": "disabled",
+			"script": "scripts/commit_check.sh",
+			"script_repository": {"id": 595, "full_name": "DevIT/hooks"},
+			"environment": {"id": 2, "name": "DevTools Hook Env", "image_url": "https://example.com/dev-env.tar.gz"},
+			"allow_downstream_configuration": false
+		}`)
+	})
+
+	ctx := context.Background()
+	hook, _, err := client.Admin.GetPreReceiveHook(ctx, 1)
+	if err != nil {
+		t.Errorf("Admin.GetPreReceiveHook returned error: %v", err)
+	}
+
+	want := &PreReceiveHook{
+		ID:                           Int64(1),
+		Name:                         String("Check Commits"),
+		Enforcement:                  String("disabled"),
+		Script:                       String("scripts/commit_check.sh"),
+		ScriptRepository:             &Repository{ID: Int64(595), FullName: String("DevIT/hooks")},
+		Environment:                  &PreReceiveEnvironment{ID: Int64(2), Name: String("DevTools Hook Env"), ImageURL: String("https://example.com/dev-env.tar.gz")},
+		AllowDownstreamConfiguration: Bool(false),
+	}
+	if !cmp.Equal(hook, want) {
+		t.Errorf("Admin.GetPreReceiveHook returned %+v, want %+v", hook, want)
+	}
+
+	const methodName = "GetPreReceiveHook"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Admin.GetPreReceiveHook(ctx, 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestAdminService_CreatePreReceiveHook(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &PreReceiveHook{
+		Name:                         String("Check Commits"),
+		Script:                       String("scripts/commit_check.sh"),
+		ScriptRepository:             &Repository{FullName: String("DevIT/hooks")},
+		Environment:                  &PreReceiveEnvironment{ID: Int64(2)},
+		Enforcement:                  String("disabled"),
+		AllowDownstreamConfiguration: Bool(false),
+	}
+
+	mux.HandleFunc("/admin/pre-receive-hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		testBody(t, r, `{"name":"Check Commits","enforcement":"disabled","script":"scripts/commit_check.sh","script_repository":{"full_name":"DevIT/hooks"},"environment":{"id":2},"allow_downstream_configuration":false}`+"\n")
+		fmt.Fprint(w, `{"id":1,"name":"Check Commits","enforcement":"disabled"}`)
+	})
+
+	ctx := context.Background()
+	hook, _, err := client.Admin.CreatePreReceiveHook(ctx, input)
+	if err != nil {
+		t.Errorf("Admin.CreatePreReceiveHook returned error: %v", err)
+	}
+
+	want := &PreReceiveHook{ID: Int64(1), Name: String("Check Commits"), Enforcement: String("disabled")}
+	if !cmp.Equal(hook, want) {
+		t.Errorf("Admin.CreatePreReceiveHook returned %+v, want %+v", hook, want)
+	}
+
+	const methodName = "CreatePreReceiveHook"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Admin.CreatePreReceiveHook(ctx, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestAdminService_UpdatePreReceiveHook(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &PreReceiveHook{Enforcement: String("enabled")}
+
+	mux.HandleFunc("/admin/pre-receive-hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		testBody(t, r, `{"enforcement":"enabled"}`+"\n")
+		fmt.Fprint(w, `{"id":1,"enforcement":"enabled"}`)
+	})
+
+	ctx := context.Background()
+	hook, _, err := client.Admin.UpdatePreReceiveHook(ctx, 1, input)
+	if err != nil {
+		t.Errorf("Admin.UpdatePreReceiveHook returned error: %v", err)
+	}
+
+	want := &PreReceiveHook{ID: Int64(1), Enforcement: String("enabled")}
+	if !cmp.Equal(hook, want) {
+		t.Errorf("Admin.UpdatePreReceiveHook returned %+v, want %+v", hook, want)
+	}
+
+	const methodName = "UpdatePreReceiveHook"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Admin.UpdatePreReceiveHook(ctx, 1, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestAdminService_DeletePreReceiveHook(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/pre-receive-hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Admin.DeletePreReceiveHook(ctx, 1); err != nil {
+		t.Errorf("Admin.DeletePreReceiveHook returned error: %v", err)
+	}
+
+	const methodName = "DeletePreReceiveHook"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Admin.DeletePreReceiveHook(ctx, 1)
+	})
+}
@@ -63,6 +63,42 @@ func TestAdminService_UpdateUserLDAPMapping(t *testing.T) {
 	})
 }
 
+func TestAdminService_SyncUserLDAPMapping(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/ldap/users/u/sync", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"status":"queued"}`)
+	})
+
+	ctx := context.Background()
+	status, _, err := client.Admin.SyncUserLDAPMapping(ctx, "u")
+	if err != nil {
+		t.Errorf("Admin.SyncUserLDAPMapping returned error: %v", err)
+	}
+
+	want := &LDAPSyncStatus{Status: String("queued")}
+	if !cmp.Equal(status, want) {
+		t.Errorf("Admin.SyncUserLDAPMapping returned %+v, want %+v", status, want)
+	}
+
+	const methodName = "SyncUserLDAPMapping"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Admin.SyncUserLDAPMapping(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Admin.SyncUserLDAPMapping(ctx, "u")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestAdminService_UpdateTeamLDAPMapping(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -111,6 +147,37 @@ func TestAdminService_UpdateTeamLDAPMapping(t *testing.T) {
 	})
 }
 
+func TestAdminService_SyncTeamLDAPMapping(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/ldap/teams/1/sync", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"status":"queued"}`)
+	})
+
+	ctx := context.Background()
+	status, _, err := client.Admin.SyncTeamLDAPMapping(ctx, 1)
+	if err != nil {
+		t.Errorf("Admin.SyncTeamLDAPMapping returned error: %v", err)
+	}
+
+	want := &LDAPSyncStatus{Status: String("queued")}
+	if !cmp.Equal(status, want) {
+		t.Errorf("Admin.SyncTeamLDAPMapping returned %+v, want %+v", status, want)
+	}
+
+	const methodName = "SyncTeamLDAPMapping"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Admin.SyncTeamLDAPMapping(ctx, 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestAdminService_TeamLDAPMapping_String(t *testing.T) {
 	v := &TeamLDAPMapping{
 		ID:              Int64(1),
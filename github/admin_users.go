@@ -131,3 +131,35 @@ func (s *AdminService) DeleteUserImpersonation(ctx context.Context, username str
 
 	return resp, nil
 }
+
+// PromoteUserToSiteAdmin promotes a user to a site administrator of a GitHub
+// Enterprise instance. It is equivalent to UsersService.PromoteSiteAdmin.
+//
+// GitHub Enterprise API docs: https://developer.github.com/enterprise/v3/enterprise-admin/users/#promote-an-ordinary-user-to-a-site-administrator
+func (s *AdminService) PromoteUserToSiteAdmin(ctx context.Context, user string) (*Response, error) {
+	return s.client.Users.PromoteSiteAdmin(ctx, user)
+}
+
+// DemoteSiteAdmin demotes a user from site administrator of a GitHub
+// Enterprise instance. It is equivalent to UsersService.DemoteSiteAdmin.
+//
+// GitHub Enterprise API docs: https://developer.github.com/enterprise/v3/enterprise-admin/users/#demote-a-site-administrator-to-an-ordinary-user
+func (s *AdminService) DemoteSiteAdmin(ctx context.Context, user string) (*Response, error) {
+	return s.client.Users.DemoteSiteAdmin(ctx, user)
+}
+
+// Suspend suspends a user on a GitHub Enterprise instance. It is equivalent
+// to UsersService.Suspend.
+//
+// GitHub Enterprise API docs: https://developer.github.com/enterprise/v3/enterprise-admin/users/#suspend-a-user
+func (s *AdminService) Suspend(ctx context.Context, user string, opts *UserSuspendOptions) (*Response, error) {
+	return s.client.Users.Suspend(ctx, user, opts)
+}
+
+// Unsuspend unsuspends a user on a GitHub Enterprise instance. It is
+// equivalent to UsersService.Unsuspend.
+//
+// GitHub Enterprise API docs: https://developer.github.com/enterprise/v3/enterprise-admin/users/#unsuspend-a-user
+func (s *AdminService) Unsuspend(ctx context.Context, user string) (*Response, error) {
+	return s.client.Users.Unsuspend(ctx, user)
+}
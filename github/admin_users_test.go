@@ -176,6 +176,119 @@ func TestUserImpersonation_Delete(t *testing.T) {
 	})
 }
 
+func TestAdminUsers_PromoteUserToSiteAdmin(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/site_admin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Admin.PromoteUserToSiteAdmin(ctx, "u")
+	if err != nil {
+		t.Errorf("Admin.PromoteUserToSiteAdmin returned error: %v", err)
+	}
+	if got, want := resp.StatusCode, http.StatusNoContent; got != want {
+		t.Errorf("Admin.PromoteUserToSiteAdmin returned status %d, want %d", got, want)
+	}
+
+	const methodName = "PromoteUserToSiteAdmin"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Admin.PromoteUserToSiteAdmin(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Admin.PromoteUserToSiteAdmin(ctx, "u")
+	})
+}
+
+func TestAdminUsers_DemoteSiteAdmin(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/site_admin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Admin.DemoteSiteAdmin(ctx, "u")
+	if err != nil {
+		t.Errorf("Admin.DemoteSiteAdmin returned error: %v", err)
+	}
+	if got, want := resp.StatusCode, http.StatusNoContent; got != want {
+		t.Errorf("Admin.DemoteSiteAdmin returned status %d, want %d", got, want)
+	}
+
+	const methodName = "DemoteSiteAdmin"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Admin.DemoteSiteAdmin(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Admin.DemoteSiteAdmin(ctx, "u")
+	})
+}
+
+func TestAdminUsers_Suspend(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/suspended", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"reason":"spam"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Admin.Suspend(ctx, "u", &UserSuspendOptions{Reason: String("spam")})
+	if err != nil {
+		t.Errorf("Admin.Suspend returned error: %v", err)
+	}
+	if got, want := resp.StatusCode, http.StatusNoContent; got != want {
+		t.Errorf("Admin.Suspend returned status %d, want %d", got, want)
+	}
+
+	const methodName = "Suspend"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Admin.Suspend(ctx, "\n", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Admin.Suspend(ctx, "u", nil)
+	})
+}
+
+func TestAdminUsers_Unsuspend(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/suspended", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Admin.Unsuspend(ctx, "u"); err != nil {
+		t.Errorf("Admin.Unsuspend returned error: %v", err)
+	}
+
+	const methodName = "Unsuspend"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Admin.Unsuspend(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Admin.Unsuspend(ctx, "u")
+	})
+}
+
 func TestCreateUserRequest_Marshal(t *testing.T) {
 	testJSONMarshal(t, &createUserRequest{}, "{}")
 
@@ -51,6 +51,8 @@ func (s *AppsService) ListRepos(ctx context.Context, opts *ListOptions) (*ListRe
 
 // ListUserRepos lists repositories that are accessible
 // to the authenticated user for an installation.
+// It requires a user-to-server token. The TotalCount of the result is the
+// number of accessible repositories across all pages.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/apps/#list-repositories-accessible-to-the-user-access-token
 func (s *AppsService) ListUserRepos(ctx context.Context, id int64, opts *ListOptions) (*ListRepositories, *Response, error) {
@@ -83,6 +85,9 @@ func (s *AppsService) ListUserRepos(ctx context.Context, id int64, opts *ListOpt
 }
 
 // AddRepository adds a single repository to an installation.
+// It requires a user-to-server token. GitHub responds with 204 No Content,
+// in which case the returned Repository is empty; check the StatusCode of
+// the returned Response to confirm the repository was added.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/apps/#add-a-repository-to-an-app-installation
 func (s *AppsService) AddRepository(ctx context.Context, instID, repoID int64) (*Repository, *Response, error) {
@@ -102,6 +107,7 @@ func (s *AppsService) AddRepository(ctx context.Context, instID, repoID int64) (
 }
 
 // RemoveRepository removes a single repository from an installation.
+// It requires a user-to-server token, and GitHub responds with 204 No Content.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/apps/#remove-a-repository-from-an-app-installation
 func (s *AppsService) RemoveRepository(ctx context.Context, instID, repoID int64) (*Response, error) {
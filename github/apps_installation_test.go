@@ -102,6 +102,47 @@ func TestAppsService_ListUserRepos(t *testing.T) {
 	})
 }
 
+func TestAppsService_ListUserRepos_multiplePages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/installations/1/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "", "1":
+			w.Header().Set("Link", `<https://api.github.com/user/installations/1/repositories?page=2&per_page=2>; rel="next", <https://api.github.com/user/installations/1/repositories?page=2&per_page=2>; rel="last"`)
+			fmt.Fprint(w, `{"total_count":3,"repositories":[{"id":1},{"id":2}]}`)
+		case "2":
+			fmt.Fprint(w, `{"total_count":3,"repositories":[{"id":3}]}`)
+		default:
+			t.Errorf("Unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	opt := &ListOptions{PerPage: 2}
+	var repos []*Repository
+	for {
+		list, resp, err := client.Apps.ListUserRepos(ctx, 1, opt)
+		if err != nil {
+			t.Fatalf("Apps.ListUserRepos returned error: %v", err)
+		}
+		if got, want := list.GetTotalCount(), 3; got != want {
+			t.Errorf("Apps.ListUserRepos returned total count %v, want %v", got, want)
+		}
+		repos = append(repos, list.Repositories...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	want := []*Repository{{ID: Int64(1)}, {ID: Int64(2)}, {ID: Int64(3)}}
+	if !cmp.Equal(repos, want) {
+		t.Errorf("Apps.ListUserRepos returned %+v, want %+v", repos, want)
+	}
+}
+
 func TestAppsService_AddRepository(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -132,6 +173,28 @@ func TestAppsService_AddRepository(t *testing.T) {
 	})
 }
 
+func TestAppsService_AddRepository_noContent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/installations/1/repositories/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	repo, resp, err := client.Apps.AddRepository(ctx, 1, 2)
+	if err != nil {
+		t.Errorf("Apps.AddRepository returned error: %v", err)
+	}
+	if got, want := resp.StatusCode, http.StatusNoContent; got != want {
+		t.Errorf("Apps.AddRepository returned status %v, want %v", got, want)
+	}
+	if want := new(Repository); !cmp.Equal(repo, want) {
+		t.Errorf("Apps.AddRepository returned %+v, want %+v", repo, want)
+	}
+}
+
 func TestAppsService_RemoveRepository(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -142,10 +205,13 @@ func TestAppsService_RemoveRepository(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	_, err := client.Apps.RemoveRepository(ctx, 1, 1)
+	resp, err := client.Apps.RemoveRepository(ctx, 1, 1)
 	if err != nil {
 		t.Errorf("Apps.RemoveRepository returned error: %v", err)
 	}
+	if got, want := resp.StatusCode, http.StatusNoContent; got != want {
+		t.Errorf("Apps.RemoveRepository returned status %v, want %v", got, want)
+	}
 
 	const methodName = "RemoveRepository"
 	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
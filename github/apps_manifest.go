@@ -29,7 +29,9 @@ type AppConfig struct {
 }
 
 // CompleteAppManifest completes the App manifest handshake flow for the given
-// code.
+// code. The code is valid for one hour, and the returned PEM, WebhookSecret
+// and ClientSecret credentials are not returned again, so they should be
+// stored right away.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/apps/#create-a-github-app-from-a-manifest
 func (s *AppsService) CompleteAppManifest(ctx context.Context, code string) (*AppConfig, *Response, error) {
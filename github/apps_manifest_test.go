@@ -17,6 +17,7 @@ import (
 const (
 	manifestJSON = `{
 	"id": 1,
+  "slug": "s",
   "client_id": "a" ,
   "client_secret": "b",
   "webhook_secret": "c",
@@ -42,6 +43,7 @@ func TestGetConfig(t *testing.T) {
 
 	want := &AppConfig{
 		ID:            Int64(1),
+		Slug:          String("s"),
 		ClientID:      String("a"),
 		ClientSecret:  String("b"),
 		WebhookSecret: String("c"),
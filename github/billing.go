@@ -24,6 +24,8 @@ type ActionBilling struct {
 	MinutesUsedBreakdown MinutesUsedBreakdown `json:"minutes_used_breakdown"`
 }
 
+// MinutesUsedBreakdown represents the number of GitHub Actions minutes used
+// per runner operating system.
 type MinutesUsedBreakdown struct {
 	Ubuntu  int `json:"UBUNTU"`
 	MacOS   int `json:"MACOS"`
@@ -44,6 +46,47 @@ type StorageBilling struct {
 	EstimatedStorageForMonth     int `json:"estimated_storage_for_month"`
 }
 
+// UsageReportOptions specifies optional parameters for the enhanced billing
+// usage report.
+type UsageReportOptions struct {
+	// If specified, only return results for a single year. The value of year
+	// is an integer with four digits representing a year. For example, 2021.
+	// Default value is the current year.
+	Year *int `url:"year,omitempty"`
+
+	// If specified, only return results for a single month. The value of month
+	// is an integer between 1 and 12. Requires Year to be set.
+	Month *int `url:"month,omitempty"`
+
+	// If specified, only return results for a single day. The value of day is
+	// an integer between 1 and 31. Requires Year and Month to be set.
+	Day *int `url:"day,omitempty"`
+
+	// If specified, only return results for a single hour. The value of hour
+	// is an integer between 0 and 23. Requires Year, Month and Day to be set.
+	Hour *int `url:"hour,omitempty"`
+}
+
+// UsageItem represents a single item of an enhanced billing usage report.
+type UsageItem struct {
+	Date             *string  `json:"date,omitempty"`
+	Product          *string  `json:"product,omitempty"`
+	SKU              *string  `json:"sku,omitempty"`
+	Quantity         *float64 `json:"quantity,omitempty"`
+	UnitType         *string  `json:"unitType,omitempty"`
+	PricePerUnit     *float64 `json:"pricePerUnit,omitempty"`
+	GrossAmount      *float64 `json:"grossAmount,omitempty"`
+	DiscountAmount   *float64 `json:"discountAmount,omitempty"`
+	NetAmount        *float64 `json:"netAmount,omitempty"`
+	OrganizationName *string  `json:"organizationName,omitempty"`
+	RepositoryName   *string  `json:"repositoryName,omitempty"`
+}
+
+// UsageReport represents an enhanced billing usage report.
+type UsageReport struct {
+	UsageItems []*UsageItem `json:"usageItems,omitempty"`
+}
+
 // GetActionsBillingOrg returns the summary of the free and paid GitHub Actions minutes used for an Org.
 //
 // GitHub API docs: https://docs.github.com/en/rest/reference/billing#get-github-actions-billing-for-an-organization
@@ -129,3 +172,31 @@ func (s *BillingService) GetStorageBillingUser(ctx context.Context, user string)
 	resp, err := s.client.Do(ctx, req, storageUserBilling)
 	return storageUserBilling, resp, err
 }
+
+// GetUsageReportOrg returns the enhanced billing usage report for an Org,
+// optionally filtered by year, month, day and hour.
+//
+// Note that this endpoint is only available to organizations on the enhanced
+// billing platform.
+//
+// GitHub API docs: https://docs.github.com/en/rest/reference/billing#get-billing-usage-report-for-an-organization
+func (s *BillingService) GetUsageReportOrg(ctx context.Context, org string, opts *UsageReportOptions) (*UsageReport, *Response, error) {
+	u := fmt.Sprintf("organizations/%v/settings/billing/usage", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usageReport := new(UsageReport)
+	resp, err := s.client.Do(ctx, req, usageReport)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return usageReport, resp, nil
+}
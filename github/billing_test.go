@@ -379,3 +379,82 @@ func TestStorageBilling_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestBillingService_GetUsageReportOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/organizations/o/settings/billing/usage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"year": "2021", "month": "8"})
+		fmt.Fprint(w, `{
+			"usageItems": [
+				{
+					"date": "2021-08-01",
+					"product": "Actions",
+					"sku": "Actions Linux",
+					"quantity": 100,
+					"unitType": "minutes",
+					"pricePerUnit": 0.008,
+					"grossAmount": 0.8,
+					"discountAmount": 0,
+					"netAmount": 0.8,
+					"organizationName": "o",
+					"repositoryName": "o/r"
+				}
+			]
+		}`)
+	})
+
+	opts := &UsageReportOptions{Year: Int(2021), Month: Int(8)}
+	ctx := context.Background()
+	report, _, err := client.Billing.GetUsageReportOrg(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Billing.GetUsageReportOrg returned error: %v", err)
+	}
+
+	quantity, price, gross, discount, net := 100.0, 0.008, 0.8, 0.0, 0.8
+	want := &UsageReport{
+		UsageItems: []*UsageItem{
+			{
+				Date:             String("2021-08-01"),
+				Product:          String("Actions"),
+				SKU:              String("Actions Linux"),
+				Quantity:         &quantity,
+				UnitType:         String("minutes"),
+				PricePerUnit:     &price,
+				GrossAmount:      &gross,
+				DiscountAmount:   &discount,
+				NetAmount:        &net,
+				OrganizationName: String("o"),
+				RepositoryName:   String("o/r"),
+			},
+		},
+	}
+	if !cmp.Equal(report, want) {
+		t.Errorf("Billing.GetUsageReportOrg returned %+v, want %+v", report, want)
+	}
+
+	const methodName = "GetUsageReportOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Billing.GetUsageReportOrg(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Billing.GetUsageReportOrg(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestBillingService_GetUsageReportOrg_invalidOrg(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	_, _, err := client.Billing.GetUsageReportOrg(ctx, "%", nil)
+	testURLParseError(t, err)
+}
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -36,7 +37,9 @@ type CheckRun struct {
 	PullRequests []*PullRequest  `json:"pull_requests,omitempty"`
 }
 
-// CheckRunOutput represents the output of a CheckRun.
+// CheckRunOutput represents the output of a CheckRun. Some payloads report
+// the output as a plain string rather than an object, in which case that
+// string is held in Text.
 type CheckRunOutput struct {
 	Title            *string               `json:"title,omitempty"`
 	Summary          *string               `json:"summary,omitempty"`
@@ -47,6 +50,25 @@ type CheckRunOutput struct {
 	Images           []*CheckRunImage      `json:"images,omitempty"`
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface, accepting both
+// the object and the string form of the output.
+func (o *CheckRunOutput) UnmarshalJSON(data []byte) error {
+	var v stringOrObject
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.text != nil {
+		*o = CheckRunOutput{Text: v.text}
+		return nil
+	}
+	if v.object == nil {
+		return nil
+	}
+
+	type checkRunOutput CheckRunOutput
+	return json.Unmarshal(v.object, (*checkRunOutput)(o))
+}
+
 // CheckRunAnnotation represents an annotation object for a CheckRun output.
 type CheckRunAnnotation struct {
 	Path            *string `json:"path,omitempty"`
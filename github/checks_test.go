@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -515,7 +516,7 @@ func TestChecksService_SetCheckSuitePreferences(t *testing.T) {
 		testMethod(t, r, "PATCH")
 
 		testBody(t, r, `{"auto_trigger_checks":[{"app_id":2,"setting":false}]}`+"\n")
-		fmt.Fprint(w, `{"preferences":{"auto_trigger_checks":[{"app_id": 2,"setting": false}]}}`)
+		fmt.Fprint(w, `{"preferences":{"auto_trigger_checks":[{"app_id": 2,"setting": false}]},"repository":{"id":1,"name":"r"}}`)
 	})
 	a := []*AutoTriggerCheck{{
 		AppID:   Int64(2),
@@ -533,6 +534,7 @@ func TestChecksService_SetCheckSuitePreferences(t *testing.T) {
 	}
 	want := &CheckSuitePreferenceResults{
 		Preferences: p,
+		Repository:  &Repository{ID: Int64(1), Name: String("r")},
 	}
 
 	if !cmp.Equal(prefResults, want) {
@@ -1048,6 +1050,31 @@ func TestCreateCheckSuiteOptions_Marshal(t *testing.T) {
 	testJSONMarshal(t, u, want)
 }
 
+func TestCheckRunOutput_Unmarshal(t *testing.T) {
+	tests := []struct {
+		data string
+		want *CheckRun
+	}{
+		{`{"output":{"title":"t","text":"x"}}`, &CheckRun{Output: &CheckRunOutput{Title: String("t"), Text: String("x")}}},
+		{`{"output":"x"}`, &CheckRun{Output: &CheckRunOutput{Text: String("x")}}},
+		{`{"output":null}`, &CheckRun{}},
+	}
+
+	for _, tt := range tests {
+		got := new(CheckRun)
+		if err := json.Unmarshal([]byte(tt.data), got); err != nil {
+			t.Errorf("json.Unmarshal(%s) returned error: %v", tt.data, err)
+		}
+		if !cmp.Equal(got, tt.want) {
+			t.Errorf("json.Unmarshal(%s) = %+v, want %+v", tt.data, got, tt.want)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`{"output":1}`), new(CheckRun)); err == nil {
+		t.Error("json.Unmarshal of a numeric output returned nil error, want error")
+	}
+}
+
 func TestCheckRunOutput_Marshal(t *testing.T) {
 	testJSONMarshal(t, &CheckRunOutput{}, "{}")
 
@@ -0,0 +1,120 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WaitForCheckSuite polls the check runs for the specified ref until all of
+// them are completed, and returns them with their conclusions. The Total of
+// the results counts the check runs of all pages.
+//
+// The check runs are polled at most pollOpts.MaxAttempts times, waiting
+// pollOpts.Interval between two polls, or longer if GitHub asks for it with an
+// X-Poll-Interval header. As long as no check run was created for the ref, or
+// some are queued or in progress, polling continues. If a poll fails because
+// the rate limit is exceeded, the next one waits until the limit resets, or
+// for a minute if GitHub doesn't say when requests may resume. If the check
+// runs are still not completed after the last attempt, the pending results
+// are returned along with an error.
+func (s *ChecksService) WaitForCheckSuite(ctx context.Context, owner, repo, ref string, pollOpts PollOptions) (*ListCheckRunsResults, *Response, error) {
+	if ctx == nil {
+		return nil, nil, errNonNilContext
+	}
+
+	interval := pollOpts.Interval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	maxAttempts := pollOpts.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultPollMaxAttempts
+	}
+
+	var (
+		results *ListCheckRunsResults
+		resp    *Response
+	)
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			wait := interval
+			if resp != nil && resp.PollInterval > wait {
+				wait = resp.PollInterval
+			}
+			if err := sleepUntil(ctx, time.Now().Add(wait)); err != nil {
+				return results, resp, err
+			}
+		}
+
+		r, rr, err := s.listAllCheckRunsForRef(ctx, owner, repo, ref)
+		if err != nil {
+			var resume time.Time
+			switch e := err.(type) {
+			case *RateLimitError:
+				resume = e.Rate.Reset.Time
+			case *AbuseRateLimitError:
+				if e.RetryAfter != nil {
+					resume = time.Now().Add(*e.RetryAfter)
+				}
+			default:
+				return nil, rr, err
+			}
+			if attempt == maxAttempts-1 {
+				return results, rr, err
+			}
+			if resume.IsZero() {
+				resume = time.Now().Add(defaultRateLimitBackoff)
+			}
+			if err := sleepUntil(ctx, resume); err != nil {
+				return results, rr, err
+			}
+			continue
+		}
+		results, resp = r, rr
+
+		if checkRunsCompleted(results.CheckRuns) {
+			return results, resp, nil
+		}
+	}
+
+	return results, resp, fmt.Errorf("check runs for %v not completed after %d attempts", ref, maxAttempts)
+}
+
+// listAllCheckRunsForRef lists the check runs of all pages for the specified
+// ref. The returned Response is the one of the last page.
+func (s *ChecksService) listAllCheckRunsForRef(ctx context.Context, owner, repo, ref string) (*ListCheckRunsResults, *Response, error) {
+	opts := &ListCheckRunsOptions{ListOptions: ListOptions{PerPage: 100}}
+	all := new(ListCheckRunsResults)
+	for {
+		results, resp, err := s.ListCheckRunsForRef(ctx, owner, repo, ref, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		all.CheckRuns = append(all.CheckRuns, results.CheckRuns...)
+		if resp.NextPage == 0 {
+			all.Total = Int(len(all.CheckRuns))
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// checkRunsCompleted reports whether there are check runs and all of them
+// are completed.
+func checkRunsCompleted(runs []*CheckRun) bool {
+	if len(runs) == 0 {
+		return false
+	}
+	for _, run := range runs {
+		if run.GetStatus() != "completed" {
+			return false
+		}
+	}
+	return true
+}
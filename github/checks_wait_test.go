@@ -0,0 +1,231 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestChecksService_WaitForCheckSuite(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var polls int
+	mux.HandleFunc("/repos/o/r/commits/main/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "100"})
+		polls++
+		if polls == 1 {
+			fmt.Fprint(w, `{"total_count":2,"check_runs":[
+				{"id":1,"status":"completed","conclusion":"success"},
+				{"id":2,"status":"in_progress"}
+			]}`)
+			return
+		}
+		fmt.Fprint(w, `{"total_count":2,"check_runs":[
+			{"id":1,"status":"completed","conclusion":"success"},
+			{"id":2,"status":"completed","conclusion":"failure"}
+		]}`)
+	})
+
+	pollOpts := PollOptions{Interval: time.Millisecond, MaxAttempts: 5}
+	ctx := context.Background()
+	results, _, err := client.Checks.WaitForCheckSuite(ctx, "o", "r", "main", pollOpts)
+	if err != nil {
+		t.Fatalf("Checks.WaitForCheckSuite returned error: %v", err)
+	}
+	if polls != 2 {
+		t.Errorf("Checks.WaitForCheckSuite polled %d times, want 2", polls)
+	}
+
+	want := &ListCheckRunsResults{
+		Total: Int(2),
+		CheckRuns: []*CheckRun{
+			{ID: Int64(1), Status: String("completed"), Conclusion: String("success")},
+			{ID: Int64(2), Status: String("completed"), Conclusion: String("failure")},
+		},
+	}
+	if !cmp.Equal(results, want) {
+		t.Errorf("Checks.WaitForCheckSuite returned %+v, want %+v", results, want)
+	}
+}
+
+func TestChecksService_WaitForCheckSuite_pages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits/main/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/commits/main/check-runs?page=2>; rel="next"`)
+			fmt.Fprint(w, `{"total_count":2,"check_runs":[{"id":1,"status":"completed"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"total_count":2,"check_runs":[{"id":2,"status":"completed"}]}`)
+		default:
+			t.Errorf("Unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	results, _, err := client.Checks.WaitForCheckSuite(ctx, "o", "r", "main", PollOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Checks.WaitForCheckSuite returned error: %v", err)
+	}
+
+	want := &ListCheckRunsResults{
+		Total: Int(2),
+		CheckRuns: []*CheckRun{
+			{ID: Int64(1), Status: String("completed")},
+			{ID: Int64(2), Status: String("completed")},
+		},
+	}
+	if !cmp.Equal(results, want) {
+		t.Errorf("Checks.WaitForCheckSuite returned %+v, want %+v", results, want)
+	}
+}
+
+func TestChecksService_WaitForCheckSuite_maxAttempts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var polls int
+	mux.HandleFunc("/repos/o/r/commits/main/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		fmt.Fprint(w, `{"total_count":1,"check_runs":[{"id":1,"status":"queued"}]}`)
+	})
+
+	pollOpts := PollOptions{Interval: time.Millisecond, MaxAttempts: 3}
+	ctx := context.Background()
+	results, _, err := client.Checks.WaitForCheckSuite(ctx, "o", "r", "main", pollOpts)
+	if err == nil {
+		t.Fatal("Checks.WaitForCheckSuite returned no error, want one")
+	}
+	if polls != 3 {
+		t.Errorf("Checks.WaitForCheckSuite polled %d times, want 3", polls)
+	}
+
+	want := &ListCheckRunsResults{Total: Int(1), CheckRuns: []*CheckRun{{ID: Int64(1), Status: String("queued")}}}
+	if !cmp.Equal(results, want) {
+		t.Errorf("Checks.WaitForCheckSuite returned %+v, want %+v", results, want)
+	}
+}
+
+func TestChecksService_WaitForCheckSuite_rateLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var polls int
+	mux.HandleFunc("/repos/o/r/commits/main/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls == 1 {
+			w.Header().Set(headerRateLimit, "60")
+			w.Header().Set(headerRateRemaining, "0")
+			w.Header().Set(headerRateReset, fmt.Sprint(time.Now().Unix()))
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"API rate limit exceeded for xxx.xxx.xxx.xxx."}`)
+			return
+		}
+		fmt.Fprint(w, `{"total_count":1,"check_runs":[{"id":1,"status":"completed"}]}`)
+	})
+
+	pollOpts := PollOptions{Interval: time.Millisecond, MaxAttempts: 2}
+	ctx := context.Background()
+	if _, _, err := client.Checks.WaitForCheckSuite(ctx, "o", "r", "main", pollOpts); err != nil {
+		t.Fatalf("Checks.WaitForCheckSuite returned error: %v", err)
+	}
+	if polls != 2 {
+		t.Errorf("Checks.WaitForCheckSuite polled %d times, want 2", polls)
+	}
+}
+
+func TestChecksService_WaitForCheckSuite_abuseRateLimitBackoff(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	saved := defaultRateLimitBackoff
+	defaultRateLimitBackoff = 20 * time.Millisecond
+	defer func() { defaultRateLimitBackoff = saved }()
+
+	var polls int
+	mux.HandleFunc("/repos/o/r/commits/main/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls == 1 {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{
+				"message": "You have triggered an abuse detection mechanism ...",
+				"documentation_url": "https://docs.github.com/en/free-pro-team@latest/rest/reference/#abuse-rate-limits"
+			}`)
+			return
+		}
+		fmt.Fprint(w, `{"total_count":1,"check_runs":[{"id":1,"status":"completed"}]}`)
+	})
+
+	pollOpts := PollOptions{Interval: time.Millisecond, MaxAttempts: 2}
+	ctx := context.Background()
+	start := time.Now()
+	if _, _, err := client.Checks.WaitForCheckSuite(ctx, "o", "r", "main", pollOpts); err != nil {
+		t.Fatalf("Checks.WaitForCheckSuite returned error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < defaultRateLimitBackoff {
+		t.Errorf("Checks.WaitForCheckSuite retried after %v, want at least %v", elapsed, defaultRateLimitBackoff)
+	}
+	if polls != 2 {
+		t.Errorf("Checks.WaitForCheckSuite polled %d times, want 2", polls)
+	}
+}
+
+func TestChecksService_WaitForCheckSuite_pollInterval(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var polls int
+	mux.HandleFunc("/repos/o/r/commits/main/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		w.Header().Set(headerPollInterval, "3600")
+		fmt.Fprint(w, `{"total_count":1,"check_runs":[{"id":1,"status":"in_progress"}]}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	results, _, err := client.Checks.WaitForCheckSuite(ctx, "o", "r", "main", PollOptions{Interval: time.Millisecond})
+	if err != context.Canceled {
+		t.Errorf("Checks.WaitForCheckSuite returned error %v, want %v", err, context.Canceled)
+	}
+	if polls != 1 {
+		t.Errorf("Checks.WaitForCheckSuite polled %d times, want 1", polls)
+	}
+	if got := len(results.CheckRuns); got != 1 {
+		t.Errorf("Checks.WaitForCheckSuite returned %d check runs, want 1", got)
+	}
+}
+
+func TestChecksService_WaitForCheckSuite_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits/main/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	results, _, err := client.Checks.WaitForCheckSuite(ctx, "o", "r", "main", PollOptions{Interval: time.Millisecond})
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Checks.WaitForCheckSuite returned error %v, want *ErrorResponse", err)
+	}
+	if results != nil {
+		t.Errorf("Checks.WaitForCheckSuite returned %+v, want nil", results)
+	}
+
+	if _, _, err := client.Checks.WaitForCheckSuite(nil, "o", "r", "main", PollOptions{}); err != errNonNilContext {
+		t.Errorf("Checks.WaitForCheckSuite returned error %v with a nil context, want %v", err, errNonNilContext)
+	}
+}
@@ -6,8 +6,14 @@
 package github
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 )
@@ -82,7 +88,10 @@ type Alert struct {
 	DismissedBy        *User               `json:"dismissed_by,omitempty"`
 	DismissedAt        *Timestamp          `json:"dismissed_at,omitempty"`
 	DismissedReason    *string             `json:"dismissed_reason,omitempty"`
+	DismissedComment   *string             `json:"dismissed_comment,omitempty"`
 	InstancesURL       *string             `json:"instances_url,omitempty"`
+	// Repository is only populated by ListAlertsForOrg.
+	Repository *Repository `json:"repository,omitempty"`
 }
 
 // ID returns the ID associated with an alert. It is the number at the end of the security alert's URL.
@@ -107,14 +116,29 @@ func (a *Alert) ID() int64 {
 	return id
 }
 
-// AlertListOptions specifies optional parameters to the CodeScanningService.ListAlerts
-// method.
+// AlertListOptions specifies optional parameters to the CodeScanningService.ListAlertsForRepo
+// and CodeScanningService.ListAlertsForOrg methods.
 type AlertListOptions struct {
 	// State of the code scanning alerts to list. Set to closed to list only closed code scanning alerts. Default: open
 	State string `url:"state,omitempty"`
 
 	// Return code scanning alerts for a specific branch reference. The ref must be formatted as heads/<branch name>.
 	Ref string `url:"ref,omitempty"`
+
+	// Return code scanning alerts reported by a specific tool, e.g. "CodeQL".
+	ToolName string `url:"tool_name,omitempty"`
+
+	// Return code scanning alerts of a specific severity. Possible values are: critical, high, medium, low,
+	// warning, note, error.
+	Severity string `url:"severity,omitempty"`
+
+	// Sort specifies how to sort alerts. Possible values are: created, updated. Default: created
+	Sort string `url:"sort,omitempty"`
+
+	// Direction in which to sort alerts. Possible values are: asc, desc. Default: desc
+	Direction string `url:"direction,omitempty"`
+
+	ListCursorOptions
 }
 
 // ListAlertsForRepo lists code scanning alerts for a repository.
@@ -145,6 +169,35 @@ func (s *CodeScanningService) ListAlertsForRepo(ctx context.Context, owner, repo
 	return alerts, resp, nil
 }
 
+// ListAlertsForOrg lists code scanning alerts for all repositories in an organization.
+// Each returned alert has its Repository field populated.
+//
+// You must be an owner or security manager of the organization and use an access token with the
+// security_events scope to use this endpoint. GitHub Apps must have the security_events read
+// permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#list-code-scanning-alerts-for-an-organization
+func (s *CodeScanningService) ListAlertsForOrg(ctx context.Context, org string, opts *AlertListOptions) ([]*Alert, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/code-scanning/alerts", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alerts []*Alert
+	resp, err := s.client.Do(ctx, req, &alerts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alerts, resp, nil
+}
+
 // GetAlert gets a single code scanning alert for a repository.
 //
 // You must use an access token with the security_events scope to use this endpoint.
@@ -169,3 +222,161 @@ func (s *CodeScanningService) GetAlert(ctx context.Context, owner, repo string,
 
 	return a, resp, nil
 }
+
+// CodeScanningAlertState represents the state of a code scanning alert to
+// update.
+type CodeScanningAlertState struct {
+	// State is the state of the alert. Possible values are: open, dismissed.
+	State string `json:"state"`
+
+	// DismissedReason is the reason for dismissing the alert. It is required
+	// when State is dismissed. Possible values are: false positive,
+	// won't fix, used in tests.
+	DismissedReason *string `json:"dismissed_reason,omitempty"`
+
+	// DismissedComment is an optional comment on dismissing the alert.
+	DismissedComment *string `json:"dismissed_comment,omitempty"`
+}
+
+// validate checks that s describes a state GitHub accepts.
+func (s *CodeScanningAlertState) validate() error {
+	if s == nil {
+		return errors.New("alert state must be provided")
+	}
+	return validateAlertState(s.State, s.GetDismissedReason(), "open", "dismissed",
+		"false positive", "won't fix", "used in tests")
+}
+
+// UpdateAlert updates the state of a single code scanning alert for a
+// repository, to dismiss it with a reason or to reopen it.
+//
+// You must use an access token with the security_events scope to use this endpoint.
+// GitHub Apps must have the security_events write permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#update-a-code-scanning-alert
+func (s *CodeScanningService) UpdateAlert(ctx context.Context, owner, repo string, id int64, state *CodeScanningAlertState) (*Alert, *Response, error) {
+	if err := state.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/alerts/%v", owner, repo, id)
+	req, err := s.client.NewRequest("PATCH", u, state)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(Alert)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// SarifAnalysis specifies the results of a code scanning job.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning#upload-an-analysis-as-sarif-data
+type SarifAnalysis struct {
+	CommitSHA *string `json:"commit_sha,omitempty"`
+	Ref       *string `json:"ref,omitempty"`
+	// Sarif holds the SARIF results, compressed with gzip and encoded in base64.
+	Sarif       *string    `json:"sarif,omitempty"`
+	CheckoutURI *string    `json:"checkout_uri,omitempty"`
+	StartedAt   *Timestamp `json:"started_at,omitempty"`
+	ToolName    *string    `json:"tool_name,omitempty"`
+}
+
+// SarifID identifies a SARIF upload.
+type SarifID struct {
+	ID  *string `json:"id,omitempty"`
+	URL *string `json:"url,omitempty"`
+}
+
+// SarifUpload represents the processing status of a SARIF upload.
+type SarifUpload struct {
+	// Possible values for ProcessingStatus are: pending, complete, failed.
+	ProcessingStatus *string  `json:"processing_status,omitempty"`
+	AnalysesURL      *string  `json:"analyses_url,omitempty"`
+	Errors           []string `json:"errors,omitempty"`
+}
+
+// UploadSarif uploads the result of code scanning job to GitHub.
+// The Sarif field of sarif must already be gzip-compressed and base64-encoded;
+// use UploadSarifFile to upload a plain SARIF document.
+//
+// You must use an access token with the security_events scope to use this endpoint.
+// GitHub Apps must have the security_events write permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning#upload-an-analysis-as-sarif-data
+func (s *CodeScanningService) UploadSarif(ctx context.Context, owner, repo string, sarif *SarifAnalysis) (*SarifID, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/sarifs", owner, repo)
+
+	req, err := s.client.NewRequest("POST", u, sarif)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sarifID := new(SarifID)
+	resp, err := s.client.Do(ctx, req, sarifID)
+	if err != nil {
+		// GitHub processes uploads asynchronously and responds with 202 Accepted.
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, sarifID); err != nil {
+				return nil, resp, err
+			}
+
+			return sarifID, resp, nil
+		}
+		return nil, resp, err
+	}
+
+	return sarifID, resp, nil
+}
+
+// UploadSarifFile reads a plain SARIF document from r, compresses it with gzip,
+// encodes it in base64 and uploads it as the analysis of commitSHA on ref.
+// The returned SarifID can be passed to GetSarifUploadInformation to poll
+// the processing status of the upload.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning#upload-an-analysis-as-sarif-data
+func (s *CodeScanningService) UploadSarifFile(ctx context.Context, owner, repo string, r io.Reader, commitSHA, ref string) (*SarifID, *Response, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := io.Copy(zw, r); err != nil {
+		return nil, nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	sarif := &SarifAnalysis{
+		CommitSHA: String(commitSHA),
+		Ref:       String(ref),
+		Sarif:     String(base64.StdEncoding.EncodeToString(buf.Bytes())),
+	}
+	return s.UploadSarif(ctx, owner, repo, sarif)
+}
+
+// GetSarifUploadInformation gets the processing status of a SARIF upload.
+//
+// You must use an access token with the security_events scope to use this endpoint.
+// GitHub Apps must have the security_events read permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning#get-information-about-a-sarif-upload
+func (s *CodeScanningService) GetSarifUploadInformation(ctx context.Context, owner, repo, sarifID string) (*SarifUpload, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/sarifs/%v", owner, repo, sarifID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	upload := new(SarifUpload)
+	resp, err := s.client.Do(ctx, req, upload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return upload, resp, nil
+}
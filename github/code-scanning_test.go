@@ -6,9 +6,15 @@
 package github
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -247,6 +253,71 @@ func TestActionsService_ListAlertsForRepo(t *testing.T) {
 	})
 }
 
+func TestCodeScanningService_ListAlertsForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/code-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"tool_name": "CodeQL",
+			"severity":  "high",
+			"sort":      "updated",
+			"direction": "asc",
+			"after":     "c1",
+		})
+		w.Header().Set("Link", `<https://api.github.com/orgs/o/code-scanning/alerts?after=c2>; rel="next"`)
+		fmt.Fprint(w, `[{
+			"rule": {"id": "js/sql-injection", "security_severity_level": "high"},
+			"tool": {"name": "CodeQL", "version": "2.4.0"},
+			"state": "open",
+			"html_url": "https://github.com/o/r/security/code-scanning/7",
+			"repository": {"id": 1, "name": "r", "full_name": "o/r"}
+		}]`)
+	})
+
+	opts := &AlertListOptions{
+		ToolName:          "CodeQL",
+		Severity:          "high",
+		Sort:              "updated",
+		Direction:         "asc",
+		ListCursorOptions: ListCursorOptions{After: "c1"},
+	}
+	ctx := context.Background()
+	alerts, resp, err := client.CodeScanning.ListAlertsForOrg(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("CodeScanning.ListAlertsForOrg returned error: %v", err)
+	}
+
+	want := []*Alert{{
+		Rule:       &Rule{ID: String("js/sql-injection"), SecuritySeverityLevel: String("high")},
+		Tool:       &Tool{Name: String("CodeQL"), Version: String("2.4.0")},
+		State:      String("open"),
+		HTMLURL:    String("https://github.com/o/r/security/code-scanning/7"),
+		Repository: &Repository{ID: Int64(1), Name: String("r"), FullName: String("o/r")},
+	}}
+	if !cmp.Equal(alerts, want) {
+		t.Errorf("CodeScanning.ListAlertsForOrg returned %+v, want %+v", alerts, want)
+	}
+	if got, want := resp.After, "c2"; got != want {
+		t.Errorf("CodeScanning.ListAlertsForOrg returned resp.After %q, want %q", got, want)
+	}
+
+	const methodName = "ListAlertsForOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.ListAlertsForOrg(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.ListAlertsForOrg(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestActionsService_GetAlert(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -430,3 +501,232 @@ func TestTool_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestCodeScanningService_UploadSarif(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SarifAnalysis{CommitSHA: String("abc"), Ref: String("refs/heads/main"), Sarif: String("c2FyaWY=")}
+
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"commit_sha":"abc","ref":"refs/heads/main","sarif":"c2FyaWY="}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":"47177e22","url":"https://api.github.com/repos/o/r/code-scanning/sarifs/47177e22"}`)
+	})
+
+	ctx := context.Background()
+	sarifID, _, err := client.CodeScanning.UploadSarif(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("CodeScanning.UploadSarif returned error: %v", err)
+	}
+
+	want := &SarifID{ID: String("47177e22"), URL: String("https://api.github.com/repos/o/r/code-scanning/sarifs/47177e22")}
+	if !cmp.Equal(sarifID, want) {
+		t.Errorf("CodeScanning.UploadSarif returned %+v, want %+v", sarifID, want)
+	}
+
+	const methodName = "UploadSarif"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.UploadSarif(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.UploadSarif(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodeScanningService_UploadSarifFile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const sarif = `{"version":"2.1.0","runs":[]}`
+
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := new(SarifAnalysis)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatalf("Decode returned error: %v", err)
+		}
+		if got, want := v.GetCommitSHA(), "abc"; got != want {
+			t.Errorf("Request commit_sha = %q, want %q", got, want)
+		}
+		if got, want := v.GetRef(), "refs/heads/main"; got != want {
+			t.Errorf("Request ref = %q, want %q", got, want)
+		}
+
+		compressed, err := base64.StdEncoding.DecodeString(v.GetSarif())
+		if err != nil {
+			t.Fatalf("Request sarif is not base64: %v", err)
+		}
+		zr, err := gzip.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			t.Fatalf("Request sarif is not gzip: %v", err)
+		}
+		data, err := ioutil.ReadAll(zr)
+		if err != nil {
+			t.Fatalf("Reading gzip data returned error: %v", err)
+		}
+		if got := string(data); got != sarif {
+			t.Errorf("Request sarif = %q, want %q", got, sarif)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":"47177e22"}`)
+	})
+
+	ctx := context.Background()
+	sarifID, _, err := client.CodeScanning.UploadSarifFile(ctx, "o", "r", strings.NewReader(sarif), "abc", "refs/heads/main")
+	if err != nil {
+		t.Errorf("CodeScanning.UploadSarifFile returned error: %v", err)
+	}
+
+	want := &SarifID{ID: String("47177e22")}
+	if !cmp.Equal(sarifID, want) {
+		t.Errorf("CodeScanning.UploadSarifFile returned %+v, want %+v", sarifID, want)
+	}
+}
+
+func TestCodeScanningService_GetSarifUploadInformation(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	status := "pending"
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs/47177e22", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"processing_status":%q,"analyses_url":"https://api.github.com/repos/o/r/code-scanning/analyses?sarif_id=47177e22"}`, status)
+	})
+
+	ctx := context.Background()
+	upload, _, err := client.CodeScanning.GetSarifUploadInformation(ctx, "o", "r", "47177e22")
+	if err != nil {
+		t.Errorf("CodeScanning.GetSarifUploadInformation returned error: %v", err)
+	}
+	if got, want := upload.GetProcessingStatus(), "pending"; got != want {
+		t.Errorf("CodeScanning.GetSarifUploadInformation returned status %q, want %q", got, want)
+	}
+
+	status = "complete"
+	upload, _, err = client.CodeScanning.GetSarifUploadInformation(ctx, "o", "r", "47177e22")
+	if err != nil {
+		t.Errorf("CodeScanning.GetSarifUploadInformation returned error: %v", err)
+	}
+
+	want := &SarifUpload{
+		ProcessingStatus: String("complete"),
+		AnalysesURL:      String("https://api.github.com/repos/o/r/code-scanning/analyses?sarif_id=47177e22"),
+	}
+	if !cmp.Equal(upload, want) {
+		t.Errorf("CodeScanning.GetSarifUploadInformation returned %+v, want %+v", upload, want)
+	}
+
+	const methodName = "GetSarifUploadInformation"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.GetSarifUploadInformation(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.GetSarifUploadInformation(ctx, "o", "r", "47177e22")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodeScanningService_UpdateAlert_dismiss(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CodeScanningAlertState{
+		State:            "dismissed",
+		DismissedReason:  String("false positive"),
+		DismissedComment: String("input is validated upstream"),
+	}
+
+	mux.HandleFunc("/repos/o/r/code-scanning/alerts/88", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"dismissed","dismissed_reason":"false positive","dismissed_comment":"input is validated upstream"}`+"\n")
+		fmt.Fprint(w, `{"rule_id":"js/trivial-conditional","state":"dismissed","dismissed_reason":"false positive","dismissed_comment":"input is validated upstream"}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.CodeScanning.UpdateAlert(ctx, "o", "r", 88, input)
+	if err != nil {
+		t.Errorf("CodeScanning.UpdateAlert returned error: %v", err)
+	}
+
+	want := &Alert{
+		RuleID:           String("js/trivial-conditional"),
+		State:            String("dismissed"),
+		DismissedReason:  String("false positive"),
+		DismissedComment: String("input is validated upstream"),
+	}
+	if !cmp.Equal(alert, want) {
+		t.Errorf("CodeScanning.UpdateAlert returned %+v, want %+v", alert, want)
+	}
+
+	const methodName = "UpdateAlert"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.UpdateAlert(ctx, "\n", "\n", 88, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.UpdateAlert(ctx, "o", "r", 88, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodeScanningService_UpdateAlert_reopen(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/alerts/88", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"open"}`+"\n")
+		fmt.Fprint(w, `{"state":"open"}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.CodeScanning.UpdateAlert(ctx, "o", "r", 88, &CodeScanningAlertState{State: "open"})
+	if err != nil {
+		t.Errorf("CodeScanning.UpdateAlert returned error: %v", err)
+	}
+	if want := (&Alert{State: String("open")}); !cmp.Equal(alert, want) {
+		t.Errorf("CodeScanning.UpdateAlert returned %+v, want %+v", alert, want)
+	}
+}
+
+func TestCodeScanningService_UpdateAlert_invalid(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/alerts/88", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("CodeScanning.UpdateAlert sent a request for an invalid state")
+	})
+
+	tests := []*CodeScanningAlertState{
+		nil,
+		{State: "fixed"},
+		{State: "dismissed"},
+		{State: "dismissed", DismissedReason: String("false_positive")},
+	}
+
+	ctx := context.Background()
+	for _, input := range tests {
+		if _, _, err := client.CodeScanning.UpdateAlert(ctx, "o", "r", 88, input); err == nil {
+			t.Errorf("CodeScanning.UpdateAlert(%+v) returned no error", input)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// CodespacesService handles communication with the codespaces related
+// methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/codespaces
+type CodespacesService service
+
+// Codespace represents a codespace.
+type Codespace struct {
+	ID            *int64             `json:"id,omitempty"`
+	Name          *string            `json:"name,omitempty"`
+	DisplayName   *string            `json:"display_name,omitempty"`
+	EnvironmentID *string            `json:"environment_id,omitempty"`
+	Owner         *User              `json:"owner,omitempty"`
+	BillableOwner *User              `json:"billable_owner,omitempty"`
+	Repository    *Repository        `json:"repository,omitempty"`
+	Machine       *CodespacesMachine `json:"machine,omitempty"`
+	// Possible values for State are: Unknown, Created, Queued, Provisioning,
+	// Available, Awaiting, Unavailable, Deleted, Moved, Shutdown, Archived,
+	// Starting, ShuttingDown, Failed, Exporting, Updating, Rebuilding.
+	State              *string              `json:"state,omitempty"`
+	GitStatus          *CodespacesGitStatus `json:"git_status,omitempty"`
+	Location           *string              `json:"location,omitempty"`
+	IdleTimeoutMinutes *int                 `json:"idle_timeout_minutes,omitempty"`
+	CreatedAt          *Timestamp           `json:"created_at,omitempty"`
+	UpdatedAt          *Timestamp           `json:"updated_at,omitempty"`
+	LastUsedAt         *Timestamp           `json:"last_used_at,omitempty"`
+	URL                *string              `json:"url,omitempty"`
+	WebURL             *string              `json:"web_url,omitempty"`
+	MachinesURL        *string              `json:"machines_url,omitempty"`
+	StartURL           *string              `json:"start_url,omitempty"`
+	StopURL            *string              `json:"stop_url,omitempty"`
+}
+
+// CodespacesGitStatus represents the git status of a codespace.
+type CodespacesGitStatus struct {
+	Ahead                 *int    `json:"ahead,omitempty"`
+	Behind                *int    `json:"behind,omitempty"`
+	HasUnpushedChanges    *bool   `json:"has_unpushed_changes,omitempty"`
+	HasUncommittedChanges *bool   `json:"has_uncommitted_changes,omitempty"`
+	Ref                   *string `json:"ref,omitempty"`
+}
+
+// CodespacesMachine represents the machine type of a codespace.
+type CodespacesMachine struct {
+	Name                 *string `json:"name,omitempty"`
+	DisplayName          *string `json:"display_name,omitempty"`
+	OperatingSystem      *string `json:"operating_system,omitempty"`
+	StorageInBytes       *int64  `json:"storage_in_bytes,omitempty"`
+	MemoryInBytes        *int64  `json:"memory_in_bytes,omitempty"`
+	CPUs                 *int    `json:"cpus,omitempty"`
+	PrebuildAvailability *string `json:"prebuild_availability,omitempty"`
+}
+
+// ListCodespaces represents a list of codespaces, along with the total
+// number of codespaces.
+type ListCodespaces struct {
+	TotalCount *int         `json:"total_count,omitempty"`
+	Codespaces []*Codespace `json:"codespaces"`
+}
+
+// ListInOrganization lists the codespaces of an organization.
+// The authenticated user must be an administrator of the organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/codespaces#list-codespaces-for-the-organization
+func (s *CodespacesService) ListInOrganization(ctx context.Context, org string, opts *ListOptions) (*ListCodespaces, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/codespaces", org)
+	return s.listCodespaces(ctx, u, opts)
+}
+
+// ListOrgMemberCodespaces lists the codespaces that a member of an
+// organization has for repositories of the organization.
+// The authenticated user must be an administrator of the organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/codespaces#list-codespaces-for-a-user-in-organization
+func (s *CodespacesService) ListOrgMemberCodespaces(ctx context.Context, org, username string, opts *ListOptions) (*ListCodespaces, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/members/%v/codespaces", org, username)
+	return s.listCodespaces(ctx, u, opts)
+}
+
+func (s *CodespacesService) listCodespaces(ctx context.Context, u string, opts *ListOptions) (*ListCodespaces, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codespaces := new(ListCodespaces)
+	resp, err := s.client.Do(ctx, req, codespaces)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return codespaces, resp, nil
+}
+
+// StopOrgMemberCodespace stops a codespace of a member of an organization.
+// The authenticated user must be an administrator of the organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/codespaces#stop-a-codespace-for-an-organization-user
+func (s *CodespacesService) StopOrgMemberCodespace(ctx context.Context, org, username, codespaceName string) (*Codespace, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/members/%v/codespaces/%v/stop", org, username, codespaceName)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codespace := new(Codespace)
+	resp, err := s.client.Do(ctx, req, codespace)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return codespace, resp, nil
+}
+
+// DeleteOrgMemberCodespace deletes a codespace of a member of an
+// organization. The authenticated user must be an administrator of the
+// organization.
+//
+// GitHub deletes the codespace asynchronously and responds with 202 Accepted,
+// which is returned as an *AcceptedError.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/codespaces#delete-a-codespace-from-the-organization
+func (s *CodespacesService) DeleteOrgMemberCodespace(ctx context.Context, org, username, codespaceName string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/members/%v/codespaces/%v", org, username, codespaceName)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
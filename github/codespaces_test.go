@@ -0,0 +1,157 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCodespacesService_ListInOrganization(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/codespaces", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"total_count":3,"codespaces":[{"id":1,"name":"c1","state":"Available","owner":{"login":"u"},"git_status":{"ahead":1,"ref":"main"}}]}`)
+	})
+
+	opts := &ListOptions{Page: 2}
+	ctx := context.Background()
+	codespaces, _, err := client.Codespaces.ListInOrganization(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Codespaces.ListInOrganization returned error: %v", err)
+	}
+
+	want := &ListCodespaces{
+		TotalCount: Int(3),
+		Codespaces: []*Codespace{{
+			ID:        Int64(1),
+			Name:      String("c1"),
+			State:     String("Available"),
+			Owner:     &User{Login: String("u")},
+			GitStatus: &CodespacesGitStatus{Ahead: Int(1), Ref: String("main")},
+		}},
+	}
+	if !cmp.Equal(codespaces, want) {
+		t.Errorf("Codespaces.ListInOrganization returned %+v, want %+v", codespaces, want)
+	}
+
+	const methodName = "ListInOrganization"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.ListInOrganization(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.ListInOrganization(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_ListOrgMemberCodespaces(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/members/u/codespaces", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"codespaces":[{"id":1}]}`)
+	})
+
+	ctx := context.Background()
+	codespaces, _, err := client.Codespaces.ListOrgMemberCodespaces(ctx, "o", "u", nil)
+	if err != nil {
+		t.Errorf("Codespaces.ListOrgMemberCodespaces returned error: %v", err)
+	}
+
+	want := &ListCodespaces{TotalCount: Int(1), Codespaces: []*Codespace{{ID: Int64(1)}}}
+	if !cmp.Equal(codespaces, want) {
+		t.Errorf("Codespaces.ListOrgMemberCodespaces returned %+v, want %+v", codespaces, want)
+	}
+
+	const methodName = "ListOrgMemberCodespaces"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.ListOrgMemberCodespaces(ctx, "\n", "\n", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.ListOrgMemberCodespaces(ctx, "o", "u", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_StopOrgMemberCodespace(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/members/u/codespaces/c1/stop", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":1,"name":"c1","state":"ShuttingDown"}`)
+	})
+
+	ctx := context.Background()
+	codespace, _, err := client.Codespaces.StopOrgMemberCodespace(ctx, "o", "u", "c1")
+	if err != nil {
+		t.Errorf("Codespaces.StopOrgMemberCodespace returned error: %v", err)
+	}
+
+	want := &Codespace{ID: Int64(1), Name: String("c1"), State: String("ShuttingDown")}
+	if !cmp.Equal(codespace, want) {
+		t.Errorf("Codespaces.StopOrgMemberCodespace returned %+v, want %+v", codespace, want)
+	}
+
+	const methodName = "StopOrgMemberCodespace"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Codespaces.StopOrgMemberCodespace(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Codespaces.StopOrgMemberCodespace(ctx, "o", "u", "c1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodespacesService_DeleteOrgMemberCodespace(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/members/u/codespaces/c1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	_, err := client.Codespaces.DeleteOrgMemberCodespace(ctx, "o", "u", "c1")
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("Codespaces.DeleteOrgMemberCodespace returned error %v, want *AcceptedError", err)
+	}
+
+	const methodName = "DeleteOrgMemberCodespace"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Codespaces.DeleteOrgMemberCodespace(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Codespaces.DeleteOrgMemberCodespace(ctx, "o", "u", "c1")
+	})
+}
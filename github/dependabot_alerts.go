@@ -0,0 +1,218 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Dependency represents the vulnerable dependency of a DependabotAlert.
+type Dependency struct {
+	Package      *VulnerabilityPackage `json:"package,omitempty"`
+	ManifestPath *string               `json:"manifest_path,omitempty"`
+	// Possible values for Scope are: development, runtime.
+	Scope *string `json:"scope,omitempty"`
+}
+
+// FirstPatchedVersion represents the first version of a package that is not
+// affected by a DependabotVulnerability.
+type FirstPatchedVersion struct {
+	Identifier *string `json:"identifier,omitempty"`
+}
+
+// DependabotVulnerability represents a vulnerability of a package described
+// by a DependabotSecurityAdvisory.
+type DependabotVulnerability struct {
+	Package *VulnerabilityPackage `json:"package,omitempty"`
+	// Possible values for Severity are: critical, high, medium, low.
+	Severity               *string              `json:"severity,omitempty"`
+	VulnerableVersionRange *string              `json:"vulnerable_version_range,omitempty"`
+	FirstPatchedVersion    *FirstPatchedVersion `json:"first_patched_version,omitempty"`
+}
+
+// DependabotSecurityAdvisory represents the security advisory a
+// DependabotAlert was raised for.
+type DependabotSecurityAdvisory struct {
+	GHSAID      *string `json:"ghsa_id,omitempty"`
+	CVEID       *string `json:"cve_id,omitempty"`
+	Summary     *string `json:"summary,omitempty"`
+	Description *string `json:"description,omitempty"`
+	// Possible values for Severity are: critical, high, medium, low.
+	Severity        *string                    `json:"severity,omitempty"`
+	Vulnerabilities []*DependabotVulnerability `json:"vulnerabilities,omitempty"`
+	CVSS            *AdvisoryCVSS              `json:"cvss,omitempty"`
+	CWEs            []*AdvisoryCWEs            `json:"cwes,omitempty"`
+	Identifiers     []*AdvisoryIdentifier      `json:"identifiers,omitempty"`
+	References      []*AdvisoryReference       `json:"references,omitempty"`
+	PublishedAt     *Timestamp                 `json:"published_at,omitempty"`
+	UpdatedAt       *Timestamp                 `json:"updated_at,omitempty"`
+	WithdrawnAt     *Timestamp                 `json:"withdrawn_at,omitempty"`
+}
+
+// DependabotAlert represents a Dependabot alert.
+type DependabotAlert struct {
+	Number *int `json:"number,omitempty"`
+	// Possible values for State are: auto_dismissed, dismissed, fixed, open.
+	State                 *string                     `json:"state,omitempty"`
+	Dependency            *Dependency                 `json:"dependency,omitempty"`
+	SecurityAdvisory      *DependabotSecurityAdvisory `json:"security_advisory,omitempty"`
+	SecurityVulnerability *DependabotVulnerability    `json:"security_vulnerability,omitempty"`
+	URL                   *string                     `json:"url,omitempty"`
+	HTMLURL               *string                     `json:"html_url,omitempty"`
+	CreatedAt             *Timestamp                  `json:"created_at,omitempty"`
+	UpdatedAt             *Timestamp                  `json:"updated_at,omitempty"`
+	DismissedAt           *Timestamp                  `json:"dismissed_at,omitempty"`
+	DismissedBy           *User                       `json:"dismissed_by,omitempty"`
+	// Possible values for DismissedReason are: fix_started, inaccurate,
+	// no_bandwidth, not_used, tolerable_risk.
+	DismissedReason  *string    `json:"dismissed_reason,omitempty"`
+	DismissedComment *string    `json:"dismissed_comment,omitempty"`
+	FixedAt          *Timestamp `json:"fixed_at,omitempty"`
+	// Repository is only populated by ListOrgAlerts.
+	Repository *Repository `json:"repository,omitempty"`
+}
+
+// ListAlertsOptions specifies the optional parameters to the
+// DependabotService.ListRepoAlerts and DependabotService.ListOrgAlerts
+// methods. The State, Severity, Ecosystem and Package filters accept a
+// comma-separated list of values.
+type ListAlertsOptions struct {
+	// State filters alerts by state. Possible values are: auto_dismissed,
+	// dismissed, fixed, open.
+	State string `url:"state,omitempty"`
+
+	// Severity filters alerts by severity. Possible values are: low, medium,
+	// high, critical.
+	Severity string `url:"severity,omitempty"`
+
+	// Ecosystem filters alerts by package ecosystem, such as npm or pip.
+	Ecosystem string `url:"ecosystem,omitempty"`
+
+	// Package filters alerts by package name.
+	Package string `url:"package,omitempty"`
+
+	// Scope filters alerts by the scope of the vulnerable dependency.
+	// Possible values are: development, runtime.
+	Scope string `url:"scope,omitempty"`
+
+	// Sort specifies how to sort alerts. Possible values are: created,
+	// updated. Default: created
+	Sort string `url:"sort,omitempty"`
+
+	// Direction in which to sort alerts. Possible values are: asc, desc.
+	// Default: desc
+	Direction string `url:"direction,omitempty"`
+
+	ListCursorOptions
+}
+
+func (s *DependabotService) listAlerts(ctx context.Context, url string, opts *ListAlertsOptions) ([]*DependabotAlert, *Response, error) {
+	u, err := addOptions(url, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alerts []*DependabotAlert
+	resp, err := s.client.Do(ctx, req, &alerts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alerts, resp, nil
+}
+
+// ListRepoAlerts lists all Dependabot alerts of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot#list-dependabot-alerts-for-a-repository
+func (s *DependabotService) ListRepoAlerts(ctx context.Context, owner, repo string, opts *ListAlertsOptions) ([]*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependabot/alerts", owner, repo)
+	return s.listAlerts(ctx, u, opts)
+}
+
+// ListOrgAlerts lists all Dependabot alerts of an organization, across all of
+// its repositories. Each alert includes its Repository. Results are paginated
+// with cursors: set opts.After to Response.After to get the next page.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot#list-dependabot-alerts-for-an-organization
+func (s *DependabotService) ListOrgAlerts(ctx context.Context, org string, opts *ListAlertsOptions) ([]*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/dependabot/alerts", org)
+	return s.listAlerts(ctx, u, opts)
+}
+
+// DependabotAlertState represents the state of a Dependabot alert to update.
+type DependabotAlertState struct {
+	// State is the state of the alert. Possible values are: dismissed, open.
+	State string `json:"state"`
+
+	// DismissedReason is the reason for dismissing the alert. It is required
+	// when State is dismissed. Possible values are: fix_started, inaccurate,
+	// no_bandwidth, not_used, tolerable_risk.
+	DismissedReason *string `json:"dismissed_reason,omitempty"`
+
+	// DismissedComment is an optional comment on dismissing the alert.
+	DismissedComment *string `json:"dismissed_comment,omitempty"`
+}
+
+// validate checks that s describes a state GitHub accepts.
+func (s *DependabotAlertState) validate() error {
+	if s == nil {
+		return errors.New("alert state must be provided")
+	}
+	return validateAlertState(s.State, s.GetDismissedReason(), "open", "dismissed",
+		"fix_started", "inaccurate", "no_bandwidth", "not_used", "tolerable_risk")
+}
+
+// validateAlertState checks that state is either open or closed, the names
+// of the open and closed states of an alert, and that a closed alert has one
+// of the given reasons. It is shared by the security alert services.
+func validateAlertState(state, reason string, open, closed string, reasons ...string) error {
+	switch state {
+	case open:
+		return nil
+	case closed:
+		if reason == "" {
+			return fmt.Errorf("a reason is required when the alert state is %v", closed)
+		}
+		for _, r := range reasons {
+			if reason == r {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid reason %q for alert state %v", reason, closed)
+	}
+	return fmt.Errorf("invalid alert state %q: must be %v or %v", state, open, closed)
+}
+
+// UpdateAlert updates the state of a Dependabot alert of a repository, to
+// dismiss it with a reason or to reopen it.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot#update-a-dependabot-alert
+func (s *DependabotService) UpdateAlert(ctx context.Context, owner, repo string, number int, state *DependabotAlertState) (*DependabotAlert, *Response, error) {
+	if err := state.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/dependabot/alerts/%v", owner, repo, number)
+	req, err := s.client.NewRequest("PATCH", u, state)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(DependabotAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, nil
+}
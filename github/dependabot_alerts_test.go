@@ -0,0 +1,258 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDependabotService_ListRepoAlerts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "open"})
+		fmt.Fprint(w, `[{"number":1,"state":"open"},{"number":42,"state":"fixed"}]`)
+	})
+
+	opts := &ListAlertsOptions{State: "open"}
+	ctx := context.Background()
+	alerts, _, err := client.Dependabot.ListRepoAlerts(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Dependabot.ListRepoAlerts returned error: %v", err)
+	}
+
+	want := []*DependabotAlert{
+		{Number: Int(1), State: String("open")},
+		{Number: Int(42), State: String("fixed")},
+	}
+	if !cmp.Equal(alerts, want) {
+		t.Errorf("Dependabot.ListRepoAlerts returned %+v, want %+v", alerts, want)
+	}
+
+	const methodName = "ListRepoAlerts"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Dependabot.ListRepoAlerts(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Dependabot.ListRepoAlerts(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDependabotService_ListOrgAlerts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("after") {
+		case "":
+			testFormValues(t, r, values{"ecosystem": "npm", "severity": "critical,high", "per_page": "1"})
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/dependabot/alerts?ecosystem=npm&severity=critical%2Chigh&per_page=1&after=Y3Vyc29y>; rel="next"`)
+			fmt.Fprint(w, `[{
+				"number": 1,
+				"state": "open",
+				"dependency": {"package": {"ecosystem": "npm", "name": "lodash"}, "manifest_path": "package-lock.json", "scope": "runtime"},
+				"security_advisory": {"ghsa_id": "GHSA-rrrr-rrrr-rrrr", "severity": "critical"},
+				"security_vulnerability": {"package": {"ecosystem": "npm", "name": "lodash"}, "severity": "critical", "vulnerable_version_range": "< 4.17.21", "first_patched_version": {"identifier": "4.17.21"}},
+				"created_at": "2021-01-02T00:00:00Z",
+				"repository": {"id": 1, "name": "r1"}
+			}]`)
+		case "Y3Vyc29y":
+			testFormValues(t, r, values{"ecosystem": "npm", "severity": "critical,high", "per_page": "1", "after": "Y3Vyc29y"})
+			fmt.Fprint(w, `[{"number":7,"state":"open","security_advisory":{"severity":"high"},"repository":{"id":2,"name":"r2"}}]`)
+		default:
+			t.Errorf("Unexpected cursor %q", r.FormValue("after"))
+		}
+	})
+
+	opts := &ListAlertsOptions{Ecosystem: "npm", Severity: "critical,high", ListCursorOptions: ListCursorOptions{PerPage: 1}}
+	ctx := context.Background()
+	var alerts []*DependabotAlert
+	for {
+		page, resp, err := client.Dependabot.ListOrgAlerts(ctx, "o", opts)
+		if err != nil {
+			t.Fatalf("Dependabot.ListOrgAlerts returned error: %v", err)
+		}
+		alerts = append(alerts, page...)
+		if resp.After == "" {
+			break
+		}
+		opts.After = resp.After
+	}
+
+	want := []*DependabotAlert{
+		{
+			Number: Int(1),
+			State:  String("open"),
+			Dependency: &Dependency{
+				Package:      &VulnerabilityPackage{Ecosystem: String("npm"), Name: String("lodash")},
+				ManifestPath: String("package-lock.json"),
+				Scope:        String("runtime"),
+			},
+			SecurityAdvisory: &DependabotSecurityAdvisory{GHSAID: String("GHSA-rrrr-rrrr-rrrr"), Severity: String("critical")},
+			SecurityVulnerability: &DependabotVulnerability{
+				Package:                &VulnerabilityPackage{Ecosystem: String("npm"), Name: String("lodash")},
+				Severity:               String("critical"),
+				VulnerableVersionRange: String("< 4.17.21"),
+				FirstPatchedVersion:    &FirstPatchedVersion{Identifier: String("4.17.21")},
+			},
+			CreatedAt:  &Timestamp{time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC)},
+			Repository: &Repository{ID: Int64(1), Name: String("r1")},
+		},
+		{
+			Number:           Int(7),
+			State:            String("open"),
+			SecurityAdvisory: &DependabotSecurityAdvisory{Severity: String("high")},
+			Repository:       &Repository{ID: Int64(2), Name: String("r2")},
+		},
+	}
+	if !cmp.Equal(alerts, want) {
+		t.Errorf("Dependabot.ListOrgAlerts returned %+v, want %+v", alerts, want)
+	}
+
+	const methodName = "ListOrgAlerts"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Dependabot.ListOrgAlerts(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Dependabot.ListOrgAlerts(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDependabotService_UpdateAlert_dismiss(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &DependabotAlertState{
+		State:            "dismissed",
+		DismissedReason:  String("tolerable_risk"),
+		DismissedComment: String("only used in CI"),
+	}
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"dismissed","dismissed_reason":"tolerable_risk","dismissed_comment":"only used in CI"}`+"\n")
+		fmt.Fprint(w, `{"number":42,"state":"dismissed","dismissed_reason":"tolerable_risk","dismissed_comment":"only used in CI"}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.Dependabot.UpdateAlert(ctx, "o", "r", 42, input)
+	if err != nil {
+		t.Errorf("Dependabot.UpdateAlert returned error: %v", err)
+	}
+
+	want := &DependabotAlert{
+		Number:           Int(42),
+		State:            String("dismissed"),
+		DismissedReason:  String("tolerable_risk"),
+		DismissedComment: String("only used in CI"),
+	}
+	if !cmp.Equal(alert, want) {
+		t.Errorf("Dependabot.UpdateAlert returned %+v, want %+v", alert, want)
+	}
+
+	const methodName = "UpdateAlert"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Dependabot.UpdateAlert(ctx, "\n", "\n", 42, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Dependabot.UpdateAlert(ctx, "o", "r", 42, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDependabotService_UpdateAlert_reopen(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"open"}`+"\n")
+		fmt.Fprint(w, `{"number":42,"state":"open"}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.Dependabot.UpdateAlert(ctx, "o", "r", 42, &DependabotAlertState{State: "open"})
+	if err != nil {
+		t.Errorf("Dependabot.UpdateAlert returned error: %v", err)
+	}
+
+	if want := (&DependabotAlert{Number: Int(42), State: String("open")}); !cmp.Equal(alert, want) {
+		t.Errorf("Dependabot.UpdateAlert returned %+v, want %+v", alert, want)
+	}
+}
+
+func TestDependabotService_UpdateAlert_invalid(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts/42", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Dependabot.UpdateAlert sent a request for an invalid state")
+	})
+
+	tests := []*DependabotAlertState{
+		nil,
+		{State: "fixed"},
+		{State: "dismissed"},
+		{State: "dismissed", DismissedReason: String("false positive")},
+	}
+
+	ctx := context.Background()
+	for _, input := range tests {
+		if _, _, err := client.Dependabot.UpdateAlert(ctx, "o", "r", 42, input); err == nil {
+			t.Errorf("Dependabot.UpdateAlert(%+v) returned no error", input)
+		}
+	}
+}
+
+func TestValidateAlertState(t *testing.T) {
+	tests := []struct {
+		state, reason string
+		want          string
+	}{
+		{state: "open"},
+		{state: "closed", reason: "r2"},
+		{state: "closed", want: "a reason is required when the alert state is closed"},
+		{state: "closed", reason: "r3", want: `invalid reason "r3" for alert state closed`},
+		{state: "fixed", want: `invalid alert state "fixed": must be open or closed`},
+	}
+
+	for _, tt := range tests {
+		err := validateAlertState(tt.state, tt.reason, "open", "closed", "r1", "r2")
+		var got string
+		if err != nil {
+			got = err.Error()
+		}
+		if got != tt.want {
+			t.Errorf("validateAlertState(%q, %q) returned error %q, want %q", tt.state, tt.reason, got, tt.want)
+		}
+	}
+}
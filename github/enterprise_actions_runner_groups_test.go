@@ -0,0 +1,58 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEnterpriseService_ListRunnerGroups(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":2,"runner_groups":[{"id":1,"name":"Default","visibility":"all","default":true,"runners_url":"https://api.github.com/enterprises/e/actions/runner_groups/1/runners","allows_public_repositories":true},{"id":2,"name":"g","visibility":"selected","default":false,"runners_url":"https://api.github.com/enterprises/e/actions/runner_groups/2/runners","allows_public_repositories":false}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	ctx := context.Background()
+	groups, _, err := client.Enterprise.ListRunnerGroups(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Enterprise.ListRunnerGroups returned error: %v", err)
+	}
+
+	want := &RunnerGroups{
+		TotalCount: 2,
+		RunnerGroups: []*RunnerGroup{
+			{ID: Int64(1), Name: String("Default"), Visibility: String("all"), Default: Bool(true), RunnersURL: String("https://api.github.com/enterprises/e/actions/runner_groups/1/runners"), AllowsPublicRepositories: Bool(true)},
+			{ID: Int64(2), Name: String("g"), Visibility: String("selected"), Default: Bool(false), RunnersURL: String("https://api.github.com/enterprises/e/actions/runner_groups/2/runners"), AllowsPublicRepositories: Bool(false)},
+		},
+	}
+	if !cmp.Equal(groups, want) {
+		t.Errorf("Enterprise.ListRunnerGroups returned %+v, want %+v", groups, want)
+	}
+
+	const methodName = "ListRunnerGroups"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.ListRunnerGroups(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.ListRunnerGroups(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
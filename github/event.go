@@ -7,6 +7,8 @@ package github
 
 import (
 	"encoding/json"
+	"fmt"
+	"reflect"
 	"time"
 )
 
@@ -26,10 +28,23 @@ func (e Event) String() string {
 	return Stringify(e)
 }
 
+// UnknownEventTypeError is returned by Event.ParsePayload when the type of
+// the event is not one go-github has a payload struct for.
+type UnknownEventTypeError struct {
+	Type string // The type of the event.
+}
+
+func (e *UnknownEventTypeError) Error() string {
+	return fmt.Sprintf("unknown event type %q", e.Type)
+}
+
 // ParsePayload parses the event payload. For recognized event types,
 // a value of the corresponding struct type will be returned.
+//
+// For other event types, the payload is returned decoded as a
+// map[string]interface{}, together with an *UnknownEventTypeError.
 func (e *Event) ParsePayload() (payload interface{}, err error) {
-	switch *e.Type {
+	switch e.GetType() {
 	case "CheckRunEvent":
 		payload = &CheckRunEvent{}
 	case "CheckSuiteEvent":
@@ -48,6 +63,8 @@ func (e *Event) ParsePayload() (payload interface{}, err error) {
 		payload = &DeploymentEvent{}
 	case "DeploymentStatusEvent":
 		payload = &DeploymentStatusEvent{}
+	case "DiscussionEvent":
+		payload = &DiscussionEvent{}
 	case "ForkEvent":
 		payload = &ForkEvent{}
 	case "GitHubAppAuthorizationEvent":
@@ -124,8 +141,17 @@ func (e *Event) ParsePayload() (payload interface{}, err error) {
 		payload = &WatchEvent{}
 	case "WorkflowDispatchEvent":
 		payload = &WorkflowDispatchEvent{}
+	case "WorkflowJobEvent":
+		payload = &WorkflowJobEvent{}
 	case "WorkflowRunEvent":
 		payload = &WorkflowRunEvent{}
+	default:
+		// Still decode the payload generically, for callers that want to
+		// inspect event types go-github doesn't know about yet.
+		if err := json.Unmarshal(*e.RawPayload, &payload); err != nil {
+			return nil, err
+		}
+		return payload, &UnknownEventTypeError{Type: e.GetType()}
 	}
 	err = json.Unmarshal(*e.RawPayload, &payload)
 	return payload, err
@@ -139,8 +165,32 @@ func (e *Event) ParsePayload() (payload interface{}, err error) {
 func (e *Event) Payload() (payload interface{}) {
 	var err error
 	payload, err = e.ParsePayload()
-	if err != nil {
+	if _, ok := err.(*UnknownEventTypeError); err != nil && !ok {
 		panic(err)
 	}
 	return payload
 }
+
+// installationType is the type of the Installation field of event payloads.
+var installationType = reflect.TypeOf((*Installation)(nil))
+
+// InstallationID returns the ID of the installation that event, a payload
+// such as returned by ParseWebHook or Event.ParsePayload, was delivered to,
+// and whether it has one. GitHub Apps need it to authenticate as the
+// installation when handling the event.
+func InstallationID(event interface{}) (int64, bool) {
+	v := reflect.ValueOf(event)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return 0, false
+	}
+
+	f := v.Elem().FieldByName("Installation")
+	if !f.IsValid() || f.Type() != installationType || f.IsNil() {
+		return 0, false
+	}
+	installation := f.Interface().(*Installation)
+	if installation.ID == nil {
+		return 0, false
+	}
+	return *installation.ID, true
+}
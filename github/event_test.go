@@ -30,6 +30,41 @@ func TestPayload_NoPanic(t *testing.T) {
 	e.Payload()
 }
 
+func TestInstallationID(t *testing.T) {
+	tests := []struct {
+		name   string
+		event  interface{}
+		wantID int64
+		wantOK bool
+	}{
+		{"PushEvent", &PushEvent{Installation: &Installation{ID: Int64(1)}}, 1, true},
+		{"IssuesEvent", &IssuesEvent{Installation: &Installation{ID: Int64(2)}}, 2, true},
+		{"no installation", &IssuesEvent{}, 0, false},
+		{"no installation ID", &PushEvent{Installation: &Installation{}}, 0, false},
+		{"no Installation field", &WorkflowRunEvent{}, 0, false},
+		{"not a pointer", PushEvent{Installation: &Installation{ID: Int64(1)}}, 0, false},
+		{"nil", nil, 0, false},
+		{"nil pointer", (*PushEvent)(nil), 0, false},
+	}
+
+	for _, tt := range tests {
+		id, ok := InstallationID(tt.event)
+		if id != tt.wantID || ok != tt.wantOK {
+			t.Errorf("InstallationID(%v) = %v, %v, want %v, %v", tt.name, id, ok, tt.wantID, tt.wantOK)
+		}
+	}
+}
+
+func TestInstallationID_parsedWebHook(t *testing.T) {
+	event, err := ParseWebHook("push", []byte(`{"ref":"refs/heads/main","installation":{"id":42}}`))
+	if err != nil {
+		t.Fatalf("ParseWebHook returned error: %v", err)
+	}
+	if id, ok := InstallationID(event); !ok || id != 42 {
+		t.Errorf("InstallationID = %v, %v, want 42, true", id, ok)
+	}
+}
+
 func TestEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Event{}, "{}")
 
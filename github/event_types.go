@@ -163,6 +163,62 @@ type DeploymentStatusEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// DiscussionEvent is triggered when a discussion in a repository is
+// created, edited, answered, or otherwise changed.
+// The Webhook event name is "discussion".
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhook-events-and-payloads#discussion
+type DiscussionEvent struct {
+	// Action is the action that was performed. Possible values are:
+	// created, edited, deleted, pinned, unpinned, locked, unlocked,
+	// transferred, category_changed, answered, unanswered, labeled, unlabeled.
+	Action     *string     `json:"action,omitempty"`
+	Discussion *Discussion `json:"discussion,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
+// Discussion represents a discussion in a GitHub repository.
+type Discussion struct {
+	ID                 *int64              `json:"id,omitempty"`
+	NodeID             *string             `json:"node_id,omitempty"`
+	Number             *int                `json:"number,omitempty"`
+	Title              *string             `json:"title,omitempty"`
+	Body               *string             `json:"body,omitempty"`
+	User               *User               `json:"user,omitempty"`
+	State              *string             `json:"state,omitempty"`
+	Locked             *bool               `json:"locked,omitempty"`
+	Comments           *int                `json:"comments,omitempty"`
+	AuthorAssociation  *string             `json:"author_association,omitempty"`
+	ActiveLockReason   *string             `json:"active_lock_reason,omitempty"`
+	DiscussionCategory *DiscussionCategory `json:"category,omitempty"`
+	AnswerHTMLURL      *string             `json:"answer_html_url,omitempty"`
+	AnswerChosenAt     *Timestamp          `json:"answer_chosen_at,omitempty"`
+	AnswerChosenBy     *string             `json:"answer_chosen_by,omitempty"`
+	HTMLURL            *string             `json:"html_url,omitempty"`
+	RepositoryURL      *string             `json:"repository_url,omitempty"`
+	CreatedAt          *Timestamp          `json:"created_at,omitempty"`
+	UpdatedAt          *Timestamp          `json:"updated_at,omitempty"`
+}
+
+// DiscussionCategory represents a discussion category in a GitHub repository.
+type DiscussionCategory struct {
+	ID           *int64     `json:"id,omitempty"`
+	NodeID       *string    `json:"node_id,omitempty"`
+	RepositoryID *int64     `json:"repository_id,omitempty"`
+	Emoji        *string    `json:"emoji,omitempty"`
+	Name         *string    `json:"name,omitempty"`
+	Description  *string    `json:"description,omitempty"`
+	Slug         *string    `json:"slug,omitempty"`
+	IsAnswerable *bool      `json:"is_answerable,omitempty"`
+	CreatedAt    *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt    *Timestamp `json:"updated_at,omitempty"`
+}
+
 // ForkEvent is triggered when a user forks a repository.
 // The Webhook event name is "fork".
 //
@@ -1083,6 +1139,21 @@ type WorkflowDispatchEvent struct {
 	Sender *User         `json:"sender,omitempty"`
 }
 
+// WorkflowJobEvent is triggered when a GitHub Actions workflow job is queued,
+// started or completed.
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhook-events-and-payloads#workflow_job
+type WorkflowJobEvent struct {
+	Action      *string      `json:"action,omitempty"`
+	WorkflowJob *WorkflowJob `json:"workflow_job,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Org          *Organization `json:"organization,omitempty"`
+	Repo         *Repository   `json:"repository,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
 // WorkflowRunEvent is triggered when a GitHub Actions workflow run is requested or completed.
 //
 // GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhook-events-and-payloads#workflow_run
@@ -432,18 +432,23 @@ func TestGistsService_GetRevision(t *testing.T) {
 
 	mux.HandleFunc("/gists/1/s", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		fmt.Fprint(w, `{"id": "1"}`)
+		fmt.Fprint(w, `{"id": "1", "files": {"f.txt": {"filename": "f.txt", "content": "old"}}}`)
 	})
 
 	ctx := context.Background()
 	gist, _, err := client.Gists.GetRevision(ctx, "1", "s")
 	if err != nil {
-		t.Errorf("Gists.Get returned error: %v", err)
+		t.Errorf("Gists.GetRevision returned error: %v", err)
 	}
 
-	want := &Gist{ID: String("1")}
+	want := &Gist{
+		ID: String("1"),
+		Files: map[GistFilename]GistFile{
+			"f.txt": {Filename: String("f.txt"), Content: String("old")},
+		},
+	}
 	if !cmp.Equal(gist, want) {
-		t.Errorf("Gists.Get returned %+v, want %+v", gist, want)
+		t.Errorf("Gists.GetRevision returned %+v, want %+v", gist, want)
 	}
 
 	const methodName = "GetRevision"
@@ -885,6 +890,67 @@ func TestGistsService_IsStarred_noStar(t *testing.T) {
 	})
 }
 
+func TestGistsService_IsStarred_toggle(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	starred := false
+	mux.HandleFunc("/gists/1/star", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			starred = true
+		case "DELETE":
+			starred = false
+		case "GET":
+			if !starred {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	checkStarred := func(want bool) {
+		t.Helper()
+		star, _, err := client.Gists.IsStarred(ctx, "1")
+		if err != nil {
+			t.Errorf("Gists.IsStarred returned error: %v", err)
+		}
+		if star != want {
+			t.Errorf("Gists.IsStarred returned %+v, want %+v", star, want)
+		}
+	}
+
+	checkStarred(false)
+	if _, err := client.Gists.Star(ctx, "1"); err != nil {
+		t.Errorf("Gists.Star returned error: %v", err)
+	}
+	checkStarred(true)
+	if _, err := client.Gists.Unstar(ctx, "1"); err != nil {
+		t.Errorf("Gists.Unstar returned error: %v", err)
+	}
+	checkStarred(false)
+}
+
+func TestGistsService_IsStarred_serverError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/gists/1/star", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	star, _, err := client.Gists.IsStarred(ctx, "1")
+	if err == nil {
+		t.Error("Gists.IsStarred should return an error for a 500 response")
+	}
+	if star {
+		t.Errorf("Gists.IsStarred returned %+v, want false", star)
+	}
+}
+
 func TestGistsService_IsStarred_invalidID(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -73,6 +73,36 @@ func TestActionsPermissions_GetSelectedActionsURL(tt *testing.T) {
 	a.GetSelectedActionsURL()
 }
 
+func TestActionsPermissionsEnterprise_GetAllowedActions(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsPermissionsEnterprise{AllowedActions: &zeroValue}
+	a.GetAllowedActions()
+	a = &ActionsPermissionsEnterprise{}
+	a.GetAllowedActions()
+	a = nil
+	a.GetAllowedActions()
+}
+
+func TestActionsPermissionsEnterprise_GetEnabledOrganizations(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsPermissionsEnterprise{EnabledOrganizations: &zeroValue}
+	a.GetEnabledOrganizations()
+	a = &ActionsPermissionsEnterprise{}
+	a.GetEnabledOrganizations()
+	a = nil
+	a.GetEnabledOrganizations()
+}
+
+func TestActionsPermissionsEnterprise_GetSelectedActionsURL(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsPermissionsEnterprise{SelectedActionsURL: &zeroValue}
+	a.GetSelectedActionsURL()
+	a = &ActionsPermissionsEnterprise{}
+	a.GetSelectedActionsURL()
+	a = nil
+	a.GetSelectedActionsURL()
+}
+
 func TestAdminEnforcement_GetURL(tt *testing.T) {
 	var zeroValue string
 	a := &AdminEnforcement{URL: &zeroValue}
@@ -153,6 +183,100 @@ func TestAdminStats_GetUsers(tt *testing.T) {
 	a.GetUsers()
 }
 
+func TestAdvisoryCVSS_GetScore(tt *testing.T) {
+	a := &AdvisoryCVSS{}
+	a.GetScore()
+	a = nil
+	a.GetScore()
+}
+
+func TestAdvisoryCVSS_GetVectorString(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryCVSS{VectorString: &zeroValue}
+	a.GetVectorString()
+	a = &AdvisoryCVSS{}
+	a.GetVectorString()
+	a = nil
+	a.GetVectorString()
+}
+
+func TestAdvisoryCWEs_GetCWEID(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryCWEs{CWEID: &zeroValue}
+	a.GetCWEID()
+	a = &AdvisoryCWEs{}
+	a.GetCWEID()
+	a = nil
+	a.GetCWEID()
+}
+
+func TestAdvisoryCWEs_GetName(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryCWEs{Name: &zeroValue}
+	a.GetName()
+	a = &AdvisoryCWEs{}
+	a.GetName()
+	a = nil
+	a.GetName()
+}
+
+func TestAdvisoryIdentifier_GetType(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryIdentifier{Type: &zeroValue}
+	a.GetType()
+	a = &AdvisoryIdentifier{}
+	a.GetType()
+	a = nil
+	a.GetType()
+}
+
+func TestAdvisoryIdentifier_GetValue(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryIdentifier{Value: &zeroValue}
+	a.GetValue()
+	a = &AdvisoryIdentifier{}
+	a.GetValue()
+	a = nil
+	a.GetValue()
+}
+
+func TestAdvisoryReference_GetURL(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryReference{URL: &zeroValue}
+	a.GetURL()
+	a = &AdvisoryReference{}
+	a.GetURL()
+	a = nil
+	a.GetURL()
+}
+
+func TestAdvisoryVulnerability_GetPackage(tt *testing.T) {
+	a := &AdvisoryVulnerability{}
+	a.GetPackage()
+	a = nil
+	a.GetPackage()
+}
+
+func TestAdvisoryVulnerability_GetPatchedVersions(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryVulnerability{PatchedVersions: &zeroValue}
+	a.GetPatchedVersions()
+	a = &AdvisoryVulnerability{}
+	a.GetPatchedVersions()
+	a = nil
+	a.GetPatchedVersions()
+}
+
+func TestAdvisoryVulnerability_GetVulnerableVersionRange(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryVulnerability{VulnerableVersionRange: &zeroValue}
+	a.GetVulnerableVersionRange()
+	a = &AdvisoryVulnerability{}
+	a.GetVulnerableVersionRange()
+	a = nil
+	a.GetVulnerableVersionRange()
+}
+
 func TestAlert_GetClosedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	a := &Alert{ClosedAt: &zeroValue}
@@ -197,6 +321,16 @@ func TestAlert_GetDismissedBy(tt *testing.T) {
 	a.GetDismissedBy()
 }
 
+func TestAlert_GetDismissedComment(tt *testing.T) {
+	var zeroValue string
+	a := &Alert{DismissedComment: &zeroValue}
+	a.GetDismissedComment()
+	a = &Alert{}
+	a.GetDismissedComment()
+	a = nil
+	a.GetDismissedComment()
+}
+
 func TestAlert_GetDismissedReason(tt *testing.T) {
 	var zeroValue string
 	a := &Alert{DismissedReason: &zeroValue}
@@ -234,6 +368,13 @@ func TestAlert_GetMostRecentInstance(tt *testing.T) {
 	a.GetMostRecentInstance()
 }
 
+func TestAlert_GetRepository(tt *testing.T) {
+	a := &Alert{}
+	a.GetRepository()
+	a = nil
+	a.GetRepository()
+}
+
 func TestAlert_GetRule(tt *testing.T) {
 	a := &Alert{}
 	a.GetRule()
@@ -298,6 +439,36 @@ func TestAlert_GetURL(tt *testing.T) {
 	a.GetURL()
 }
 
+func TestAnnouncement_GetExpiration(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &Announcement{Expiration: &zeroValue}
+	a.GetExpiration()
+	a = &Announcement{}
+	a.GetExpiration()
+	a = nil
+	a.GetExpiration()
+}
+
+func TestAnnouncement_GetMessage(tt *testing.T) {
+	var zeroValue string
+	a := &Announcement{Message: &zeroValue}
+	a.GetMessage()
+	a = &Announcement{}
+	a.GetMessage()
+	a = nil
+	a.GetMessage()
+}
+
+func TestAnnouncement_GetUserDismissible(tt *testing.T) {
+	var zeroValue bool
+	a := &Announcement{UserDismissible: &zeroValue}
+	a.GetUserDismissible()
+	a = &Announcement{}
+	a.GetUserDismissible()
+	a = nil
+	a.GetUserDismissible()
+}
+
 func TestAPIMeta_GetVerifiablePasswordAuthentication(tt *testing.T) {
 	var zeroValue bool
 	a := &APIMeta{VerifiablePasswordAuthentication: &zeroValue}
@@ -639,6 +810,13 @@ func TestArtifactList_GetTotalCount(tt *testing.T) {
 	a.GetTotalCount()
 }
 
+func TestAssetUpload_GetOptions(tt *testing.T) {
+	a := &AssetUpload{}
+	a.GetOptions()
+	a = nil
+	a.GetOptions()
+}
+
 func TestAttachment_GetBody(tt *testing.T) {
 	var zeroValue string
 	a := &Attachment{Body: &zeroValue}
@@ -1417,6 +1595,76 @@ func TestAuthorizationUpdateRequest_GetNoteURL(tt *testing.T) {
 	a.GetNoteURL()
 }
 
+func TestAutolink_GetID(tt *testing.T) {
+	var zeroValue int64
+	a := &Autolink{ID: &zeroValue}
+	a.GetID()
+	a = &Autolink{}
+	a.GetID()
+	a = nil
+	a.GetID()
+}
+
+func TestAutolink_GetIsAlphanumeric(tt *testing.T) {
+	var zeroValue bool
+	a := &Autolink{IsAlphanumeric: &zeroValue}
+	a.GetIsAlphanumeric()
+	a = &Autolink{}
+	a.GetIsAlphanumeric()
+	a = nil
+	a.GetIsAlphanumeric()
+}
+
+func TestAutolink_GetKeyPrefix(tt *testing.T) {
+	var zeroValue string
+	a := &Autolink{KeyPrefix: &zeroValue}
+	a.GetKeyPrefix()
+	a = &Autolink{}
+	a.GetKeyPrefix()
+	a = nil
+	a.GetKeyPrefix()
+}
+
+func TestAutolink_GetURLTemplate(tt *testing.T) {
+	var zeroValue string
+	a := &Autolink{URLTemplate: &zeroValue}
+	a.GetURLTemplate()
+	a = &Autolink{}
+	a.GetURLTemplate()
+	a = nil
+	a.GetURLTemplate()
+}
+
+func TestAutolinkOptions_GetIsAlphanumeric(tt *testing.T) {
+	var zeroValue bool
+	a := &AutolinkOptions{IsAlphanumeric: &zeroValue}
+	a.GetIsAlphanumeric()
+	a = &AutolinkOptions{}
+	a.GetIsAlphanumeric()
+	a = nil
+	a.GetIsAlphanumeric()
+}
+
+func TestAutolinkOptions_GetKeyPrefix(tt *testing.T) {
+	var zeroValue string
+	a := &AutolinkOptions{KeyPrefix: &zeroValue}
+	a.GetKeyPrefix()
+	a = &AutolinkOptions{}
+	a.GetKeyPrefix()
+	a = nil
+	a.GetKeyPrefix()
+}
+
+func TestAutolinkOptions_GetURLTemplate(tt *testing.T) {
+	var zeroValue string
+	a := &AutolinkOptions{URLTemplate: &zeroValue}
+	a.GetURLTemplate()
+	a = &AutolinkOptions{}
+	a.GetURLTemplate()
+	a = nil
+	a.GetURLTemplate()
+}
+
 func TestAutoTriggerCheck_GetAppID(tt *testing.T) {
 	var zeroValue int64
 	a := &AutoTriggerCheck{AppID: &zeroValue}
@@ -1581,6 +1829,36 @@ func TestBranchPolicy_GetProtectedBranches(tt *testing.T) {
 	b.GetProtectedBranches()
 }
 
+func TestBypassActor_GetActorID(tt *testing.T) {
+	var zeroValue int64
+	b := &BypassActor{ActorID: &zeroValue}
+	b.GetActorID()
+	b = &BypassActor{}
+	b.GetActorID()
+	b = nil
+	b.GetActorID()
+}
+
+func TestBypassActor_GetActorType(tt *testing.T) {
+	var zeroValue string
+	b := &BypassActor{ActorType: &zeroValue}
+	b.GetActorType()
+	b = &BypassActor{}
+	b.GetActorType()
+	b = nil
+	b.GetActorType()
+}
+
+func TestBypassActor_GetBypassMode(tt *testing.T) {
+	var zeroValue string
+	b := &BypassActor{BypassMode: &zeroValue}
+	b.GetBypassMode()
+	b = &BypassActor{}
+	b.GetBypassMode()
+	b = nil
+	b.GetBypassMode()
+}
+
 func TestCheckRun_GetApp(tt *testing.T) {
 	c := &CheckRun{}
 	c.GetApp()
@@ -2221,6 +2499,26 @@ func TestCodeResult_GetSHA(tt *testing.T) {
 	c.GetSHA()
 }
 
+func TestCodeScanningAlertState_GetDismissedComment(tt *testing.T) {
+	var zeroValue string
+	c := &CodeScanningAlertState{DismissedComment: &zeroValue}
+	c.GetDismissedComment()
+	c = &CodeScanningAlertState{}
+	c.GetDismissedComment()
+	c = nil
+	c.GetDismissedComment()
+}
+
+func TestCodeScanningAlertState_GetDismissedReason(tt *testing.T) {
+	var zeroValue string
+	c := &CodeScanningAlertState{DismissedReason: &zeroValue}
+	c.GetDismissedReason()
+	c = &CodeScanningAlertState{}
+	c.GetDismissedReason()
+	c = nil
+	c.GetDismissedReason()
+}
+
 func TestCodeSearchResult_GetIncompleteResults(tt *testing.T) {
 	var zeroValue bool
 	c := &CodeSearchResult{IncompleteResults: &zeroValue}
@@ -2241,159 +2539,464 @@ func TestCodeSearchResult_GetTotal(tt *testing.T) {
 	c.GetTotal()
 }
 
-func TestCollaboratorInvitation_GetCreatedAt(tt *testing.T) {
+func TestCodespace_GetBillableOwner(tt *testing.T) {
+	c := &Codespace{}
+	c.GetBillableOwner()
+	c = nil
+	c.GetBillableOwner()
+}
+
+func TestCodespace_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	c := &CollaboratorInvitation{CreatedAt: &zeroValue}
+	c := &Codespace{CreatedAt: &zeroValue}
 	c.GetCreatedAt()
-	c = &CollaboratorInvitation{}
+	c = &Codespace{}
 	c.GetCreatedAt()
 	c = nil
 	c.GetCreatedAt()
 }
 
-func TestCollaboratorInvitation_GetHTMLURL(tt *testing.T) {
+func TestCodespace_GetDisplayName(tt *testing.T) {
 	var zeroValue string
-	c := &CollaboratorInvitation{HTMLURL: &zeroValue}
-	c.GetHTMLURL()
-	c = &CollaboratorInvitation{}
-	c.GetHTMLURL()
+	c := &Codespace{DisplayName: &zeroValue}
+	c.GetDisplayName()
+	c = &Codespace{}
+	c.GetDisplayName()
 	c = nil
-	c.GetHTMLURL()
+	c.GetDisplayName()
 }
 
-func TestCollaboratorInvitation_GetID(tt *testing.T) {
+func TestCodespace_GetEnvironmentID(tt *testing.T) {
+	var zeroValue string
+	c := &Codespace{EnvironmentID: &zeroValue}
+	c.GetEnvironmentID()
+	c = &Codespace{}
+	c.GetEnvironmentID()
+	c = nil
+	c.GetEnvironmentID()
+}
+
+func TestCodespace_GetGitStatus(tt *testing.T) {
+	c := &Codespace{}
+	c.GetGitStatus()
+	c = nil
+	c.GetGitStatus()
+}
+
+func TestCodespace_GetID(tt *testing.T) {
 	var zeroValue int64
-	c := &CollaboratorInvitation{ID: &zeroValue}
+	c := &Codespace{ID: &zeroValue}
 	c.GetID()
-	c = &CollaboratorInvitation{}
+	c = &Codespace{}
 	c.GetID()
 	c = nil
 	c.GetID()
 }
 
-func TestCollaboratorInvitation_GetInvitee(tt *testing.T) {
-	c := &CollaboratorInvitation{}
-	c.GetInvitee()
+func TestCodespace_GetIdleTimeoutMinutes(tt *testing.T) {
+	var zeroValue int
+	c := &Codespace{IdleTimeoutMinutes: &zeroValue}
+	c.GetIdleTimeoutMinutes()
+	c = &Codespace{}
+	c.GetIdleTimeoutMinutes()
 	c = nil
-	c.GetInvitee()
+	c.GetIdleTimeoutMinutes()
 }
 
-func TestCollaboratorInvitation_GetInviter(tt *testing.T) {
-	c := &CollaboratorInvitation{}
-	c.GetInviter()
+func TestCodespace_GetLastUsedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &Codespace{LastUsedAt: &zeroValue}
+	c.GetLastUsedAt()
+	c = &Codespace{}
+	c.GetLastUsedAt()
 	c = nil
-	c.GetInviter()
+	c.GetLastUsedAt()
 }
 
-func TestCollaboratorInvitation_GetPermissions(tt *testing.T) {
+func TestCodespace_GetLocation(tt *testing.T) {
 	var zeroValue string
-	c := &CollaboratorInvitation{Permissions: &zeroValue}
-	c.GetPermissions()
-	c = &CollaboratorInvitation{}
-	c.GetPermissions()
-	c = nil
-	c.GetPermissions()
-}
-
-func TestCollaboratorInvitation_GetRepo(tt *testing.T) {
-	c := &CollaboratorInvitation{}
-	c.GetRepo()
+	c := &Codespace{Location: &zeroValue}
+	c.GetLocation()
+	c = &Codespace{}
+	c.GetLocation()
 	c = nil
-	c.GetRepo()
+	c.GetLocation()
 }
 
-func TestCollaboratorInvitation_GetURL(tt *testing.T) {
-	var zeroValue string
-	c := &CollaboratorInvitation{URL: &zeroValue}
-	c.GetURL()
-	c = &CollaboratorInvitation{}
-	c.GetURL()
+func TestCodespace_GetMachine(tt *testing.T) {
+	c := &Codespace{}
+	c.GetMachine()
 	c = nil
-	c.GetURL()
+	c.GetMachine()
 }
 
-func TestCombinedStatus_GetCommitURL(tt *testing.T) {
+func TestCodespace_GetMachinesURL(tt *testing.T) {
 	var zeroValue string
-	c := &CombinedStatus{CommitURL: &zeroValue}
-	c.GetCommitURL()
-	c = &CombinedStatus{}
-	c.GetCommitURL()
+	c := &Codespace{MachinesURL: &zeroValue}
+	c.GetMachinesURL()
+	c = &Codespace{}
+	c.GetMachinesURL()
 	c = nil
-	c.GetCommitURL()
+	c.GetMachinesURL()
 }
 
-func TestCombinedStatus_GetName(tt *testing.T) {
+func TestCodespace_GetName(tt *testing.T) {
 	var zeroValue string
-	c := &CombinedStatus{Name: &zeroValue}
+	c := &Codespace{Name: &zeroValue}
 	c.GetName()
-	c = &CombinedStatus{}
+	c = &Codespace{}
 	c.GetName()
 	c = nil
 	c.GetName()
 }
 
-func TestCombinedStatus_GetRepositoryURL(tt *testing.T) {
-	var zeroValue string
-	c := &CombinedStatus{RepositoryURL: &zeroValue}
-	c.GetRepositoryURL()
-	c = &CombinedStatus{}
-	c.GetRepositoryURL()
+func TestCodespace_GetOwner(tt *testing.T) {
+	c := &Codespace{}
+	c.GetOwner()
 	c = nil
-	c.GetRepositoryURL()
+	c.GetOwner()
 }
 
-func TestCombinedStatus_GetSHA(tt *testing.T) {
+func TestCodespace_GetRepository(tt *testing.T) {
+	c := &Codespace{}
+	c.GetRepository()
+	c = nil
+	c.GetRepository()
+}
+
+func TestCodespace_GetStartURL(tt *testing.T) {
 	var zeroValue string
-	c := &CombinedStatus{SHA: &zeroValue}
-	c.GetSHA()
-	c = &CombinedStatus{}
-	c.GetSHA()
+	c := &Codespace{StartURL: &zeroValue}
+	c.GetStartURL()
+	c = &Codespace{}
+	c.GetStartURL()
 	c = nil
-	c.GetSHA()
+	c.GetStartURL()
 }
 
-func TestCombinedStatus_GetState(tt *testing.T) {
+func TestCodespace_GetState(tt *testing.T) {
 	var zeroValue string
-	c := &CombinedStatus{State: &zeroValue}
+	c := &Codespace{State: &zeroValue}
 	c.GetState()
-	c = &CombinedStatus{}
+	c = &Codespace{}
 	c.GetState()
 	c = nil
 	c.GetState()
 }
 
-func TestCombinedStatus_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	c := &CombinedStatus{TotalCount: &zeroValue}
-	c.GetTotalCount()
-	c = &CombinedStatus{}
-	c.GetTotalCount()
+func TestCodespace_GetStopURL(tt *testing.T) {
+	var zeroValue string
+	c := &Codespace{StopURL: &zeroValue}
+	c.GetStopURL()
+	c = &Codespace{}
+	c.GetStopURL()
 	c = nil
-	c.GetTotalCount()
+	c.GetStopURL()
 }
 
-func TestComment_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	c := &Comment{CreatedAt: &zeroValue}
-	c.GetCreatedAt()
-	c = &Comment{}
-	c.GetCreatedAt()
+func TestCodespace_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &Codespace{UpdatedAt: &zeroValue}
+	c.GetUpdatedAt()
+	c = &Codespace{}
+	c.GetUpdatedAt()
 	c = nil
-	c.GetCreatedAt()
+	c.GetUpdatedAt()
 }
 
-func TestCommentStats_GetTotalCommitComments(tt *testing.T) {
-	var zeroValue int
-	c := &CommentStats{TotalCommitComments: &zeroValue}
-	c.GetTotalCommitComments()
-	c = &CommentStats{}
-	c.GetTotalCommitComments()
+func TestCodespace_GetURL(tt *testing.T) {
+	var zeroValue string
+	c := &Codespace{URL: &zeroValue}
+	c.GetURL()
+	c = &Codespace{}
+	c.GetURL()
 	c = nil
-	c.GetTotalCommitComments()
+	c.GetURL()
 }
 
-func TestCommentStats_GetTotalGistComments(tt *testing.T) {
-	var zeroValue int
+func TestCodespace_GetWebURL(tt *testing.T) {
+	var zeroValue string
+	c := &Codespace{WebURL: &zeroValue}
+	c.GetWebURL()
+	c = &Codespace{}
+	c.GetWebURL()
+	c = nil
+	c.GetWebURL()
+}
+
+func TestCodespacesGitStatus_GetAhead(tt *testing.T) {
+	var zeroValue int
+	c := &CodespacesGitStatus{Ahead: &zeroValue}
+	c.GetAhead()
+	c = &CodespacesGitStatus{}
+	c.GetAhead()
+	c = nil
+	c.GetAhead()
+}
+
+func TestCodespacesGitStatus_GetBehind(tt *testing.T) {
+	var zeroValue int
+	c := &CodespacesGitStatus{Behind: &zeroValue}
+	c.GetBehind()
+	c = &CodespacesGitStatus{}
+	c.GetBehind()
+	c = nil
+	c.GetBehind()
+}
+
+func TestCodespacesGitStatus_GetHasUncommittedChanges(tt *testing.T) {
+	var zeroValue bool
+	c := &CodespacesGitStatus{HasUncommittedChanges: &zeroValue}
+	c.GetHasUncommittedChanges()
+	c = &CodespacesGitStatus{}
+	c.GetHasUncommittedChanges()
+	c = nil
+	c.GetHasUncommittedChanges()
+}
+
+func TestCodespacesGitStatus_GetHasUnpushedChanges(tt *testing.T) {
+	var zeroValue bool
+	c := &CodespacesGitStatus{HasUnpushedChanges: &zeroValue}
+	c.GetHasUnpushedChanges()
+	c = &CodespacesGitStatus{}
+	c.GetHasUnpushedChanges()
+	c = nil
+	c.GetHasUnpushedChanges()
+}
+
+func TestCodespacesGitStatus_GetRef(tt *testing.T) {
+	var zeroValue string
+	c := &CodespacesGitStatus{Ref: &zeroValue}
+	c.GetRef()
+	c = &CodespacesGitStatus{}
+	c.GetRef()
+	c = nil
+	c.GetRef()
+}
+
+func TestCodespacesMachine_GetCPUs(tt *testing.T) {
+	var zeroValue int
+	c := &CodespacesMachine{CPUs: &zeroValue}
+	c.GetCPUs()
+	c = &CodespacesMachine{}
+	c.GetCPUs()
+	c = nil
+	c.GetCPUs()
+}
+
+func TestCodespacesMachine_GetDisplayName(tt *testing.T) {
+	var zeroValue string
+	c := &CodespacesMachine{DisplayName: &zeroValue}
+	c.GetDisplayName()
+	c = &CodespacesMachine{}
+	c.GetDisplayName()
+	c = nil
+	c.GetDisplayName()
+}
+
+func TestCodespacesMachine_GetMemoryInBytes(tt *testing.T) {
+	var zeroValue int64
+	c := &CodespacesMachine{MemoryInBytes: &zeroValue}
+	c.GetMemoryInBytes()
+	c = &CodespacesMachine{}
+	c.GetMemoryInBytes()
+	c = nil
+	c.GetMemoryInBytes()
+}
+
+func TestCodespacesMachine_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CodespacesMachine{Name: &zeroValue}
+	c.GetName()
+	c = &CodespacesMachine{}
+	c.GetName()
+	c = nil
+	c.GetName()
+}
+
+func TestCodespacesMachine_GetOperatingSystem(tt *testing.T) {
+	var zeroValue string
+	c := &CodespacesMachine{OperatingSystem: &zeroValue}
+	c.GetOperatingSystem()
+	c = &CodespacesMachine{}
+	c.GetOperatingSystem()
+	c = nil
+	c.GetOperatingSystem()
+}
+
+func TestCodespacesMachine_GetPrebuildAvailability(tt *testing.T) {
+	var zeroValue string
+	c := &CodespacesMachine{PrebuildAvailability: &zeroValue}
+	c.GetPrebuildAvailability()
+	c = &CodespacesMachine{}
+	c.GetPrebuildAvailability()
+	c = nil
+	c.GetPrebuildAvailability()
+}
+
+func TestCodespacesMachine_GetStorageInBytes(tt *testing.T) {
+	var zeroValue int64
+	c := &CodespacesMachine{StorageInBytes: &zeroValue}
+	c.GetStorageInBytes()
+	c = &CodespacesMachine{}
+	c.GetStorageInBytes()
+	c = nil
+	c.GetStorageInBytes()
+}
+
+func TestCollaboratorInvitation_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CollaboratorInvitation{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &CollaboratorInvitation{}
+	c.GetCreatedAt()
+	c = nil
+	c.GetCreatedAt()
+}
+
+func TestCollaboratorInvitation_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	c := &CollaboratorInvitation{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &CollaboratorInvitation{}
+	c.GetHTMLURL()
+	c = nil
+	c.GetHTMLURL()
+}
+
+func TestCollaboratorInvitation_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &CollaboratorInvitation{ID: &zeroValue}
+	c.GetID()
+	c = &CollaboratorInvitation{}
+	c.GetID()
+	c = nil
+	c.GetID()
+}
+
+func TestCollaboratorInvitation_GetInvitee(tt *testing.T) {
+	c := &CollaboratorInvitation{}
+	c.GetInvitee()
+	c = nil
+	c.GetInvitee()
+}
+
+func TestCollaboratorInvitation_GetInviter(tt *testing.T) {
+	c := &CollaboratorInvitation{}
+	c.GetInviter()
+	c = nil
+	c.GetInviter()
+}
+
+func TestCollaboratorInvitation_GetPermissions(tt *testing.T) {
+	var zeroValue string
+	c := &CollaboratorInvitation{Permissions: &zeroValue}
+	c.GetPermissions()
+	c = &CollaboratorInvitation{}
+	c.GetPermissions()
+	c = nil
+	c.GetPermissions()
+}
+
+func TestCollaboratorInvitation_GetRepo(tt *testing.T) {
+	c := &CollaboratorInvitation{}
+	c.GetRepo()
+	c = nil
+	c.GetRepo()
+}
+
+func TestCollaboratorInvitation_GetURL(tt *testing.T) {
+	var zeroValue string
+	c := &CollaboratorInvitation{URL: &zeroValue}
+	c.GetURL()
+	c = &CollaboratorInvitation{}
+	c.GetURL()
+	c = nil
+	c.GetURL()
+}
+
+func TestCombinedStatus_GetCommitURL(tt *testing.T) {
+	var zeroValue string
+	c := &CombinedStatus{CommitURL: &zeroValue}
+	c.GetCommitURL()
+	c = &CombinedStatus{}
+	c.GetCommitURL()
+	c = nil
+	c.GetCommitURL()
+}
+
+func TestCombinedStatus_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CombinedStatus{Name: &zeroValue}
+	c.GetName()
+	c = &CombinedStatus{}
+	c.GetName()
+	c = nil
+	c.GetName()
+}
+
+func TestCombinedStatus_GetRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	c := &CombinedStatus{RepositoryURL: &zeroValue}
+	c.GetRepositoryURL()
+	c = &CombinedStatus{}
+	c.GetRepositoryURL()
+	c = nil
+	c.GetRepositoryURL()
+}
+
+func TestCombinedStatus_GetSHA(tt *testing.T) {
+	var zeroValue string
+	c := &CombinedStatus{SHA: &zeroValue}
+	c.GetSHA()
+	c = &CombinedStatus{}
+	c.GetSHA()
+	c = nil
+	c.GetSHA()
+}
+
+func TestCombinedStatus_GetState(tt *testing.T) {
+	var zeroValue string
+	c := &CombinedStatus{State: &zeroValue}
+	c.GetState()
+	c = &CombinedStatus{}
+	c.GetState()
+	c = nil
+	c.GetState()
+}
+
+func TestCombinedStatus_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	c := &CombinedStatus{TotalCount: &zeroValue}
+	c.GetTotalCount()
+	c = &CombinedStatus{}
+	c.GetTotalCount()
+	c = nil
+	c.GetTotalCount()
+}
+
+func TestComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	c := &Comment{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &Comment{}
+	c.GetCreatedAt()
+	c = nil
+	c.GetCreatedAt()
+}
+
+func TestCommentStats_GetTotalCommitComments(tt *testing.T) {
+	var zeroValue int
+	c := &CommentStats{TotalCommitComments: &zeroValue}
+	c.GetTotalCommitComments()
+	c = &CommentStats{}
+	c.GetTotalCommitComments()
+	c = nil
+	c.GetTotalCommitComments()
+}
+
+func TestCommentStats_GetTotalGistComments(tt *testing.T) {
+	var zeroValue int
 	c := &CommentStats{TotalGistComments: &zeroValue}
 	c.GetTotalGistComments()
 	c = &CommentStats{}
@@ -3476,6 +4079,56 @@ func TestCreateOrgInvitationOptions_GetRole(tt *testing.T) {
 	c.GetRole()
 }
 
+func TestCreatePagesDeploymentRequest_GetArtifactID(tt *testing.T) {
+	var zeroValue int64
+	c := &CreatePagesDeploymentRequest{ArtifactID: &zeroValue}
+	c.GetArtifactID()
+	c = &CreatePagesDeploymentRequest{}
+	c.GetArtifactID()
+	c = nil
+	c.GetArtifactID()
+}
+
+func TestCreatePagesDeploymentRequest_GetArtifactURL(tt *testing.T) {
+	var zeroValue string
+	c := &CreatePagesDeploymentRequest{ArtifactURL: &zeroValue}
+	c.GetArtifactURL()
+	c = &CreatePagesDeploymentRequest{}
+	c.GetArtifactURL()
+	c = nil
+	c.GetArtifactURL()
+}
+
+func TestCreatePagesDeploymentRequest_GetEnvironment(tt *testing.T) {
+	var zeroValue string
+	c := &CreatePagesDeploymentRequest{Environment: &zeroValue}
+	c.GetEnvironment()
+	c = &CreatePagesDeploymentRequest{}
+	c.GetEnvironment()
+	c = nil
+	c.GetEnvironment()
+}
+
+func TestCreatePagesDeploymentRequest_GetOIDCToken(tt *testing.T) {
+	var zeroValue string
+	c := &CreatePagesDeploymentRequest{OIDCToken: &zeroValue}
+	c.GetOIDCToken()
+	c = &CreatePagesDeploymentRequest{}
+	c.GetOIDCToken()
+	c = nil
+	c.GetOIDCToken()
+}
+
+func TestCreatePagesDeploymentRequest_GetPagesBuildVersion(tt *testing.T) {
+	var zeroValue string
+	c := &CreatePagesDeploymentRequest{PagesBuildVersion: &zeroValue}
+	c.GetPagesBuildVersion()
+	c = &CreatePagesDeploymentRequest{}
+	c.GetPagesBuildVersion()
+	c = nil
+	c.GetPagesBuildVersion()
+}
+
 func TestCreateRunnerGroupRequest_GetAllowsPublicRepositories(tt *testing.T) {
 	var zeroValue bool
 	c := &CreateRunnerGroupRequest{AllowsPublicRepositories: &zeroValue}
@@ -3513,6 +4166,16 @@ func TestCreateUpdateEnvironment_GetDeploymentBranchPolicy(tt *testing.T) {
 	c.GetDeploymentBranchPolicy()
 }
 
+func TestCreateUpdateEnvironment_GetPreventSelfReview(tt *testing.T) {
+	var zeroValue bool
+	c := &CreateUpdateEnvironment{PreventSelfReview: &zeroValue}
+	c.GetPreventSelfReview()
+	c = &CreateUpdateEnvironment{}
+	c.GetPreventSelfReview()
+	c = nil
+	c.GetPreventSelfReview()
+}
+
 func TestCreateUpdateEnvironment_GetWaitTimer(tt *testing.T) {
 	var zeroValue int
 	c := &CreateUpdateEnvironment{WaitTimer: &zeroValue}
@@ -3584,29 +4247,332 @@ func TestDeleteEvent_GetSender(tt *testing.T) {
 	d.GetSender()
 }
 
-func TestDeployKeyEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	d := &DeployKeyEvent{Action: &zeroValue}
-	d.GetAction()
-	d = &DeployKeyEvent{}
-	d.GetAction()
+func TestDependabotAlert_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DependabotAlert{}
+	d.GetCreatedAt()
 	d = nil
-	d.GetAction()
+	d.GetCreatedAt()
 }
 
-func TestDeployKeyEvent_GetKey(tt *testing.T) {
-	d := &DeployKeyEvent{}
-	d.GetKey()
+func TestDependabotAlert_GetDependency(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetDependency()
 	d = nil
-	d.GetKey()
+	d.GetDependency()
 }
 
-func TestDeployment_GetCreatedAt(tt *testing.T) {
+func TestDependabotAlert_GetDismissedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	d := &Deployment{CreatedAt: &zeroValue}
-	d.GetCreatedAt()
-	d = &Deployment{}
-	d.GetCreatedAt()
+	d := &DependabotAlert{DismissedAt: &zeroValue}
+	d.GetDismissedAt()
+	d = &DependabotAlert{}
+	d.GetDismissedAt()
+	d = nil
+	d.GetDismissedAt()
+}
+
+func TestDependabotAlert_GetDismissedBy(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetDismissedBy()
+	d = nil
+	d.GetDismissedBy()
+}
+
+func TestDependabotAlert_GetDismissedComment(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlert{DismissedComment: &zeroValue}
+	d.GetDismissedComment()
+	d = &DependabotAlert{}
+	d.GetDismissedComment()
+	d = nil
+	d.GetDismissedComment()
+}
+
+func TestDependabotAlert_GetDismissedReason(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlert{DismissedReason: &zeroValue}
+	d.GetDismissedReason()
+	d = &DependabotAlert{}
+	d.GetDismissedReason()
+	d = nil
+	d.GetDismissedReason()
+}
+
+func TestDependabotAlert_GetFixedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{FixedAt: &zeroValue}
+	d.GetFixedAt()
+	d = &DependabotAlert{}
+	d.GetFixedAt()
+	d = nil
+	d.GetFixedAt()
+}
+
+func TestDependabotAlert_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlert{HTMLURL: &zeroValue}
+	d.GetHTMLURL()
+	d = &DependabotAlert{}
+	d.GetHTMLURL()
+	d = nil
+	d.GetHTMLURL()
+}
+
+func TestDependabotAlert_GetNumber(tt *testing.T) {
+	var zeroValue int
+	d := &DependabotAlert{Number: &zeroValue}
+	d.GetNumber()
+	d = &DependabotAlert{}
+	d.GetNumber()
+	d = nil
+	d.GetNumber()
+}
+
+func TestDependabotAlert_GetRepository(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetRepository()
+	d = nil
+	d.GetRepository()
+}
+
+func TestDependabotAlert_GetSecurityAdvisory(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetSecurityAdvisory()
+	d = nil
+	d.GetSecurityAdvisory()
+}
+
+func TestDependabotAlert_GetSecurityVulnerability(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetSecurityVulnerability()
+	d = nil
+	d.GetSecurityVulnerability()
+}
+
+func TestDependabotAlert_GetState(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlert{State: &zeroValue}
+	d.GetState()
+	d = &DependabotAlert{}
+	d.GetState()
+	d = nil
+	d.GetState()
+}
+
+func TestDependabotAlert_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DependabotAlert{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
+}
+
+func TestDependabotAlert_GetURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlert{URL: &zeroValue}
+	d.GetURL()
+	d = &DependabotAlert{}
+	d.GetURL()
+	d = nil
+	d.GetURL()
+}
+
+func TestDependabotAlertState_GetDismissedComment(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlertState{DismissedComment: &zeroValue}
+	d.GetDismissedComment()
+	d = &DependabotAlertState{}
+	d.GetDismissedComment()
+	d = nil
+	d.GetDismissedComment()
+}
+
+func TestDependabotAlertState_GetDismissedReason(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlertState{DismissedReason: &zeroValue}
+	d.GetDismissedReason()
+	d = &DependabotAlertState{}
+	d.GetDismissedReason()
+	d = nil
+	d.GetDismissedReason()
+}
+
+func TestDependabotSecurityAdvisory_GetCVEID(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotSecurityAdvisory{CVEID: &zeroValue}
+	d.GetCVEID()
+	d = &DependabotSecurityAdvisory{}
+	d.GetCVEID()
+	d = nil
+	d.GetCVEID()
+}
+
+func TestDependabotSecurityAdvisory_GetCVSS(tt *testing.T) {
+	d := &DependabotSecurityAdvisory{}
+	d.GetCVSS()
+	d = nil
+	d.GetCVSS()
+}
+
+func TestDependabotSecurityAdvisory_GetDescription(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotSecurityAdvisory{Description: &zeroValue}
+	d.GetDescription()
+	d = &DependabotSecurityAdvisory{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
+}
+
+func TestDependabotSecurityAdvisory_GetGHSAID(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotSecurityAdvisory{GHSAID: &zeroValue}
+	d.GetGHSAID()
+	d = &DependabotSecurityAdvisory{}
+	d.GetGHSAID()
+	d = nil
+	d.GetGHSAID()
+}
+
+func TestDependabotSecurityAdvisory_GetPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotSecurityAdvisory{PublishedAt: &zeroValue}
+	d.GetPublishedAt()
+	d = &DependabotSecurityAdvisory{}
+	d.GetPublishedAt()
+	d = nil
+	d.GetPublishedAt()
+}
+
+func TestDependabotSecurityAdvisory_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotSecurityAdvisory{Severity: &zeroValue}
+	d.GetSeverity()
+	d = &DependabotSecurityAdvisory{}
+	d.GetSeverity()
+	d = nil
+	d.GetSeverity()
+}
+
+func TestDependabotSecurityAdvisory_GetSummary(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotSecurityAdvisory{Summary: &zeroValue}
+	d.GetSummary()
+	d = &DependabotSecurityAdvisory{}
+	d.GetSummary()
+	d = nil
+	d.GetSummary()
+}
+
+func TestDependabotSecurityAdvisory_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotSecurityAdvisory{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DependabotSecurityAdvisory{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
+}
+
+func TestDependabotSecurityAdvisory_GetWithdrawnAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotSecurityAdvisory{WithdrawnAt: &zeroValue}
+	d.GetWithdrawnAt()
+	d = &DependabotSecurityAdvisory{}
+	d.GetWithdrawnAt()
+	d = nil
+	d.GetWithdrawnAt()
+}
+
+func TestDependabotVulnerability_GetFirstPatchedVersion(tt *testing.T) {
+	d := &DependabotVulnerability{}
+	d.GetFirstPatchedVersion()
+	d = nil
+	d.GetFirstPatchedVersion()
+}
+
+func TestDependabotVulnerability_GetPackage(tt *testing.T) {
+	d := &DependabotVulnerability{}
+	d.GetPackage()
+	d = nil
+	d.GetPackage()
+}
+
+func TestDependabotVulnerability_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotVulnerability{Severity: &zeroValue}
+	d.GetSeverity()
+	d = &DependabotVulnerability{}
+	d.GetSeverity()
+	d = nil
+	d.GetSeverity()
+}
+
+func TestDependabotVulnerability_GetVulnerableVersionRange(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotVulnerability{VulnerableVersionRange: &zeroValue}
+	d.GetVulnerableVersionRange()
+	d = &DependabotVulnerability{}
+	d.GetVulnerableVersionRange()
+	d = nil
+	d.GetVulnerableVersionRange()
+}
+
+func TestDependency_GetManifestPath(tt *testing.T) {
+	var zeroValue string
+	d := &Dependency{ManifestPath: &zeroValue}
+	d.GetManifestPath()
+	d = &Dependency{}
+	d.GetManifestPath()
+	d = nil
+	d.GetManifestPath()
+}
+
+func TestDependency_GetPackage(tt *testing.T) {
+	d := &Dependency{}
+	d.GetPackage()
+	d = nil
+	d.GetPackage()
+}
+
+func TestDependency_GetScope(tt *testing.T) {
+	var zeroValue string
+	d := &Dependency{Scope: &zeroValue}
+	d.GetScope()
+	d = &Dependency{}
+	d.GetScope()
+	d = nil
+	d.GetScope()
+}
+
+func TestDeployKeyEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	d := &DeployKeyEvent{Action: &zeroValue}
+	d.GetAction()
+	d = &DeployKeyEvent{}
+	d.GetAction()
+	d = nil
+	d.GetAction()
+}
+
+func TestDeployKeyEvent_GetKey(tt *testing.T) {
+	d := &DeployKeyEvent{}
+	d.GetKey()
+	d = nil
+	d.GetKey()
+}
+
+func TestDeployment_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &Deployment{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &Deployment{}
+	d.GetCreatedAt()
 	d = nil
 	d.GetCreatedAt()
 }
@@ -4068,128 +5034,457 @@ func TestDeploymentStatusRequest_GetState(tt *testing.T) {
 	d.GetState()
 }
 
-func TestDiscussionComment_GetAuthor(tt *testing.T) {
-	d := &DiscussionComment{}
-	d.GetAuthor()
+func TestDiscussion_GetActiveLockReason(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{ActiveLockReason: &zeroValue}
+	d.GetActiveLockReason()
+	d = &Discussion{}
+	d.GetActiveLockReason()
 	d = nil
-	d.GetAuthor()
+	d.GetActiveLockReason()
 }
 
-func TestDiscussionComment_GetBody(tt *testing.T) {
+func TestDiscussion_GetAnswerChosenAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &Discussion{AnswerChosenAt: &zeroValue}
+	d.GetAnswerChosenAt()
+	d = &Discussion{}
+	d.GetAnswerChosenAt()
+	d = nil
+	d.GetAnswerChosenAt()
+}
+
+func TestDiscussion_GetAnswerChosenBy(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{Body: &zeroValue}
-	d.GetBody()
-	d = &DiscussionComment{}
-	d.GetBody()
+	d := &Discussion{AnswerChosenBy: &zeroValue}
+	d.GetAnswerChosenBy()
+	d = &Discussion{}
+	d.GetAnswerChosenBy()
 	d = nil
-	d.GetBody()
+	d.GetAnswerChosenBy()
 }
 
-func TestDiscussionComment_GetBodyHTML(tt *testing.T) {
+func TestDiscussion_GetAnswerHTMLURL(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{BodyHTML: &zeroValue}
-	d.GetBodyHTML()
-	d = &DiscussionComment{}
-	d.GetBodyHTML()
+	d := &Discussion{AnswerHTMLURL: &zeroValue}
+	d.GetAnswerHTMLURL()
+	d = &Discussion{}
+	d.GetAnswerHTMLURL()
 	d = nil
-	d.GetBodyHTML()
+	d.GetAnswerHTMLURL()
 }
 
-func TestDiscussionComment_GetBodyVersion(tt *testing.T) {
+func TestDiscussion_GetAuthorAssociation(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{BodyVersion: &zeroValue}
-	d.GetBodyVersion()
-	d = &DiscussionComment{}
-	d.GetBodyVersion()
+	d := &Discussion{AuthorAssociation: &zeroValue}
+	d.GetAuthorAssociation()
+	d = &Discussion{}
+	d.GetAuthorAssociation()
 	d = nil
-	d.GetBodyVersion()
+	d.GetAuthorAssociation()
 }
 
-func TestDiscussionComment_GetCreatedAt(tt *testing.T) {
+func TestDiscussion_GetBody(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{Body: &zeroValue}
+	d.GetBody()
+	d = &Discussion{}
+	d.GetBody()
+	d = nil
+	d.GetBody()
+}
+
+func TestDiscussion_GetComments(tt *testing.T) {
+	var zeroValue int
+	d := &Discussion{Comments: &zeroValue}
+	d.GetComments()
+	d = &Discussion{}
+	d.GetComments()
+	d = nil
+	d.GetComments()
+}
+
+func TestDiscussion_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	d := &DiscussionComment{CreatedAt: &zeroValue}
+	d := &Discussion{CreatedAt: &zeroValue}
 	d.GetCreatedAt()
-	d = &DiscussionComment{}
+	d = &Discussion{}
 	d.GetCreatedAt()
 	d = nil
 	d.GetCreatedAt()
 }
 
-func TestDiscussionComment_GetDiscussionURL(tt *testing.T) {
-	var zeroValue string
-	d := &DiscussionComment{DiscussionURL: &zeroValue}
-	d.GetDiscussionURL()
-	d = &DiscussionComment{}
-	d.GetDiscussionURL()
+func TestDiscussion_GetDiscussionCategory(tt *testing.T) {
+	d := &Discussion{}
+	d.GetDiscussionCategory()
 	d = nil
-	d.GetDiscussionURL()
+	d.GetDiscussionCategory()
 }
 
-func TestDiscussionComment_GetHTMLURL(tt *testing.T) {
+func TestDiscussion_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{HTMLURL: &zeroValue}
+	d := &Discussion{HTMLURL: &zeroValue}
 	d.GetHTMLURL()
-	d = &DiscussionComment{}
+	d = &Discussion{}
 	d.GetHTMLURL()
 	d = nil
 	d.GetHTMLURL()
 }
 
-func TestDiscussionComment_GetLastEditedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DiscussionComment{LastEditedAt: &zeroValue}
-	d.GetLastEditedAt()
-	d = &DiscussionComment{}
-	d.GetLastEditedAt()
+func TestDiscussion_GetID(tt *testing.T) {
+	var zeroValue int64
+	d := &Discussion{ID: &zeroValue}
+	d.GetID()
+	d = &Discussion{}
+	d.GetID()
 	d = nil
-	d.GetLastEditedAt()
+	d.GetID()
 }
 
-func TestDiscussionComment_GetNodeID(tt *testing.T) {
+func TestDiscussion_GetLocked(tt *testing.T) {
+	var zeroValue bool
+	d := &Discussion{Locked: &zeroValue}
+	d.GetLocked()
+	d = &Discussion{}
+	d.GetLocked()
+	d = nil
+	d.GetLocked()
+}
+
+func TestDiscussion_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{NodeID: &zeroValue}
+	d := &Discussion{NodeID: &zeroValue}
 	d.GetNodeID()
-	d = &DiscussionComment{}
+	d = &Discussion{}
 	d.GetNodeID()
 	d = nil
 	d.GetNodeID()
 }
 
-func TestDiscussionComment_GetNumber(tt *testing.T) {
+func TestDiscussion_GetNumber(tt *testing.T) {
 	var zeroValue int
-	d := &DiscussionComment{Number: &zeroValue}
+	d := &Discussion{Number: &zeroValue}
 	d.GetNumber()
-	d = &DiscussionComment{}
+	d = &Discussion{}
 	d.GetNumber()
 	d = nil
 	d.GetNumber()
 }
 
-func TestDiscussionComment_GetReactions(tt *testing.T) {
-	d := &DiscussionComment{}
-	d.GetReactions()
+func TestDiscussion_GetRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{RepositoryURL: &zeroValue}
+	d.GetRepositoryURL()
+	d = &Discussion{}
+	d.GetRepositoryURL()
 	d = nil
-	d.GetReactions()
+	d.GetRepositoryURL()
 }
 
-func TestDiscussionComment_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DiscussionComment{UpdatedAt: &zeroValue}
-	d.GetUpdatedAt()
-	d = &DiscussionComment{}
-	d.GetUpdatedAt()
+func TestDiscussion_GetState(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{State: &zeroValue}
+	d.GetState()
+	d = &Discussion{}
+	d.GetState()
 	d = nil
-	d.GetUpdatedAt()
+	d.GetState()
 }
 
-func TestDiscussionComment_GetURL(tt *testing.T) {
+func TestDiscussion_GetTitle(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{URL: &zeroValue}
-	d.GetURL()
-	d = &DiscussionComment{}
-	d.GetURL()
+	d := &Discussion{Title: &zeroValue}
+	d.GetTitle()
+	d = &Discussion{}
+	d.GetTitle()
 	d = nil
-	d.GetURL()
+	d.GetTitle()
+}
+
+func TestDiscussion_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &Discussion{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &Discussion{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
+}
+
+func TestDiscussion_GetUser(tt *testing.T) {
+	d := &Discussion{}
+	d.GetUser()
+	d = nil
+	d.GetUser()
+}
+
+func TestDiscussionCategory_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionCategory{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DiscussionCategory{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
+}
+
+func TestDiscussionCategory_GetDescription(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionCategory{Description: &zeroValue}
+	d.GetDescription()
+	d = &DiscussionCategory{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
+}
+
+func TestDiscussionCategory_GetEmoji(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionCategory{Emoji: &zeroValue}
+	d.GetEmoji()
+	d = &DiscussionCategory{}
+	d.GetEmoji()
+	d = nil
+	d.GetEmoji()
+}
+
+func TestDiscussionCategory_GetID(tt *testing.T) {
+	var zeroValue int64
+	d := &DiscussionCategory{ID: &zeroValue}
+	d.GetID()
+	d = &DiscussionCategory{}
+	d.GetID()
+	d = nil
+	d.GetID()
+}
+
+func TestDiscussionCategory_GetIsAnswerable(tt *testing.T) {
+	var zeroValue bool
+	d := &DiscussionCategory{IsAnswerable: &zeroValue}
+	d.GetIsAnswerable()
+	d = &DiscussionCategory{}
+	d.GetIsAnswerable()
+	d = nil
+	d.GetIsAnswerable()
+}
+
+func TestDiscussionCategory_GetName(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionCategory{Name: &zeroValue}
+	d.GetName()
+	d = &DiscussionCategory{}
+	d.GetName()
+	d = nil
+	d.GetName()
+}
+
+func TestDiscussionCategory_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionCategory{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &DiscussionCategory{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
+}
+
+func TestDiscussionCategory_GetRepositoryID(tt *testing.T) {
+	var zeroValue int64
+	d := &DiscussionCategory{RepositoryID: &zeroValue}
+	d.GetRepositoryID()
+	d = &DiscussionCategory{}
+	d.GetRepositoryID()
+	d = nil
+	d.GetRepositoryID()
+}
+
+func TestDiscussionCategory_GetSlug(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionCategory{Slug: &zeroValue}
+	d.GetSlug()
+	d = &DiscussionCategory{}
+	d.GetSlug()
+	d = nil
+	d.GetSlug()
+}
+
+func TestDiscussionCategory_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionCategory{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DiscussionCategory{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
+}
+
+func TestDiscussionComment_GetAuthor(tt *testing.T) {
+	d := &DiscussionComment{}
+	d.GetAuthor()
+	d = nil
+	d.GetAuthor()
+}
+
+func TestDiscussionComment_GetBody(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{Body: &zeroValue}
+	d.GetBody()
+	d = &DiscussionComment{}
+	d.GetBody()
+	d = nil
+	d.GetBody()
+}
+
+func TestDiscussionComment_GetBodyHTML(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{BodyHTML: &zeroValue}
+	d.GetBodyHTML()
+	d = &DiscussionComment{}
+	d.GetBodyHTML()
+	d = nil
+	d.GetBodyHTML()
+}
+
+func TestDiscussionComment_GetBodyVersion(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{BodyVersion: &zeroValue}
+	d.GetBodyVersion()
+	d = &DiscussionComment{}
+	d.GetBodyVersion()
+	d = nil
+	d.GetBodyVersion()
+}
+
+func TestDiscussionComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionComment{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DiscussionComment{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
+}
+
+func TestDiscussionComment_GetDiscussionURL(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{DiscussionURL: &zeroValue}
+	d.GetDiscussionURL()
+	d = &DiscussionComment{}
+	d.GetDiscussionURL()
+	d = nil
+	d.GetDiscussionURL()
+}
+
+func TestDiscussionComment_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{HTMLURL: &zeroValue}
+	d.GetHTMLURL()
+	d = &DiscussionComment{}
+	d.GetHTMLURL()
+	d = nil
+	d.GetHTMLURL()
+}
+
+func TestDiscussionComment_GetLastEditedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionComment{LastEditedAt: &zeroValue}
+	d.GetLastEditedAt()
+	d = &DiscussionComment{}
+	d.GetLastEditedAt()
+	d = nil
+	d.GetLastEditedAt()
+}
+
+func TestDiscussionComment_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &DiscussionComment{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
+}
+
+func TestDiscussionComment_GetNumber(tt *testing.T) {
+	var zeroValue int
+	d := &DiscussionComment{Number: &zeroValue}
+	d.GetNumber()
+	d = &DiscussionComment{}
+	d.GetNumber()
+	d = nil
+	d.GetNumber()
+}
+
+func TestDiscussionComment_GetReactions(tt *testing.T) {
+	d := &DiscussionComment{}
+	d.GetReactions()
+	d = nil
+	d.GetReactions()
+}
+
+func TestDiscussionComment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionComment{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DiscussionComment{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
+}
+
+func TestDiscussionComment_GetURL(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{URL: &zeroValue}
+	d.GetURL()
+	d = &DiscussionComment{}
+	d.GetURL()
+	d = nil
+	d.GetURL()
+}
+
+func TestDiscussionEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionEvent{Action: &zeroValue}
+	d.GetAction()
+	d = &DiscussionEvent{}
+	d.GetAction()
+	d = nil
+	d.GetAction()
+}
+
+func TestDiscussionEvent_GetDiscussion(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetDiscussion()
+	d = nil
+	d.GetDiscussion()
+}
+
+func TestDiscussionEvent_GetInstallation(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetInstallation()
+	d = nil
+	d.GetInstallation()
+}
+
+func TestDiscussionEvent_GetOrg(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetOrg()
+	d = nil
+	d.GetOrg()
+}
+
+func TestDiscussionEvent_GetRepo(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetRepo()
+	d = nil
+	d.GetRepo()
+}
+
+func TestDiscussionEvent_GetSender(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetSender()
+	d = nil
+	d.GetSender()
 }
 
 func TestDismissalRestrictionsRequest_GetTeams(tt *testing.T) {
@@ -4624,6 +5919,33 @@ func TestEnvironment_GetWaitTimer(tt *testing.T) {
 	e.GetWaitTimer()
 }
 
+func TestEnvironmentApprovals_GetComment(tt *testing.T) {
+	var zeroValue string
+	e := &EnvironmentApprovals{Comment: &zeroValue}
+	e.GetComment()
+	e = &EnvironmentApprovals{}
+	e.GetComment()
+	e = nil
+	e.GetComment()
+}
+
+func TestEnvironmentApprovals_GetState(tt *testing.T) {
+	var zeroValue string
+	e := &EnvironmentApprovals{State: &zeroValue}
+	e.GetState()
+	e = &EnvironmentApprovals{}
+	e.GetState()
+	e = nil
+	e.GetState()
+}
+
+func TestEnvironmentApprovals_GetUser(tt *testing.T) {
+	e := &EnvironmentApprovals{}
+	e.GetUser()
+	e = nil
+	e.GetUser()
+}
+
 func TestEnvResponse_GetTotalCount(tt *testing.T) {
 	var zeroValue int
 	e := &EnvResponse{TotalCount: &zeroValue}
@@ -4871,6 +6193,16 @@ func TestFeeds_GetUserURL(tt *testing.T) {
 	f.GetUserURL()
 }
 
+func TestFirstPatchedVersion_GetIdentifier(tt *testing.T) {
+	var zeroValue string
+	f := &FirstPatchedVersion{Identifier: &zeroValue}
+	f.GetIdentifier()
+	f = &FirstPatchedVersion{}
+	f.GetIdentifier()
+	f = nil
+	f.GetIdentifier()
+}
+
 func TestForkEvent_GetForkee(tt *testing.T) {
 	f := &ForkEvent{}
 	f.GetForkee()
@@ -5361,38 +6693,222 @@ func TestGitObject_GetURL(tt *testing.T) {
 	g.GetURL()
 }
 
-func TestGollumEvent_GetInstallation(tt *testing.T) {
-	g := &GollumEvent{}
-	g.GetInstallation()
+func TestGlobalSecurityAdvisory_GetCVEID(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{CVEID: &zeroValue}
+	g.GetCVEID()
+	g = &GlobalSecurityAdvisory{}
+	g.GetCVEID()
 	g = nil
-	g.GetInstallation()
+	g.GetCVEID()
 }
 
-func TestGollumEvent_GetRepo(tt *testing.T) {
-	g := &GollumEvent{}
-	g.GetRepo()
+func TestGlobalSecurityAdvisory_GetCVSS(tt *testing.T) {
+	g := &GlobalSecurityAdvisory{}
+	g.GetCVSS()
 	g = nil
-	g.GetRepo()
+	g.GetCVSS()
 }
 
-func TestGollumEvent_GetSender(tt *testing.T) {
-	g := &GollumEvent{}
-	g.GetSender()
+func TestGlobalSecurityAdvisory_GetDescription(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{Description: &zeroValue}
+	g.GetDescription()
+	g = &GlobalSecurityAdvisory{}
+	g.GetDescription()
 	g = nil
-	g.GetSender()
+	g.GetDescription()
 }
 
-func TestGPGEmail_GetEmail(tt *testing.T) {
+func TestGlobalSecurityAdvisory_GetGHSAID(tt *testing.T) {
 	var zeroValue string
-	g := &GPGEmail{Email: &zeroValue}
-	g.GetEmail()
-	g = &GPGEmail{}
-	g.GetEmail()
+	g := &GlobalSecurityAdvisory{GHSAID: &zeroValue}
+	g.GetGHSAID()
+	g = &GlobalSecurityAdvisory{}
+	g.GetGHSAID()
 	g = nil
-	g.GetEmail()
+	g.GetGHSAID()
 }
 
-func TestGPGEmail_GetVerified(tt *testing.T) {
+func TestGlobalSecurityAdvisory_GetGitHubReviewedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GlobalSecurityAdvisory{GitHubReviewedAt: &zeroValue}
+	g.GetGitHubReviewedAt()
+	g = &GlobalSecurityAdvisory{}
+	g.GetGitHubReviewedAt()
+	g = nil
+	g.GetGitHubReviewedAt()
+}
+
+func TestGlobalSecurityAdvisory_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{HTMLURL: &zeroValue}
+	g.GetHTMLURL()
+	g = &GlobalSecurityAdvisory{}
+	g.GetHTMLURL()
+	g = nil
+	g.GetHTMLURL()
+}
+
+func TestGlobalSecurityAdvisory_GetNVDPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GlobalSecurityAdvisory{NVDPublishedAt: &zeroValue}
+	g.GetNVDPublishedAt()
+	g = &GlobalSecurityAdvisory{}
+	g.GetNVDPublishedAt()
+	g = nil
+	g.GetNVDPublishedAt()
+}
+
+func TestGlobalSecurityAdvisory_GetPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GlobalSecurityAdvisory{PublishedAt: &zeroValue}
+	g.GetPublishedAt()
+	g = &GlobalSecurityAdvisory{}
+	g.GetPublishedAt()
+	g = nil
+	g.GetPublishedAt()
+}
+
+func TestGlobalSecurityAdvisory_GetRepositoryAdvisoryURL(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{RepositoryAdvisoryURL: &zeroValue}
+	g.GetRepositoryAdvisoryURL()
+	g = &GlobalSecurityAdvisory{}
+	g.GetRepositoryAdvisoryURL()
+	g = nil
+	g.GetRepositoryAdvisoryURL()
+}
+
+func TestGlobalSecurityAdvisory_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{Severity: &zeroValue}
+	g.GetSeverity()
+	g = &GlobalSecurityAdvisory{}
+	g.GetSeverity()
+	g = nil
+	g.GetSeverity()
+}
+
+func TestGlobalSecurityAdvisory_GetSourceCodeLocation(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{SourceCodeLocation: &zeroValue}
+	g.GetSourceCodeLocation()
+	g = &GlobalSecurityAdvisory{}
+	g.GetSourceCodeLocation()
+	g = nil
+	g.GetSourceCodeLocation()
+}
+
+func TestGlobalSecurityAdvisory_GetSummary(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{Summary: &zeroValue}
+	g.GetSummary()
+	g = &GlobalSecurityAdvisory{}
+	g.GetSummary()
+	g = nil
+	g.GetSummary()
+}
+
+func TestGlobalSecurityAdvisory_GetType(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{Type: &zeroValue}
+	g.GetType()
+	g = &GlobalSecurityAdvisory{}
+	g.GetType()
+	g = nil
+	g.GetType()
+}
+
+func TestGlobalSecurityAdvisory_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GlobalSecurityAdvisory{UpdatedAt: &zeroValue}
+	g.GetUpdatedAt()
+	g = &GlobalSecurityAdvisory{}
+	g.GetUpdatedAt()
+	g = nil
+	g.GetUpdatedAt()
+}
+
+func TestGlobalSecurityAdvisory_GetURL(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{URL: &zeroValue}
+	g.GetURL()
+	g = &GlobalSecurityAdvisory{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
+}
+
+func TestGlobalSecurityAdvisory_GetWithdrawnAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GlobalSecurityAdvisory{WithdrawnAt: &zeroValue}
+	g.GetWithdrawnAt()
+	g = &GlobalSecurityAdvisory{}
+	g.GetWithdrawnAt()
+	g = nil
+	g.GetWithdrawnAt()
+}
+
+func TestGlobalSecurityVulnerability_GetFirstPatchedVersion(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityVulnerability{FirstPatchedVersion: &zeroValue}
+	g.GetFirstPatchedVersion()
+	g = &GlobalSecurityVulnerability{}
+	g.GetFirstPatchedVersion()
+	g = nil
+	g.GetFirstPatchedVersion()
+}
+
+func TestGlobalSecurityVulnerability_GetPackage(tt *testing.T) {
+	g := &GlobalSecurityVulnerability{}
+	g.GetPackage()
+	g = nil
+	g.GetPackage()
+}
+
+func TestGlobalSecurityVulnerability_GetVulnerableVersionRange(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityVulnerability{VulnerableVersionRange: &zeroValue}
+	g.GetVulnerableVersionRange()
+	g = &GlobalSecurityVulnerability{}
+	g.GetVulnerableVersionRange()
+	g = nil
+	g.GetVulnerableVersionRange()
+}
+
+func TestGollumEvent_GetInstallation(tt *testing.T) {
+	g := &GollumEvent{}
+	g.GetInstallation()
+	g = nil
+	g.GetInstallation()
+}
+
+func TestGollumEvent_GetRepo(tt *testing.T) {
+	g := &GollumEvent{}
+	g.GetRepo()
+	g = nil
+	g.GetRepo()
+}
+
+func TestGollumEvent_GetSender(tt *testing.T) {
+	g := &GollumEvent{}
+	g.GetSender()
+	g = nil
+	g.GetSender()
+}
+
+func TestGPGEmail_GetEmail(tt *testing.T) {
+	var zeroValue string
+	g := &GPGEmail{Email: &zeroValue}
+	g.GetEmail()
+	g = &GPGEmail{}
+	g.GetEmail()
+	g = nil
+	g.GetEmail()
+}
+
+func TestGPGEmail_GetVerified(tt *testing.T) {
 	var zeroValue bool
 	g := &GPGEmail{Verified: &zeroValue}
 	g.GetVerified()
@@ -5753,6 +7269,16 @@ func TestHookConfig_GetInsecureSSL(tt *testing.T) {
 	h.GetInsecureSSL()
 }
 
+func TestHookConfig_GetSecret(tt *testing.T) {
+	var zeroValue string
+	h := &HookConfig{Secret: &zeroValue}
+	h.GetSecret()
+	h = &HookConfig{}
+	h.GetSecret()
+	h = nil
+	h.GetSecret()
+}
+
 func TestHookConfig_GetURL(tt *testing.T) {
 	var zeroValue string
 	h := &HookConfig{URL: &zeroValue}
@@ -6991,6 +8517,26 @@ func TestIssue_GetBody(tt *testing.T) {
 	i.GetBody()
 }
 
+func TestIssue_GetBodyHTML(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{BodyHTML: &zeroValue}
+	i.GetBodyHTML()
+	i = &Issue{}
+	i.GetBodyHTML()
+	i = nil
+	i.GetBodyHTML()
+}
+
+func TestIssue_GetBodyText(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{BodyText: &zeroValue}
+	i.GetBodyText()
+	i = &Issue{}
+	i.GetBodyText()
+	i = nil
+	i.GetBodyText()
+}
+
 func TestIssue_GetClosedAt(tt *testing.T) {
 	var zeroValue time.Time
 	i := &Issue{ClosedAt: &zeroValue}
@@ -7213,6 +8759,26 @@ func TestIssueComment_GetBody(tt *testing.T) {
 	i.GetBody()
 }
 
+func TestIssueComment_GetBodyHTML(tt *testing.T) {
+	var zeroValue string
+	i := &IssueComment{BodyHTML: &zeroValue}
+	i.GetBodyHTML()
+	i = &IssueComment{}
+	i.GetBodyHTML()
+	i = nil
+	i.GetBodyHTML()
+}
+
+func TestIssueComment_GetBodyText(tt *testing.T) {
+	var zeroValue string
+	i := &IssueComment{BodyText: &zeroValue}
+	i.GetBodyText()
+	i = &IssueComment{}
+	i.GetBodyText()
+	i = nil
+	i.GetBodyText()
+}
+
 func TestIssueComment_GetCreatedAt(tt *testing.T) {
 	var zeroValue time.Time
 	i := &IssueComment{CreatedAt: &zeroValue}
@@ -7905,6 +9471,16 @@ func TestJobs_GetTotalCount(tt *testing.T) {
 	j.GetTotalCount()
 }
 
+func TestKey_GetAddedBy(tt *testing.T) {
+	var zeroValue string
+	k := &Key{AddedBy: &zeroValue}
+	k.GetAddedBy()
+	k = &Key{}
+	k.GetAddedBy()
+	k = nil
+	k.GetAddedBy()
+}
+
 func TestKey_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	k := &Key{CreatedAt: &zeroValue}
@@ -7935,6 +9511,16 @@ func TestKey_GetKey(tt *testing.T) {
 	k.GetKey()
 }
 
+func TestKey_GetLastUsed(tt *testing.T) {
+	var zeroValue Timestamp
+	k := &Key{LastUsed: &zeroValue}
+	k.GetLastUsed()
+	k = &Key{}
+	k.GetLastUsed()
+	k = nil
+	k.GetLastUsed()
+}
+
 func TestKey_GetReadOnly(tt *testing.T) {
 	var zeroValue bool
 	k := &Key{ReadOnly: &zeroValue}
@@ -8217,6 +9803,16 @@ func TestLargeFile_GetSize(tt *testing.T) {
 	l.GetSize()
 }
 
+func TestLDAPSyncStatus_GetStatus(tt *testing.T) {
+	var zeroValue string
+	l := &LDAPSyncStatus{Status: &zeroValue}
+	l.GetStatus()
+	l = &LDAPSyncStatus{}
+	l.GetStatus()
+	l = nil
+	l.GetStatus()
+}
+
 func TestLicense_GetBody(tt *testing.T) {
 	var zeroValue string
 	l := &License{Body: &zeroValue}
@@ -8307,6 +9903,16 @@ func TestLicense_GetName(tt *testing.T) {
 	l.GetName()
 }
 
+func TestLicense_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	l := &License{NodeID: &zeroValue}
+	l.GetNodeID()
+	l = &License{}
+	l.GetNodeID()
+	l = nil
+	l.GetNodeID()
+}
+
 func TestLicense_GetPermissions(tt *testing.T) {
 	var zeroValue []string
 	l := &License{Permissions: &zeroValue}
@@ -8407,6 +10013,16 @@ func TestListCheckSuiteResults_GetTotal(tt *testing.T) {
 	l.GetTotal()
 }
 
+func TestListCodespaces_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	l := &ListCodespaces{TotalCount: &zeroValue}
+	l.GetTotalCount()
+	l = &ListCodespaces{}
+	l.GetTotalCount()
+	l = nil
+	l.GetTotalCount()
+}
+
 func TestListCollaboratorOptions_GetAffiliation(tt *testing.T) {
 	var zeroValue string
 	l := &ListCollaboratorOptions{Affiliation: &zeroValue}
@@ -8871,6 +10487,26 @@ func TestMemberEvent_GetSender(tt *testing.T) {
 	m.GetSender()
 }
 
+func TestMemberRepositoryPermissionsError_GetErrors(tt *testing.T) {
+	zeroValue := map[string]error{}
+	m := &MemberRepositoryPermissionsError{Errors: zeroValue}
+	m.GetErrors()
+	m = &MemberRepositoryPermissionsError{}
+	m.GetErrors()
+	m = nil
+	m.GetErrors()
+}
+
+func TestMemberRolesError_GetErrors(tt *testing.T) {
+	zeroValue := map[string]error{}
+	m := &MemberRolesError{Errors: zeroValue}
+	m.GetErrors()
+	m = &MemberRolesError{}
+	m.GetErrors()
+	m = nil
+	m.GetErrors()
+}
+
 func TestMembership_GetOrganization(tt *testing.T) {
 	m := &Membership{}
 	m.GetOrganization()
@@ -9440,6 +11076,20 @@ func TestMostRecentInstance_GetState(tt *testing.T) {
 	m.GetState()
 }
 
+func TestMoveFileResult_GetCreate(tt *testing.T) {
+	m := &MoveFileResult{}
+	m.GetCreate()
+	m = nil
+	m.GetCreate()
+}
+
+func TestMoveFileResult_GetDelete(tt *testing.T) {
+	m := &MoveFileResult{}
+	m.GetDelete()
+	m = nil
+	m.GetDelete()
+}
+
 func TestNewPullRequest_GetBase(tt *testing.T) {
 	var zeroValue string
 	n := &NewPullRequest{Base: &zeroValue}
@@ -10111,6 +11761,16 @@ func TestOrganization_GetReposURL(tt *testing.T) {
 	o.GetReposURL()
 }
 
+func TestOrganization_GetSecretScanningPushProtectionEnabledForNewRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{SecretScanningPushProtectionEnabledForNewRepos: &zeroValue}
+	o.GetSecretScanningPushProtectionEnabledForNewRepos()
+	o = &Organization{}
+	o.GetSecretScanningPushProtectionEnabledForNewRepos()
+	o = nil
+	o.GetSecretScanningPushProtectionEnabledForNewRepos()
+}
+
 func TestOrganization_GetTotalPrivateRepos(tt *testing.T) {
 	var zeroValue int
 	o := &Organization{TotalPrivateRepos: &zeroValue}
@@ -10171,6 +11831,16 @@ func TestOrganization_GetURL(tt *testing.T) {
 	o.GetURL()
 }
 
+func TestOrganization_GetWebCommitSignoffRequired(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{WebCommitSignoffRequired: &zeroValue}
+	o.GetWebCommitSignoffRequired()
+	o = &Organization{}
+	o.GetWebCommitSignoffRequired()
+	o = nil
+	o.GetWebCommitSignoffRequired()
+}
+
 func TestOrganizationEvent_GetAction(tt *testing.T) {
 	var zeroValue string
 	o := &OrganizationEvent{Action: &zeroValue}
@@ -11090,6 +12760,56 @@ func TestPagesBuild_GetURL(tt *testing.T) {
 	p.GetURL()
 }
 
+func TestPagesDeployment_GetID(tt *testing.T) {
+	var zeroValue string
+	p := &PagesDeployment{ID: &zeroValue}
+	p.GetID()
+	p = &PagesDeployment{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestPagesDeployment_GetPageURL(tt *testing.T) {
+	var zeroValue string
+	p := &PagesDeployment{PageURL: &zeroValue}
+	p.GetPageURL()
+	p = &PagesDeployment{}
+	p.GetPageURL()
+	p = nil
+	p.GetPageURL()
+}
+
+func TestPagesDeployment_GetPreviewURL(tt *testing.T) {
+	var zeroValue string
+	p := &PagesDeployment{PreviewURL: &zeroValue}
+	p.GetPreviewURL()
+	p = &PagesDeployment{}
+	p.GetPreviewURL()
+	p = nil
+	p.GetPreviewURL()
+}
+
+func TestPagesDeployment_GetStatusURL(tt *testing.T) {
+	var zeroValue string
+	p := &PagesDeployment{StatusURL: &zeroValue}
+	p.GetStatusURL()
+	p = &PagesDeployment{}
+	p.GetStatusURL()
+	p = nil
+	p.GetStatusURL()
+}
+
+func TestPagesDeploymentStatus_GetStatus(tt *testing.T) {
+	var zeroValue string
+	p := &PagesDeploymentStatus{Status: &zeroValue}
+	p.GetStatus()
+	p = &PagesDeploymentStatus{}
+	p.GetStatus()
+	p = nil
+	p.GetStatus()
+}
+
 func TestPagesError_GetMessage(tt *testing.T) {
 	var zeroValue string
 	p := &PagesError{Message: &zeroValue}
@@ -11150,56 +12870,351 @@ func TestPagesUpdate_GetSource(tt *testing.T) {
 	p.GetSource()
 }
 
-func TestPingEvent_GetHook(tt *testing.T) {
-	p := &PingEvent{}
-	p.GetHook()
+func TestPendingDeployment_GetCurrentUserCanApprove(tt *testing.T) {
+	var zeroValue bool
+	p := &PendingDeployment{CurrentUserCanApprove: &zeroValue}
+	p.GetCurrentUserCanApprove()
+	p = &PendingDeployment{}
+	p.GetCurrentUserCanApprove()
 	p = nil
-	p.GetHook()
+	p.GetCurrentUserCanApprove()
 }
 
-func TestPingEvent_GetHookID(tt *testing.T) {
+func TestPendingDeployment_GetEnvironment(tt *testing.T) {
+	p := &PendingDeployment{}
+	p.GetEnvironment()
+	p = nil
+	p.GetEnvironment()
+}
+
+func TestPendingDeployment_GetWaitTimer(tt *testing.T) {
 	var zeroValue int64
-	p := &PingEvent{HookID: &zeroValue}
-	p.GetHookID()
-	p = &PingEvent{}
-	p.GetHookID()
+	p := &PendingDeployment{WaitTimer: &zeroValue}
+	p.GetWaitTimer()
+	p = &PendingDeployment{}
+	p.GetWaitTimer()
 	p = nil
-	p.GetHookID()
+	p.GetWaitTimer()
 }
 
-func TestPingEvent_GetInstallation(tt *testing.T) {
-	p := &PingEvent{}
-	p.GetInstallation()
+func TestPendingDeployment_GetWaitTimerStartedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PendingDeployment{WaitTimerStartedAt: &zeroValue}
+	p.GetWaitTimerStartedAt()
+	p = &PendingDeployment{}
+	p.GetWaitTimerStartedAt()
 	p = nil
-	p.GetInstallation()
+	p.GetWaitTimerStartedAt()
 }
 
-func TestPingEvent_GetZen(tt *testing.T) {
+func TestPendingDeploymentEnvironment_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &PingEvent{Zen: &zeroValue}
-	p.GetZen()
-	p = &PingEvent{}
-	p.GetZen()
+	p := &PendingDeploymentEnvironment{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PendingDeploymentEnvironment{}
+	p.GetHTMLURL()
 	p = nil
-	p.GetZen()
+	p.GetHTMLURL()
 }
 
-func TestPlan_GetCollaborators(tt *testing.T) {
-	var zeroValue int
-	p := &Plan{Collaborators: &zeroValue}
-	p.GetCollaborators()
-	p = &Plan{}
-	p.GetCollaborators()
+func TestPendingDeploymentEnvironment_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PendingDeploymentEnvironment{ID: &zeroValue}
+	p.GetID()
+	p = &PendingDeploymentEnvironment{}
+	p.GetID()
 	p = nil
-	p.GetCollaborators()
+	p.GetID()
 }
 
-func TestPlan_GetFilledSeats(tt *testing.T) {
-	var zeroValue int
-	p := &Plan{FilledSeats: &zeroValue}
-	p.GetFilledSeats()
-	p = &Plan{}
-	p.GetFilledSeats()
+func TestPendingDeploymentEnvironment_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &PendingDeploymentEnvironment{Name: &zeroValue}
+	p.GetName()
+	p = &PendingDeploymentEnvironment{}
+	p.GetName()
+	p = nil
+	p.GetName()
+}
+
+func TestPendingDeploymentEnvironment_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &PendingDeploymentEnvironment{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PendingDeploymentEnvironment{}
+	p.GetNodeID()
+	p = nil
+	p.GetNodeID()
+}
+
+func TestPendingDeploymentEnvironment_GetURL(tt *testing.T) {
+	var zeroValue string
+	p := &PendingDeploymentEnvironment{URL: &zeroValue}
+	p.GetURL()
+	p = &PendingDeploymentEnvironment{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
+}
+
+func TestPersonalAccessToken_GetAccessGrantedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessToken{AccessGrantedAt: &zeroValue}
+	p.GetAccessGrantedAt()
+	p = &PersonalAccessToken{}
+	p.GetAccessGrantedAt()
+	p = nil
+	p.GetAccessGrantedAt()
+}
+
+func TestPersonalAccessToken_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PersonalAccessToken{ID: &zeroValue}
+	p.GetID()
+	p = &PersonalAccessToken{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestPersonalAccessToken_GetOwner(tt *testing.T) {
+	p := &PersonalAccessToken{}
+	p.GetOwner()
+	p = nil
+	p.GetOwner()
+}
+
+func TestPersonalAccessToken_GetPermissions(tt *testing.T) {
+	p := &PersonalAccessToken{}
+	p.GetPermissions()
+	p = nil
+	p.GetPermissions()
+}
+
+func TestPersonalAccessToken_GetRepositoriesURL(tt *testing.T) {
+	var zeroValue string
+	p := &PersonalAccessToken{RepositoriesURL: &zeroValue}
+	p.GetRepositoriesURL()
+	p = &PersonalAccessToken{}
+	p.GetRepositoriesURL()
+	p = nil
+	p.GetRepositoriesURL()
+}
+
+func TestPersonalAccessToken_GetRepositorySelection(tt *testing.T) {
+	var zeroValue string
+	p := &PersonalAccessToken{RepositorySelection: &zeroValue}
+	p.GetRepositorySelection()
+	p = &PersonalAccessToken{}
+	p.GetRepositorySelection()
+	p = nil
+	p.GetRepositorySelection()
+}
+
+func TestPersonalAccessToken_GetTokenExpired(tt *testing.T) {
+	var zeroValue bool
+	p := &PersonalAccessToken{TokenExpired: &zeroValue}
+	p.GetTokenExpired()
+	p = &PersonalAccessToken{}
+	p.GetTokenExpired()
+	p = nil
+	p.GetTokenExpired()
+}
+
+func TestPersonalAccessToken_GetTokenExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessToken{TokenExpiresAt: &zeroValue}
+	p.GetTokenExpiresAt()
+	p = &PersonalAccessToken{}
+	p.GetTokenExpiresAt()
+	p = nil
+	p.GetTokenExpiresAt()
+}
+
+func TestPersonalAccessToken_GetTokenLastUsedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessToken{TokenLastUsedAt: &zeroValue}
+	p.GetTokenLastUsedAt()
+	p = &PersonalAccessToken{}
+	p.GetTokenLastUsedAt()
+	p = nil
+	p.GetTokenLastUsedAt()
+}
+
+func TestPersonalAccessTokenPermissions_GetOrg(tt *testing.T) {
+	zeroValue := map[string]string{}
+	p := &PersonalAccessTokenPermissions{Org: zeroValue}
+	p.GetOrg()
+	p = &PersonalAccessTokenPermissions{}
+	p.GetOrg()
+	p = nil
+	p.GetOrg()
+}
+
+func TestPersonalAccessTokenPermissions_GetOther(tt *testing.T) {
+	zeroValue := map[string]string{}
+	p := &PersonalAccessTokenPermissions{Other: zeroValue}
+	p.GetOther()
+	p = &PersonalAccessTokenPermissions{}
+	p.GetOther()
+	p = nil
+	p.GetOther()
+}
+
+func TestPersonalAccessTokenPermissions_GetRepo(tt *testing.T) {
+	zeroValue := map[string]string{}
+	p := &PersonalAccessTokenPermissions{Repo: zeroValue}
+	p.GetRepo()
+	p = &PersonalAccessTokenPermissions{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
+}
+
+func TestPersonalAccessTokenRequest_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessTokenRequest{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PersonalAccessTokenRequest{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
+}
+
+func TestPersonalAccessTokenRequest_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PersonalAccessTokenRequest{ID: &zeroValue}
+	p.GetID()
+	p = &PersonalAccessTokenRequest{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestPersonalAccessTokenRequest_GetOwner(tt *testing.T) {
+	p := &PersonalAccessTokenRequest{}
+	p.GetOwner()
+	p = nil
+	p.GetOwner()
+}
+
+func TestPersonalAccessTokenRequest_GetPermissions(tt *testing.T) {
+	p := &PersonalAccessTokenRequest{}
+	p.GetPermissions()
+	p = nil
+	p.GetPermissions()
+}
+
+func TestPersonalAccessTokenRequest_GetReason(tt *testing.T) {
+	var zeroValue string
+	p := &PersonalAccessTokenRequest{Reason: &zeroValue}
+	p.GetReason()
+	p = &PersonalAccessTokenRequest{}
+	p.GetReason()
+	p = nil
+	p.GetReason()
+}
+
+func TestPersonalAccessTokenRequest_GetRepositoriesURL(tt *testing.T) {
+	var zeroValue string
+	p := &PersonalAccessTokenRequest{RepositoriesURL: &zeroValue}
+	p.GetRepositoriesURL()
+	p = &PersonalAccessTokenRequest{}
+	p.GetRepositoriesURL()
+	p = nil
+	p.GetRepositoriesURL()
+}
+
+func TestPersonalAccessTokenRequest_GetRepositorySelection(tt *testing.T) {
+	var zeroValue string
+	p := &PersonalAccessTokenRequest{RepositorySelection: &zeroValue}
+	p.GetRepositorySelection()
+	p = &PersonalAccessTokenRequest{}
+	p.GetRepositorySelection()
+	p = nil
+	p.GetRepositorySelection()
+}
+
+func TestPersonalAccessTokenRequest_GetTokenExpired(tt *testing.T) {
+	var zeroValue bool
+	p := &PersonalAccessTokenRequest{TokenExpired: &zeroValue}
+	p.GetTokenExpired()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenExpired()
+	p = nil
+	p.GetTokenExpired()
+}
+
+func TestPersonalAccessTokenRequest_GetTokenExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessTokenRequest{TokenExpiresAt: &zeroValue}
+	p.GetTokenExpiresAt()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenExpiresAt()
+	p = nil
+	p.GetTokenExpiresAt()
+}
+
+func TestPersonalAccessTokenRequest_GetTokenLastUsedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessTokenRequest{TokenLastUsedAt: &zeroValue}
+	p.GetTokenLastUsedAt()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenLastUsedAt()
+	p = nil
+	p.GetTokenLastUsedAt()
+}
+
+func TestPingEvent_GetHook(tt *testing.T) {
+	p := &PingEvent{}
+	p.GetHook()
+	p = nil
+	p.GetHook()
+}
+
+func TestPingEvent_GetHookID(tt *testing.T) {
+	var zeroValue int64
+	p := &PingEvent{HookID: &zeroValue}
+	p.GetHookID()
+	p = &PingEvent{}
+	p.GetHookID()
+	p = nil
+	p.GetHookID()
+}
+
+func TestPingEvent_GetInstallation(tt *testing.T) {
+	p := &PingEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
+}
+
+func TestPingEvent_GetZen(tt *testing.T) {
+	var zeroValue string
+	p := &PingEvent{Zen: &zeroValue}
+	p.GetZen()
+	p = &PingEvent{}
+	p.GetZen()
+	p = nil
+	p.GetZen()
+}
+
+func TestPlan_GetCollaborators(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{Collaborators: &zeroValue}
+	p.GetCollaborators()
+	p = &Plan{}
+	p.GetCollaborators()
+	p = nil
+	p.GetCollaborators()
+}
+
+func TestPlan_GetFilledSeats(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{FilledSeats: &zeroValue}
+	p.GetFilledSeats()
+	p = &Plan{}
+	p.GetFilledSeats()
 	p = nil
 	p.GetFilledSeats()
 }
@@ -11244,6 +13259,96 @@ func TestPlan_GetSpace(tt *testing.T) {
 	p.GetSpace()
 }
 
+func TestPreReceiveEnvironment_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PreReceiveEnvironment{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PreReceiveEnvironment{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
+}
+
+func TestPreReceiveEnvironment_GetDefaultEnvironment(tt *testing.T) {
+	var zeroValue bool
+	p := &PreReceiveEnvironment{DefaultEnvironment: &zeroValue}
+	p.GetDefaultEnvironment()
+	p = &PreReceiveEnvironment{}
+	p.GetDefaultEnvironment()
+	p = nil
+	p.GetDefaultEnvironment()
+}
+
+func TestPreReceiveEnvironment_GetHooksCount(tt *testing.T) {
+	var zeroValue int
+	p := &PreReceiveEnvironment{HooksCount: &zeroValue}
+	p.GetHooksCount()
+	p = &PreReceiveEnvironment{}
+	p.GetHooksCount()
+	p = nil
+	p.GetHooksCount()
+}
+
+func TestPreReceiveEnvironment_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &PreReceiveEnvironment{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PreReceiveEnvironment{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
+}
+
+func TestPreReceiveEnvironment_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PreReceiveEnvironment{ID: &zeroValue}
+	p.GetID()
+	p = &PreReceiveEnvironment{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestPreReceiveEnvironment_GetImageURL(tt *testing.T) {
+	var zeroValue string
+	p := &PreReceiveEnvironment{ImageURL: &zeroValue}
+	p.GetImageURL()
+	p = &PreReceiveEnvironment{}
+	p.GetImageURL()
+	p = nil
+	p.GetImageURL()
+}
+
+func TestPreReceiveEnvironment_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &PreReceiveEnvironment{Name: &zeroValue}
+	p.GetName()
+	p = &PreReceiveEnvironment{}
+	p.GetName()
+	p = nil
+	p.GetName()
+}
+
+func TestPreReceiveEnvironment_GetURL(tt *testing.T) {
+	var zeroValue string
+	p := &PreReceiveEnvironment{URL: &zeroValue}
+	p.GetURL()
+	p = &PreReceiveEnvironment{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
+}
+
+func TestPreReceiveHook_GetAllowDownstreamConfiguration(tt *testing.T) {
+	var zeroValue bool
+	p := &PreReceiveHook{AllowDownstreamConfiguration: &zeroValue}
+	p.GetAllowDownstreamConfiguration()
+	p = &PreReceiveHook{}
+	p.GetAllowDownstreamConfiguration()
+	p = nil
+	p.GetAllowDownstreamConfiguration()
+}
+
 func TestPreReceiveHook_GetConfigURL(tt *testing.T) {
 	var zeroValue string
 	p := &PreReceiveHook{ConfigURL: &zeroValue}
@@ -11264,6 +13369,13 @@ func TestPreReceiveHook_GetEnforcement(tt *testing.T) {
 	p.GetEnforcement()
 }
 
+func TestPreReceiveHook_GetEnvironment(tt *testing.T) {
+	p := &PreReceiveHook{}
+	p.GetEnvironment()
+	p = nil
+	p.GetEnvironment()
+}
+
 func TestPreReceiveHook_GetID(tt *testing.T) {
 	var zeroValue int64
 	p := &PreReceiveHook{ID: &zeroValue}
@@ -11284,6 +13396,23 @@ func TestPreReceiveHook_GetName(tt *testing.T) {
 	p.GetName()
 }
 
+func TestPreReceiveHook_GetScript(tt *testing.T) {
+	var zeroValue string
+	p := &PreReceiveHook{Script: &zeroValue}
+	p.GetScript()
+	p = &PreReceiveHook{}
+	p.GetScript()
+	p = nil
+	p.GetScript()
+}
+
+func TestPreReceiveHook_GetScriptRepository(tt *testing.T) {
+	p := &PreReceiveHook{}
+	p.GetScriptRepository()
+	p = nil
+	p.GetScriptRepository()
+}
+
 func TestPRLink_GetHRef(tt *testing.T) {
 	var zeroValue string
 	p := &PRLink{HRef: &zeroValue}
@@ -12182,6 +14311,16 @@ func TestProtectionRule_GetNodeID(tt *testing.T) {
 	p.GetNodeID()
 }
 
+func TestProtectionRule_GetPreventSelfReview(tt *testing.T) {
+	var zeroValue bool
+	p := &ProtectionRule{PreventSelfReview: &zeroValue}
+	p.GetPreventSelfReview()
+	p = &ProtectionRule{}
+	p.GetPreventSelfReview()
+	p = nil
+	p.GetPreventSelfReview()
+}
+
 func TestProtectionRule_GetType(tt *testing.T) {
 	var zeroValue string
 	p := &ProtectionRule{Type: &zeroValue}
@@ -14523,6 +16662,26 @@ func TestReleaseAsset_GetURL(tt *testing.T) {
 	r.GetURL()
 }
 
+func TestReleaseAssetsUploadError_GetErrors(tt *testing.T) {
+	zeroValue := map[int]error{}
+	r := &ReleaseAssetsUploadError{Errors: zeroValue}
+	r.GetErrors()
+	r = &ReleaseAssetsUploadError{}
+	r.GetErrors()
+	r = nil
+	r.GetErrors()
+}
+
+func TestReleaseAssetsUploadError_GetRollbackErrors(tt *testing.T) {
+	zeroValue := map[int]error{}
+	r := &ReleaseAssetsUploadError{RollbackErrors: zeroValue}
+	r.GetRollbackErrors()
+	r = &ReleaseAssetsUploadError{}
+	r.GetRollbackErrors()
+	r = nil
+	r.GetRollbackErrors()
+}
+
 func TestReleaseEvent_GetAction(tt *testing.T) {
 	var zeroValue string
 	r := &ReleaseEvent{Action: &zeroValue}
@@ -14621,14 +16780,54 @@ func TestRenameOrgResponse_GetURL(tt *testing.T) {
 	r.GetURL()
 }
 
-func TestRepositoriesSearchResult_GetIncompleteResults(tt *testing.T) {
-	var zeroValue bool
-	r := &RepositoriesSearchResult{IncompleteResults: &zeroValue}
-	r.GetIncompleteResults()
-	r = &RepositoriesSearchResult{}
-	r.GetIncompleteResults()
-	r = nil
-	r.GetIncompleteResults()
+func TestRepoMergeUpstreamRequest_GetBranch(tt *testing.T) {
+	var zeroValue string
+	r := &RepoMergeUpstreamRequest{Branch: &zeroValue}
+	r.GetBranch()
+	r = &RepoMergeUpstreamRequest{}
+	r.GetBranch()
+	r = nil
+	r.GetBranch()
+}
+
+func TestRepoMergeUpstreamResult_GetBaseBranch(tt *testing.T) {
+	var zeroValue string
+	r := &RepoMergeUpstreamResult{BaseBranch: &zeroValue}
+	r.GetBaseBranch()
+	r = &RepoMergeUpstreamResult{}
+	r.GetBaseBranch()
+	r = nil
+	r.GetBaseBranch()
+}
+
+func TestRepoMergeUpstreamResult_GetMergeType(tt *testing.T) {
+	var zeroValue string
+	r := &RepoMergeUpstreamResult{MergeType: &zeroValue}
+	r.GetMergeType()
+	r = &RepoMergeUpstreamResult{}
+	r.GetMergeType()
+	r = nil
+	r.GetMergeType()
+}
+
+func TestRepoMergeUpstreamResult_GetMessage(tt *testing.T) {
+	var zeroValue string
+	r := &RepoMergeUpstreamResult{Message: &zeroValue}
+	r.GetMessage()
+	r = &RepoMergeUpstreamResult{}
+	r.GetMessage()
+	r = nil
+	r.GetMessage()
+}
+
+func TestRepositoriesSearchResult_GetIncompleteResults(tt *testing.T) {
+	var zeroValue bool
+	r := &RepositoriesSearchResult{IncompleteResults: &zeroValue}
+	r.GetIncompleteResults()
+	r = &RepositoriesSearchResult{}
+	r.GetIncompleteResults()
+	r = nil
+	r.GetIncompleteResults()
 }
 
 func TestRepositoriesSearchResult_GetTotal(tt *testing.T) {
@@ -15530,6 +17729,83 @@ func TestRepository_GetWatchersCount(tt *testing.T) {
 	r.GetWatchersCount()
 }
 
+func TestRepositoryActivity_GetActivityType(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryActivity{ActivityType: &zeroValue}
+	r.GetActivityType()
+	r = &RepositoryActivity{}
+	r.GetActivityType()
+	r = nil
+	r.GetActivityType()
+}
+
+func TestRepositoryActivity_GetActor(tt *testing.T) {
+	r := &RepositoryActivity{}
+	r.GetActor()
+	r = nil
+	r.GetActor()
+}
+
+func TestRepositoryActivity_GetAfter(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryActivity{After: &zeroValue}
+	r.GetAfter()
+	r = &RepositoryActivity{}
+	r.GetAfter()
+	r = nil
+	r.GetAfter()
+}
+
+func TestRepositoryActivity_GetBefore(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryActivity{Before: &zeroValue}
+	r.GetBefore()
+	r = &RepositoryActivity{}
+	r.GetBefore()
+	r = nil
+	r.GetBefore()
+}
+
+func TestRepositoryActivity_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepositoryActivity{ID: &zeroValue}
+	r.GetID()
+	r = &RepositoryActivity{}
+	r.GetID()
+	r = nil
+	r.GetID()
+}
+
+func TestRepositoryActivity_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryActivity{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &RepositoryActivity{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
+}
+
+func TestRepositoryActivity_GetRef(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryActivity{Ref: &zeroValue}
+	r.GetRef()
+	r = &RepositoryActivity{}
+	r.GetRef()
+	r = nil
+	r.GetRef()
+}
+
+func TestRepositoryActivity_GetTimestamp(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryActivity{Timestamp: &zeroValue}
+	r.GetTimestamp()
+	r = &RepositoryActivity{}
+	r.GetTimestamp()
+	r = nil
+	r.GetTimestamp()
+}
+
 func TestRepositoryComment_GetBody(tt *testing.T) {
 	var zeroValue string
 	r := &RepositoryComment{Body: &zeroValue}
@@ -15580,6 +17856,16 @@ func TestRepositoryComment_GetID(tt *testing.T) {
 	r.GetID()
 }
 
+func TestRepositoryComment_GetLine(tt *testing.T) {
+	var zeroValue int
+	r := &RepositoryComment{Line: &zeroValue}
+	r.GetLine()
+	r = &RepositoryComment{}
+	r.GetLine()
+	r = nil
+	r.GetLine()
+}
+
 func TestRepositoryComment_GetNodeID(tt *testing.T) {
 	var zeroValue string
 	r := &RepositoryComment{NodeID: &zeroValue}
@@ -16204,6 +18490,33 @@ func TestRepositoryPermissionLevel_GetUser(tt *testing.T) {
 	r.GetUser()
 }
 
+func TestRepositoryProfile_GetLanguages(tt *testing.T) {
+	zeroValue := map[string]int{}
+	r := &RepositoryProfile{Languages: zeroValue}
+	r.GetLanguages()
+	r = &RepositoryProfile{}
+	r.GetLanguages()
+	r = nil
+	r.GetLanguages()
+}
+
+func TestRepositoryProfile_GetRepository(tt *testing.T) {
+	r := &RepositoryProfile{}
+	r.GetRepository()
+	r = nil
+	r.GetRepository()
+}
+
+func TestRepositoryProfileError_GetErrors(tt *testing.T) {
+	zeroValue := map[string]error{}
+	r := &RepositoryProfileError{Errors: zeroValue}
+	r.GetErrors()
+	r = &RepositoryProfileError{}
+	r.GetErrors()
+	r = nil
+	r.GetErrors()
+}
+
 func TestRepositoryRelease_GetAssetsURL(tt *testing.T) {
 	var zeroValue string
 	r := &RepositoryRelease{AssetsURL: &zeroValue}
@@ -16281,6 +18594,16 @@ func TestRepositoryRelease_GetID(tt *testing.T) {
 	r.GetID()
 }
 
+func TestRepositoryRelease_GetMakeLatest(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{MakeLatest: &zeroValue}
+	r.GetMakeLatest()
+	r = &RepositoryRelease{}
+	r.GetMakeLatest()
+	r = nil
+	r.GetMakeLatest()
+}
+
 func TestRepositoryRelease_GetName(tt *testing.T) {
 	var zeroValue string
 	r := &RepositoryRelease{Name: &zeroValue}
@@ -16381,6 +18704,26 @@ func TestRepositoryRelease_GetZipballURL(tt *testing.T) {
 	r.GetZipballURL()
 }
 
+func TestRepositoryRule_GetParameters(tt *testing.T) {
+	var zeroValue json.RawMessage
+	r := &RepositoryRule{Parameters: &zeroValue}
+	r.GetParameters()
+	r = &RepositoryRule{}
+	r.GetParameters()
+	r = nil
+	r.GetParameters()
+}
+
+func TestRepositoryRule_GetType(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRule{Type: &zeroValue}
+	r.GetType()
+	r = &RepositoryRule{}
+	r.GetType()
+	r = nil
+	r.GetType()
+}
+
 func TestRepositoryTag_GetCommit(tt *testing.T) {
 	r := &RepositoryTag{}
 	r.GetCommit()
@@ -16736,6 +19079,16 @@ func TestRequiredReviewer_GetType(tt *testing.T) {
 	r.GetType()
 }
 
+func TestRequiredStatusCheck_GetAppID(tt *testing.T) {
+	var zeroValue int64
+	r := &RequiredStatusCheck{AppID: &zeroValue}
+	r.GetAppID()
+	r = &RequiredStatusCheck{}
+	r.GetAppID()
+	r = nil
+	r.GetAppID()
+}
+
 func TestRequiredStatusChecksRequest_GetStrict(tt *testing.T) {
 	var zeroValue bool
 	r := &RequiredStatusChecksRequest{Strict: &zeroValue}
@@ -16746,6 +19099,16 @@ func TestRequiredStatusChecksRequest_GetStrict(tt *testing.T) {
 	r.GetStrict()
 }
 
+func TestResponse_GetLinks(tt *testing.T) {
+	zeroValue := map[string]string{}
+	r := &Response{Links: zeroValue}
+	r.GetLinks()
+	r = &Response{}
+	r.GetLinks()
+	r = nil
+	r.GetLinks()
+}
+
 func TestReviewersRequest_GetNodeID(tt *testing.T) {
 	var zeroValue string
 	r := &ReviewersRequest{NodeID: &zeroValue}
@@ -16756,6 +19119,16 @@ func TestReviewersRequest_GetNodeID(tt *testing.T) {
 	r.GetNodeID()
 }
 
+func TestReviewPersonalAccessTokenRequestOptions_GetReason(tt *testing.T) {
+	var zeroValue string
+	r := &ReviewPersonalAccessTokenRequestOptions{Reason: &zeroValue}
+	r.GetReason()
+	r = &ReviewPersonalAccessTokenRequestOptions{}
+	r.GetReason()
+	r = nil
+	r.GetReason()
+}
+
 func TestRule_GetDescription(tt *testing.T) {
 	var zeroValue string
 	r := &Rule{Description: &zeroValue}
@@ -16826,224 +19199,1126 @@ func TestRule_GetSeverity(tt *testing.T) {
 	r.GetSeverity()
 }
 
-func TestRunner_GetBusy(tt *testing.T) {
-	var zeroValue bool
-	r := &Runner{Busy: &zeroValue}
-	r.GetBusy()
-	r = &Runner{}
-	r.GetBusy()
+func TestRuleEvaluation_GetDetails(tt *testing.T) {
+	var zeroValue string
+	r := &RuleEvaluation{Details: &zeroValue}
+	r.GetDetails()
+	r = &RuleEvaluation{}
+	r.GetDetails()
 	r = nil
-	r.GetBusy()
+	r.GetDetails()
 }
 
-func TestRunner_GetID(tt *testing.T) {
+func TestRuleEvaluation_GetEnforcement(tt *testing.T) {
+	var zeroValue string
+	r := &RuleEvaluation{Enforcement: &zeroValue}
+	r.GetEnforcement()
+	r = &RuleEvaluation{}
+	r.GetEnforcement()
+	r = nil
+	r.GetEnforcement()
+}
+
+func TestRuleEvaluation_GetResult(tt *testing.T) {
+	var zeroValue string
+	r := &RuleEvaluation{Result: &zeroValue}
+	r.GetResult()
+	r = &RuleEvaluation{}
+	r.GetResult()
+	r = nil
+	r.GetResult()
+}
+
+func TestRuleEvaluation_GetRuleSource(tt *testing.T) {
+	r := &RuleEvaluation{}
+	r.GetRuleSource()
+	r = nil
+	r.GetRuleSource()
+}
+
+func TestRuleEvaluation_GetRuleType(tt *testing.T) {
+	var zeroValue string
+	r := &RuleEvaluation{RuleType: &zeroValue}
+	r.GetRuleType()
+	r = &RuleEvaluation{}
+	r.GetRuleType()
+	r = nil
+	r.GetRuleType()
+}
+
+func TestRuleset_GetConditions(tt *testing.T) {
+	r := &Ruleset{}
+	r.GetConditions()
+	r = nil
+	r.GetConditions()
+}
+
+func TestRuleset_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &Ruleset{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &Ruleset{}
+	r.GetCreatedAt()
+	r = nil
+	r.GetCreatedAt()
+}
+
+func TestRuleset_GetEnforcement(tt *testing.T) {
+	var zeroValue string
+	r := &Ruleset{Enforcement: &zeroValue}
+	r.GetEnforcement()
+	r = &Ruleset{}
+	r.GetEnforcement()
+	r = nil
+	r.GetEnforcement()
+}
+
+func TestRuleset_GetID(tt *testing.T) {
 	var zeroValue int64
-	r := &Runner{ID: &zeroValue}
+	r := &Ruleset{ID: &zeroValue}
 	r.GetID()
-	r = &Runner{}
+	r = &Ruleset{}
 	r.GetID()
 	r = nil
 	r.GetID()
 }
 
-func TestRunner_GetName(tt *testing.T) {
+func TestRuleset_GetLinks(tt *testing.T) {
+	r := &Ruleset{}
+	r.GetLinks()
+	r = nil
+	r.GetLinks()
+}
+
+func TestRuleset_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &Runner{Name: &zeroValue}
+	r := &Ruleset{Name: &zeroValue}
 	r.GetName()
-	r = &Runner{}
+	r = &Ruleset{}
 	r.GetName()
 	r = nil
 	r.GetName()
 }
 
-func TestRunner_GetOS(tt *testing.T) {
+func TestRuleset_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	r := &Runner{OS: &zeroValue}
-	r.GetOS()
-	r = &Runner{}
-	r.GetOS()
+	r := &Ruleset{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &Ruleset{}
+	r.GetNodeID()
 	r = nil
-	r.GetOS()
+	r.GetNodeID()
 }
 
-func TestRunner_GetStatus(tt *testing.T) {
+func TestRuleset_GetSource(tt *testing.T) {
 	var zeroValue string
-	r := &Runner{Status: &zeroValue}
-	r.GetStatus()
-	r = &Runner{}
-	r.GetStatus()
+	r := &Ruleset{Source: &zeroValue}
+	r.GetSource()
+	r = &Ruleset{}
+	r.GetSource()
 	r = nil
-	r.GetStatus()
+	r.GetSource()
 }
 
-func TestRunnerApplicationDownload_GetArchitecture(tt *testing.T) {
+func TestRuleset_GetSourceType(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerApplicationDownload{Architecture: &zeroValue}
-	r.GetArchitecture()
-	r = &RunnerApplicationDownload{}
-	r.GetArchitecture()
+	r := &Ruleset{SourceType: &zeroValue}
+	r.GetSourceType()
+	r = &Ruleset{}
+	r.GetSourceType()
 	r = nil
-	r.GetArchitecture()
+	r.GetSourceType()
 }
 
-func TestRunnerApplicationDownload_GetDownloadURL(tt *testing.T) {
+func TestRuleset_GetTarget(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerApplicationDownload{DownloadURL: &zeroValue}
-	r.GetDownloadURL()
-	r = &RunnerApplicationDownload{}
-	r.GetDownloadURL()
+	r := &Ruleset{Target: &zeroValue}
+	r.GetTarget()
+	r = &Ruleset{}
+	r.GetTarget()
 	r = nil
-	r.GetDownloadURL()
+	r.GetTarget()
 }
 
-func TestRunnerApplicationDownload_GetFilename(tt *testing.T) {
-	var zeroValue string
-	r := &RunnerApplicationDownload{Filename: &zeroValue}
-	r.GetFilename()
-	r = &RunnerApplicationDownload{}
-	r.GetFilename()
+func TestRuleset_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &Ruleset{UpdatedAt: &zeroValue}
+	r.GetUpdatedAt()
+	r = &Ruleset{}
+	r.GetUpdatedAt()
 	r = nil
-	r.GetFilename()
+	r.GetUpdatedAt()
 }
 
-func TestRunnerApplicationDownload_GetOS(tt *testing.T) {
-	var zeroValue string
-	r := &RunnerApplicationDownload{OS: &zeroValue}
-	r.GetOS()
-	r = &RunnerApplicationDownload{}
-	r.GetOS()
+func TestRulesetConditions_GetRefName(tt *testing.T) {
+	r := &RulesetConditions{}
+	r.GetRefName()
 	r = nil
-	r.GetOS()
+	r.GetRefName()
 }
 
-func TestRunnerApplicationDownload_GetSHA256Checksum(tt *testing.T) {
-	var zeroValue string
-	r := &RunnerApplicationDownload{SHA256Checksum: &zeroValue}
-	r.GetSHA256Checksum()
-	r = &RunnerApplicationDownload{}
-	r.GetSHA256Checksum()
+func TestRulesetConditions_GetRepositoryID(tt *testing.T) {
+	r := &RulesetConditions{}
+	r.GetRepositoryID()
 	r = nil
-	r.GetSHA256Checksum()
+	r.GetRepositoryID()
 }
 
-func TestRunnerApplicationDownload_GetTempDownloadToken(tt *testing.T) {
+func TestRulesetConditions_GetRepositoryName(tt *testing.T) {
+	r := &RulesetConditions{}
+	r.GetRepositoryName()
+	r = nil
+	r.GetRepositoryName()
+}
+
+func TestRulesetConditions_GetRepositoryProperty(tt *testing.T) {
+	r := &RulesetConditions{}
+	r.GetRepositoryProperty()
+	r = nil
+	r.GetRepositoryProperty()
+}
+
+func TestRulesetLink_GetHRef(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerApplicationDownload{TempDownloadToken: &zeroValue}
-	r.GetTempDownloadToken()
-	r = &RunnerApplicationDownload{}
-	r.GetTempDownloadToken()
+	r := &RulesetLink{HRef: &zeroValue}
+	r.GetHRef()
+	r = &RulesetLink{}
+	r.GetHRef()
 	r = nil
-	r.GetTempDownloadToken()
+	r.GetHRef()
 }
 
-func TestRunnerGroup_GetAllowsPublicRepositories(tt *testing.T) {
+func TestRulesetLinks_GetHTML(tt *testing.T) {
+	r := &RulesetLinks{}
+	r.GetHTML()
+	r = nil
+	r.GetHTML()
+}
+
+func TestRulesetLinks_GetSelf(tt *testing.T) {
+	r := &RulesetLinks{}
+	r.GetSelf()
+	r = nil
+	r.GetSelf()
+}
+
+func TestRulesetRepositoryNamesConditionParameters_GetProtected(tt *testing.T) {
 	var zeroValue bool
-	r := &RunnerGroup{AllowsPublicRepositories: &zeroValue}
-	r.GetAllowsPublicRepositories()
-	r = &RunnerGroup{}
-	r.GetAllowsPublicRepositories()
+	r := &RulesetRepositoryNamesConditionParameters{Protected: &zeroValue}
+	r.GetProtected()
+	r = &RulesetRepositoryNamesConditionParameters{}
+	r.GetProtected()
 	r = nil
-	r.GetAllowsPublicRepositories()
+	r.GetProtected()
+}
+
+func TestRulesetRepositoryPropertyTargetParameters_GetSource(tt *testing.T) {
+	var zeroValue string
+	r := &RulesetRepositoryPropertyTargetParameters{Source: &zeroValue}
+	r.GetSource()
+	r = &RulesetRepositoryPropertyTargetParameters{}
+	r.GetSource()
+	r = nil
+	r.GetSource()
+}
+
+func TestRulesetVersion_GetActor(tt *testing.T) {
+	r := &RulesetVersion{}
+	r.GetActor()
+	r = nil
+	r.GetActor()
+}
+
+func TestRulesetVersion_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RulesetVersion{UpdatedAt: &zeroValue}
+	r.GetUpdatedAt()
+	r = &RulesetVersion{}
+	r.GetUpdatedAt()
+	r = nil
+	r.GetUpdatedAt()
+}
+
+func TestRulesetVersion_GetVersionID(tt *testing.T) {
+	var zeroValue int64
+	r := &RulesetVersion{VersionID: &zeroValue}
+	r.GetVersionID()
+	r = &RulesetVersion{}
+	r.GetVersionID()
+	r = nil
+	r.GetVersionID()
+}
+
+func TestRulesetVersionActor_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RulesetVersionActor{ID: &zeroValue}
+	r.GetID()
+	r = &RulesetVersionActor{}
+	r.GetID()
+	r = nil
+	r.GetID()
+}
+
+func TestRulesetVersionActor_GetType(tt *testing.T) {
+	var zeroValue string
+	r := &RulesetVersionActor{Type: &zeroValue}
+	r.GetType()
+	r = &RulesetVersionActor{}
+	r.GetType()
+	r = nil
+	r.GetType()
+}
+
+func TestRulesetVersionWithState_GetActor(tt *testing.T) {
+	r := &RulesetVersionWithState{}
+	r.GetActor()
+	r = nil
+	r.GetActor()
+}
+
+func TestRulesetVersionWithState_GetState(tt *testing.T) {
+	r := &RulesetVersionWithState{}
+	r.GetState()
+	r = nil
+	r.GetState()
+}
+
+func TestRulesetVersionWithState_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RulesetVersionWithState{UpdatedAt: &zeroValue}
+	r.GetUpdatedAt()
+	r = &RulesetVersionWithState{}
+	r.GetUpdatedAt()
+	r = nil
+	r.GetUpdatedAt()
+}
+
+func TestRulesetVersionWithState_GetVersionID(tt *testing.T) {
+	var zeroValue int64
+	r := &RulesetVersionWithState{VersionID: &zeroValue}
+	r.GetVersionID()
+	r = &RulesetVersionWithState{}
+	r.GetVersionID()
+	r = nil
+	r.GetVersionID()
+}
+
+func TestRuleSource_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RuleSource{ID: &zeroValue}
+	r.GetID()
+	r = &RuleSource{}
+	r.GetID()
+	r = nil
+	r.GetID()
+}
+
+func TestRuleSource_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &RuleSource{Name: &zeroValue}
+	r.GetName()
+	r = &RuleSource{}
+	r.GetName()
+	r = nil
+	r.GetName()
+}
+
+func TestRuleSource_GetType(tt *testing.T) {
+	var zeroValue string
+	r := &RuleSource{Type: &zeroValue}
+	r.GetType()
+	r = &RuleSource{}
+	r.GetType()
+	r = nil
+	r.GetType()
+}
+
+func TestRuleSuite_GetActorID(tt *testing.T) {
+	var zeroValue int64
+	r := &RuleSuite{ActorID: &zeroValue}
+	r.GetActorID()
+	r = &RuleSuite{}
+	r.GetActorID()
+	r = nil
+	r.GetActorID()
+}
+
+func TestRuleSuite_GetActorName(tt *testing.T) {
+	var zeroValue string
+	r := &RuleSuite{ActorName: &zeroValue}
+	r.GetActorName()
+	r = &RuleSuite{}
+	r.GetActorName()
+	r = nil
+	r.GetActorName()
+}
+
+func TestRuleSuite_GetAfterSHA(tt *testing.T) {
+	var zeroValue string
+	r := &RuleSuite{AfterSHA: &zeroValue}
+	r.GetAfterSHA()
+	r = &RuleSuite{}
+	r.GetAfterSHA()
+	r = nil
+	r.GetAfterSHA()
+}
+
+func TestRuleSuite_GetBeforeSHA(tt *testing.T) {
+	var zeroValue string
+	r := &RuleSuite{BeforeSHA: &zeroValue}
+	r.GetBeforeSHA()
+	r = &RuleSuite{}
+	r.GetBeforeSHA()
+	r = nil
+	r.GetBeforeSHA()
+}
+
+func TestRuleSuite_GetEvaluationResult(tt *testing.T) {
+	var zeroValue string
+	r := &RuleSuite{EvaluationResult: &zeroValue}
+	r.GetEvaluationResult()
+	r = &RuleSuite{}
+	r.GetEvaluationResult()
+	r = nil
+	r.GetEvaluationResult()
+}
+
+func TestRuleSuite_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RuleSuite{ID: &zeroValue}
+	r.GetID()
+	r = &RuleSuite{}
+	r.GetID()
+	r = nil
+	r.GetID()
+}
+
+func TestRuleSuite_GetPushedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RuleSuite{PushedAt: &zeroValue}
+	r.GetPushedAt()
+	r = &RuleSuite{}
+	r.GetPushedAt()
+	r = nil
+	r.GetPushedAt()
+}
+
+func TestRuleSuite_GetRef(tt *testing.T) {
+	var zeroValue string
+	r := &RuleSuite{Ref: &zeroValue}
+	r.GetRef()
+	r = &RuleSuite{}
+	r.GetRef()
+	r = nil
+	r.GetRef()
+}
+
+func TestRuleSuite_GetRepositoryID(tt *testing.T) {
+	var zeroValue int64
+	r := &RuleSuite{RepositoryID: &zeroValue}
+	r.GetRepositoryID()
+	r = &RuleSuite{}
+	r.GetRepositoryID()
+	r = nil
+	r.GetRepositoryID()
+}
+
+func TestRuleSuite_GetRepositoryName(tt *testing.T) {
+	var zeroValue string
+	r := &RuleSuite{RepositoryName: &zeroValue}
+	r.GetRepositoryName()
+	r = &RuleSuite{}
+	r.GetRepositoryName()
+	r = nil
+	r.GetRepositoryName()
+}
+
+func TestRuleSuite_GetResult(tt *testing.T) {
+	var zeroValue string
+	r := &RuleSuite{Result: &zeroValue}
+	r.GetResult()
+	r = &RuleSuite{}
+	r.GetResult()
+	r = nil
+	r.GetResult()
+}
+
+func TestRunner_GetBusy(tt *testing.T) {
+	var zeroValue bool
+	r := &Runner{Busy: &zeroValue}
+	r.GetBusy()
+	r = &Runner{}
+	r.GetBusy()
+	r = nil
+	r.GetBusy()
+}
+
+func TestRunner_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &Runner{ID: &zeroValue}
+	r.GetID()
+	r = &Runner{}
+	r.GetID()
+	r = nil
+	r.GetID()
+}
+
+func TestRunner_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &Runner{Name: &zeroValue}
+	r.GetName()
+	r = &Runner{}
+	r.GetName()
+	r = nil
+	r.GetName()
+}
+
+func TestRunner_GetOS(tt *testing.T) {
+	var zeroValue string
+	r := &Runner{OS: &zeroValue}
+	r.GetOS()
+	r = &Runner{}
+	r.GetOS()
+	r = nil
+	r.GetOS()
+}
+
+func TestRunner_GetStatus(tt *testing.T) {
+	var zeroValue string
+	r := &Runner{Status: &zeroValue}
+	r.GetStatus()
+	r = &Runner{}
+	r.GetStatus()
+	r = nil
+	r.GetStatus()
+}
+
+func TestRunnerApplicationDownload_GetArchitecture(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerApplicationDownload{Architecture: &zeroValue}
+	r.GetArchitecture()
+	r = &RunnerApplicationDownload{}
+	r.GetArchitecture()
+	r = nil
+	r.GetArchitecture()
+}
+
+func TestRunnerApplicationDownload_GetDownloadURL(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerApplicationDownload{DownloadURL: &zeroValue}
+	r.GetDownloadURL()
+	r = &RunnerApplicationDownload{}
+	r.GetDownloadURL()
+	r = nil
+	r.GetDownloadURL()
+}
+
+func TestRunnerApplicationDownload_GetFilename(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerApplicationDownload{Filename: &zeroValue}
+	r.GetFilename()
+	r = &RunnerApplicationDownload{}
+	r.GetFilename()
+	r = nil
+	r.GetFilename()
+}
+
+func TestRunnerApplicationDownload_GetOS(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerApplicationDownload{OS: &zeroValue}
+	r.GetOS()
+	r = &RunnerApplicationDownload{}
+	r.GetOS()
+	r = nil
+	r.GetOS()
+}
+
+func TestRunnerApplicationDownload_GetSHA256Checksum(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerApplicationDownload{SHA256Checksum: &zeroValue}
+	r.GetSHA256Checksum()
+	r = &RunnerApplicationDownload{}
+	r.GetSHA256Checksum()
+	r = nil
+	r.GetSHA256Checksum()
+}
+
+func TestRunnerApplicationDownload_GetTempDownloadToken(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerApplicationDownload{TempDownloadToken: &zeroValue}
+	r.GetTempDownloadToken()
+	r = &RunnerApplicationDownload{}
+	r.GetTempDownloadToken()
+	r = nil
+	r.GetTempDownloadToken()
+}
+
+func TestRunnerGroup_GetAllowsPublicRepositories(tt *testing.T) {
+	var zeroValue bool
+	r := &RunnerGroup{AllowsPublicRepositories: &zeroValue}
+	r.GetAllowsPublicRepositories()
+	r = &RunnerGroup{}
+	r.GetAllowsPublicRepositories()
+	r = nil
+	r.GetAllowsPublicRepositories()
+}
+
+func TestRunnerGroup_GetDefault(tt *testing.T) {
+	var zeroValue bool
+	r := &RunnerGroup{Default: &zeroValue}
+	r.GetDefault()
+	r = &RunnerGroup{}
+	r.GetDefault()
+	r = nil
+	r.GetDefault()
+}
+
+func TestRunnerGroup_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RunnerGroup{ID: &zeroValue}
+	r.GetID()
+	r = &RunnerGroup{}
+	r.GetID()
+	r = nil
+	r.GetID()
+}
+
+func TestRunnerGroup_GetInherited(tt *testing.T) {
+	var zeroValue bool
+	r := &RunnerGroup{Inherited: &zeroValue}
+	r.GetInherited()
+	r = &RunnerGroup{}
+	r.GetInherited()
+	r = nil
+	r.GetInherited()
+}
+
+func TestRunnerGroup_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerGroup{Name: &zeroValue}
+	r.GetName()
+	r = &RunnerGroup{}
+	r.GetName()
+	r = nil
+	r.GetName()
+}
+
+func TestRunnerGroup_GetRunnersURL(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerGroup{RunnersURL: &zeroValue}
+	r.GetRunnersURL()
+	r = &RunnerGroup{}
+	r.GetRunnersURL()
+	r = nil
+	r.GetRunnersURL()
+}
+
+func TestRunnerGroup_GetSelectedRepositoriesURL(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerGroup{SelectedRepositoriesURL: &zeroValue}
+	r.GetSelectedRepositoriesURL()
+	r = &RunnerGroup{}
+	r.GetSelectedRepositoriesURL()
+	r = nil
+	r.GetSelectedRepositoriesURL()
+}
+
+func TestRunnerGroup_GetVisibility(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerGroup{Visibility: &zeroValue}
+	r.GetVisibility()
+	r = &RunnerGroup{}
+	r.GetVisibility()
+	r = nil
+	r.GetVisibility()
+}
+
+func TestRunnerLabels_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RunnerLabels{ID: &zeroValue}
+	r.GetID()
+	r = &RunnerLabels{}
+	r.GetID()
+	r = nil
+	r.GetID()
+}
+
+func TestRunnerLabels_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerLabels{Name: &zeroValue}
+	r.GetName()
+	r = &RunnerLabels{}
+	r.GetName()
+	r = nil
+	r.GetName()
+}
+
+func TestRunnerLabels_GetType(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerLabels{Type: &zeroValue}
+	r.GetType()
+	r = &RunnerLabels{}
+	r.GetType()
+	r = nil
+	r.GetType()
+}
+
+func TestSarifAnalysis_GetCheckoutURI(tt *testing.T) {
+	var zeroValue string
+	s := &SarifAnalysis{CheckoutURI: &zeroValue}
+	s.GetCheckoutURI()
+	s = &SarifAnalysis{}
+	s.GetCheckoutURI()
+	s = nil
+	s.GetCheckoutURI()
+}
+
+func TestSarifAnalysis_GetCommitSHA(tt *testing.T) {
+	var zeroValue string
+	s := &SarifAnalysis{CommitSHA: &zeroValue}
+	s.GetCommitSHA()
+	s = &SarifAnalysis{}
+	s.GetCommitSHA()
+	s = nil
+	s.GetCommitSHA()
+}
+
+func TestSarifAnalysis_GetRef(tt *testing.T) {
+	var zeroValue string
+	s := &SarifAnalysis{Ref: &zeroValue}
+	s.GetRef()
+	s = &SarifAnalysis{}
+	s.GetRef()
+	s = nil
+	s.GetRef()
+}
+
+func TestSarifAnalysis_GetSarif(tt *testing.T) {
+	var zeroValue string
+	s := &SarifAnalysis{Sarif: &zeroValue}
+	s.GetSarif()
+	s = &SarifAnalysis{}
+	s.GetSarif()
+	s = nil
+	s.GetSarif()
+}
+
+func TestSarifAnalysis_GetStartedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SarifAnalysis{StartedAt: &zeroValue}
+	s.GetStartedAt()
+	s = &SarifAnalysis{}
+	s.GetStartedAt()
+	s = nil
+	s.GetStartedAt()
+}
+
+func TestSarifAnalysis_GetToolName(tt *testing.T) {
+	var zeroValue string
+	s := &SarifAnalysis{ToolName: &zeroValue}
+	s.GetToolName()
+	s = &SarifAnalysis{}
+	s.GetToolName()
+	s = nil
+	s.GetToolName()
+}
+
+func TestSarifID_GetID(tt *testing.T) {
+	var zeroValue string
+	s := &SarifID{ID: &zeroValue}
+	s.GetID()
+	s = &SarifID{}
+	s.GetID()
+	s = nil
+	s.GetID()
+}
+
+func TestSarifID_GetURL(tt *testing.T) {
+	var zeroValue string
+	s := &SarifID{URL: &zeroValue}
+	s.GetURL()
+	s = &SarifID{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
+}
+
+func TestSarifUpload_GetAnalysesURL(tt *testing.T) {
+	var zeroValue string
+	s := &SarifUpload{AnalysesURL: &zeroValue}
+	s.GetAnalysesURL()
+	s = &SarifUpload{}
+	s.GetAnalysesURL()
+	s = nil
+	s.GetAnalysesURL()
+}
+
+func TestSarifUpload_GetProcessingStatus(tt *testing.T) {
+	var zeroValue string
+	s := &SarifUpload{ProcessingStatus: &zeroValue}
+	s.GetProcessingStatus()
+	s = &SarifUpload{}
+	s.GetProcessingStatus()
+	s = nil
+	s.GetProcessingStatus()
+}
+
+func TestSecretScanningAlert_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecretScanningAlert{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SecretScanningAlert{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
+}
+
+func TestSecretScanningAlert_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlert{HTMLURL: &zeroValue}
+	s.GetHTMLURL()
+	s = &SecretScanningAlert{}
+	s.GetHTMLURL()
+	s = nil
+	s.GetHTMLURL()
+}
+
+func TestSecretScanningAlert_GetNumber(tt *testing.T) {
+	var zeroValue int
+	s := &SecretScanningAlert{Number: &zeroValue}
+	s.GetNumber()
+	s = &SecretScanningAlert{}
+	s.GetNumber()
+	s = nil
+	s.GetNumber()
+}
+
+func TestSecretScanningAlert_GetResolution(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlert{Resolution: &zeroValue}
+	s.GetResolution()
+	s = &SecretScanningAlert{}
+	s.GetResolution()
+	s = nil
+	s.GetResolution()
+}
+
+func TestSecretScanningAlert_GetResolutionComment(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlert{ResolutionComment: &zeroValue}
+	s.GetResolutionComment()
+	s = &SecretScanningAlert{}
+	s.GetResolutionComment()
+	s = nil
+	s.GetResolutionComment()
+}
+
+func TestSecretScanningAlert_GetResolvedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecretScanningAlert{ResolvedAt: &zeroValue}
+	s.GetResolvedAt()
+	s = &SecretScanningAlert{}
+	s.GetResolvedAt()
+	s = nil
+	s.GetResolvedAt()
+}
+
+func TestSecretScanningAlert_GetResolvedBy(tt *testing.T) {
+	s := &SecretScanningAlert{}
+	s.GetResolvedBy()
+	s = nil
+	s.GetResolvedBy()
+}
+
+func TestSecretScanningAlert_GetSecret(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlert{Secret: &zeroValue}
+	s.GetSecret()
+	s = &SecretScanningAlert{}
+	s.GetSecret()
+	s = nil
+	s.GetSecret()
+}
+
+func TestSecretScanningAlert_GetSecretType(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlert{SecretType: &zeroValue}
+	s.GetSecretType()
+	s = &SecretScanningAlert{}
+	s.GetSecretType()
+	s = nil
+	s.GetSecretType()
+}
+
+func TestSecretScanningAlert_GetSecretTypeDisplayName(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlert{SecretTypeDisplayName: &zeroValue}
+	s.GetSecretTypeDisplayName()
+	s = &SecretScanningAlert{}
+	s.GetSecretTypeDisplayName()
+	s = nil
+	s.GetSecretTypeDisplayName()
+}
+
+func TestSecretScanningAlert_GetState(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlert{State: &zeroValue}
+	s.GetState()
+	s = &SecretScanningAlert{}
+	s.GetState()
+	s = nil
+	s.GetState()
+}
+
+func TestSecretScanningAlert_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecretScanningAlert{UpdatedAt: &zeroValue}
+	s.GetUpdatedAt()
+	s = &SecretScanningAlert{}
+	s.GetUpdatedAt()
+	s = nil
+	s.GetUpdatedAt()
+}
+
+func TestSecretScanningAlert_GetURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlert{URL: &zeroValue}
+	s.GetURL()
+	s = &SecretScanningAlert{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
+}
+
+func TestSecretScanningAlertUpdateOptions_GetResolution(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertUpdateOptions{Resolution: &zeroValue}
+	s.GetResolution()
+	s = &SecretScanningAlertUpdateOptions{}
+	s.GetResolution()
+	s = nil
+	s.GetResolution()
+}
+
+func TestSecretScanningAlertUpdateOptions_GetResolutionComment(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertUpdateOptions{ResolutionComment: &zeroValue}
+	s.GetResolutionComment()
+	s = &SecretScanningAlertUpdateOptions{}
+	s.GetResolutionComment()
+	s = nil
+	s.GetResolutionComment()
+}
+
+func TestSecurityAdvisory_GetAuthor(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetAuthor()
+	s = nil
+	s.GetAuthor()
+}
+
+func TestSecurityAdvisory_GetClosedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{ClosedAt: &zeroValue}
+	s.GetClosedAt()
+	s = &SecurityAdvisory{}
+	s.GetClosedAt()
+	s = nil
+	s.GetClosedAt()
+}
+
+func TestSecurityAdvisory_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SecurityAdvisory{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
+}
+
+func TestSecurityAdvisory_GetCVEID(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisory{CVEID: &zeroValue}
+	s.GetCVEID()
+	s = &SecurityAdvisory{}
+	s.GetCVEID()
+	s = nil
+	s.GetCVEID()
+}
+
+func TestSecurityAdvisory_GetCVSS(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetCVSS()
+	s = nil
+	s.GetCVSS()
+}
+
+func TestSecurityAdvisory_GetDescription(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisory{Description: &zeroValue}
+	s.GetDescription()
+	s = &SecurityAdvisory{}
+	s.GetDescription()
+	s = nil
+	s.GetDescription()
+}
+
+func TestSecurityAdvisory_GetGHSAID(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisory{GHSAID: &zeroValue}
+	s.GetGHSAID()
+	s = &SecurityAdvisory{}
+	s.GetGHSAID()
+	s = nil
+	s.GetGHSAID()
+}
+
+func TestSecurityAdvisory_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisory{HTMLURL: &zeroValue}
+	s.GetHTMLURL()
+	s = &SecurityAdvisory{}
+	s.GetHTMLURL()
+	s = nil
+	s.GetHTMLURL()
+}
+
+func TestSecurityAdvisory_GetPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{PublishedAt: &zeroValue}
+	s.GetPublishedAt()
+	s = &SecurityAdvisory{}
+	s.GetPublishedAt()
+	s = nil
+	s.GetPublishedAt()
+}
+
+func TestSecurityAdvisory_GetPublisher(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetPublisher()
+	s = nil
+	s.GetPublisher()
+}
+
+func TestSecurityAdvisory_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisory{Severity: &zeroValue}
+	s.GetSeverity()
+	s = &SecurityAdvisory{}
+	s.GetSeverity()
+	s = nil
+	s.GetSeverity()
+}
+
+func TestSecurityAdvisory_GetState(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisory{State: &zeroValue}
+	s.GetState()
+	s = &SecurityAdvisory{}
+	s.GetState()
+	s = nil
+	s.GetState()
 }
 
-func TestRunnerGroup_GetDefault(tt *testing.T) {
-	var zeroValue bool
-	r := &RunnerGroup{Default: &zeroValue}
-	r.GetDefault()
-	r = &RunnerGroup{}
-	r.GetDefault()
-	r = nil
-	r.GetDefault()
+func TestSecurityAdvisory_GetSummary(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisory{Summary: &zeroValue}
+	s.GetSummary()
+	s = &SecurityAdvisory{}
+	s.GetSummary()
+	s = nil
+	s.GetSummary()
 }
 
-func TestRunnerGroup_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RunnerGroup{ID: &zeroValue}
-	r.GetID()
-	r = &RunnerGroup{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSecurityAdvisory_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{UpdatedAt: &zeroValue}
+	s.GetUpdatedAt()
+	s = &SecurityAdvisory{}
+	s.GetUpdatedAt()
+	s = nil
+	s.GetUpdatedAt()
 }
 
-func TestRunnerGroup_GetInherited(tt *testing.T) {
-	var zeroValue bool
-	r := &RunnerGroup{Inherited: &zeroValue}
-	r.GetInherited()
-	r = &RunnerGroup{}
-	r.GetInherited()
-	r = nil
-	r.GetInherited()
+func TestSecurityAdvisory_GetURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisory{URL: &zeroValue}
+	s.GetURL()
+	s = &SecurityAdvisory{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
 }
 
-func TestRunnerGroup_GetName(tt *testing.T) {
-	var zeroValue string
-	r := &RunnerGroup{Name: &zeroValue}
-	r.GetName()
-	r = &RunnerGroup{}
-	r.GetName()
-	r = nil
-	r.GetName()
+func TestSecurityAdvisory_GetWithdrawnAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{WithdrawnAt: &zeroValue}
+	s.GetWithdrawnAt()
+	s = &SecurityAdvisory{}
+	s.GetWithdrawnAt()
+	s = nil
+	s.GetWithdrawnAt()
 }
 
-func TestRunnerGroup_GetRunnersURL(tt *testing.T) {
+func TestSecurityAdvisoryRequest_GetCVEID(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerGroup{RunnersURL: &zeroValue}
-	r.GetRunnersURL()
-	r = &RunnerGroup{}
-	r.GetRunnersURL()
-	r = nil
-	r.GetRunnersURL()
+	s := &SecurityAdvisoryRequest{CVEID: &zeroValue}
+	s.GetCVEID()
+	s = &SecurityAdvisoryRequest{}
+	s.GetCVEID()
+	s = nil
+	s.GetCVEID()
 }
 
-func TestRunnerGroup_GetSelectedRepositoriesURL(tt *testing.T) {
+func TestSecurityAdvisoryRequest_GetCVSSVectorString(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerGroup{SelectedRepositoriesURL: &zeroValue}
-	r.GetSelectedRepositoriesURL()
-	r = &RunnerGroup{}
-	r.GetSelectedRepositoriesURL()
-	r = nil
-	r.GetSelectedRepositoriesURL()
+	s := &SecurityAdvisoryRequest{CVSSVectorString: &zeroValue}
+	s.GetCVSSVectorString()
+	s = &SecurityAdvisoryRequest{}
+	s.GetCVSSVectorString()
+	s = nil
+	s.GetCVSSVectorString()
 }
 
-func TestRunnerGroup_GetVisibility(tt *testing.T) {
+func TestSecurityAdvisoryRequest_GetDescription(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerGroup{Visibility: &zeroValue}
-	r.GetVisibility()
-	r = &RunnerGroup{}
-	r.GetVisibility()
-	r = nil
-	r.GetVisibility()
+	s := &SecurityAdvisoryRequest{Description: &zeroValue}
+	s.GetDescription()
+	s = &SecurityAdvisoryRequest{}
+	s.GetDescription()
+	s = nil
+	s.GetDescription()
 }
 
-func TestRunnerLabels_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RunnerLabels{ID: &zeroValue}
-	r.GetID()
-	r = &RunnerLabels{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSecurityAdvisoryRequest_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisoryRequest{Severity: &zeroValue}
+	s.GetSeverity()
+	s = &SecurityAdvisoryRequest{}
+	s.GetSeverity()
+	s = nil
+	s.GetSeverity()
 }
 
-func TestRunnerLabels_GetName(tt *testing.T) {
+func TestSecurityAdvisoryRequest_GetState(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerLabels{Name: &zeroValue}
-	r.GetName()
-	r = &RunnerLabels{}
-	r.GetName()
-	r = nil
-	r.GetName()
+	s := &SecurityAdvisoryRequest{State: &zeroValue}
+	s.GetState()
+	s = &SecurityAdvisoryRequest{}
+	s.GetState()
+	s = nil
+	s.GetState()
 }
 
-func TestRunnerLabels_GetType(tt *testing.T) {
+func TestSecurityAdvisoryRequest_GetSummary(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerLabels{Type: &zeroValue}
-	r.GetType()
-	r = &RunnerLabels{}
-	r.GetType()
-	r = nil
-	r.GetType()
+	s := &SecurityAdvisoryRequest{Summary: &zeroValue}
+	s.GetSummary()
+	s = &SecurityAdvisoryRequest{}
+	s.GetSummary()
+	s = nil
+	s.GetSummary()
 }
 
 func TestSelectedReposList_GetTotalCount(tt *testing.T) {
@@ -17126,6 +20401,26 @@ func TestSignatureVerification_GetVerified(tt *testing.T) {
 	s.GetVerified()
 }
 
+func TestSocialAccount_GetProvider(tt *testing.T) {
+	var zeroValue string
+	s := &SocialAccount{Provider: &zeroValue}
+	s.GetProvider()
+	s = &SocialAccount{}
+	s.GetProvider()
+	s = nil
+	s.GetProvider()
+}
+
+func TestSocialAccount_GetURL(tt *testing.T) {
+	var zeroValue string
+	s := &SocialAccount{URL: &zeroValue}
+	s.GetURL()
+	s = &SocialAccount{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
+}
+
 func TestSource_GetActor(tt *testing.T) {
 	s := &Source{}
 	s.GetActor()
@@ -17240,6 +20535,46 @@ func TestSourceImportAuthor_GetURL(tt *testing.T) {
 	s.GetURL()
 }
 
+func TestSSHSigningKey_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SSHSigningKey{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SSHSigningKey{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
+}
+
+func TestSSHSigningKey_GetID(tt *testing.T) {
+	var zeroValue int64
+	s := &SSHSigningKey{ID: &zeroValue}
+	s.GetID()
+	s = &SSHSigningKey{}
+	s.GetID()
+	s = nil
+	s.GetID()
+}
+
+func TestSSHSigningKey_GetKey(tt *testing.T) {
+	var zeroValue string
+	s := &SSHSigningKey{Key: &zeroValue}
+	s.GetKey()
+	s = &SSHSigningKey{}
+	s.GetKey()
+	s = nil
+	s.GetKey()
+}
+
+func TestSSHSigningKey_GetTitle(tt *testing.T) {
+	var zeroValue string
+	s := &SSHSigningKey{Title: &zeroValue}
+	s.GetTitle()
+	s = &SSHSigningKey{}
+	s.GetTitle()
+	s = nil
+	s.GetTitle()
+}
+
 func TestStarEvent_GetAction(tt *testing.T) {
 	var zeroValue string
 	s := &StarEvent{Action: &zeroValue}
@@ -18934,6 +22269,141 @@ func TestUpdateRunnerGroupRequest_GetVisibility(tt *testing.T) {
 	u.GetVisibility()
 }
 
+func TestUsageItem_GetDate(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{Date: &zeroValue}
+	u.GetDate()
+	u = &UsageItem{}
+	u.GetDate()
+	u = nil
+	u.GetDate()
+}
+
+func TestUsageItem_GetDiscountAmount(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetDiscountAmount()
+	u = nil
+	u.GetDiscountAmount()
+}
+
+func TestUsageItem_GetGrossAmount(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetGrossAmount()
+	u = nil
+	u.GetGrossAmount()
+}
+
+func TestUsageItem_GetNetAmount(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetNetAmount()
+	u = nil
+	u.GetNetAmount()
+}
+
+func TestUsageItem_GetOrganizationName(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{OrganizationName: &zeroValue}
+	u.GetOrganizationName()
+	u = &UsageItem{}
+	u.GetOrganizationName()
+	u = nil
+	u.GetOrganizationName()
+}
+
+func TestUsageItem_GetPricePerUnit(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetPricePerUnit()
+	u = nil
+	u.GetPricePerUnit()
+}
+
+func TestUsageItem_GetProduct(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{Product: &zeroValue}
+	u.GetProduct()
+	u = &UsageItem{}
+	u.GetProduct()
+	u = nil
+	u.GetProduct()
+}
+
+func TestUsageItem_GetQuantity(tt *testing.T) {
+	u := &UsageItem{}
+	u.GetQuantity()
+	u = nil
+	u.GetQuantity()
+}
+
+func TestUsageItem_GetRepositoryName(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{RepositoryName: &zeroValue}
+	u.GetRepositoryName()
+	u = &UsageItem{}
+	u.GetRepositoryName()
+	u = nil
+	u.GetRepositoryName()
+}
+
+func TestUsageItem_GetSKU(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{SKU: &zeroValue}
+	u.GetSKU()
+	u = &UsageItem{}
+	u.GetSKU()
+	u = nil
+	u.GetSKU()
+}
+
+func TestUsageItem_GetUnitType(tt *testing.T) {
+	var zeroValue string
+	u := &UsageItem{UnitType: &zeroValue}
+	u.GetUnitType()
+	u = &UsageItem{}
+	u.GetUnitType()
+	u = nil
+	u.GetUnitType()
+}
+
+func TestUsageReportOptions_GetDay(tt *testing.T) {
+	var zeroValue int
+	u := &UsageReportOptions{Day: &zeroValue}
+	u.GetDay()
+	u = &UsageReportOptions{}
+	u.GetDay()
+	u = nil
+	u.GetDay()
+}
+
+func TestUsageReportOptions_GetHour(tt *testing.T) {
+	var zeroValue int
+	u := &UsageReportOptions{Hour: &zeroValue}
+	u.GetHour()
+	u = &UsageReportOptions{}
+	u.GetHour()
+	u = nil
+	u.GetHour()
+}
+
+func TestUsageReportOptions_GetMonth(tt *testing.T) {
+	var zeroValue int
+	u := &UsageReportOptions{Month: &zeroValue}
+	u.GetMonth()
+	u = &UsageReportOptions{}
+	u.GetMonth()
+	u = nil
+	u.GetMonth()
+}
+
+func TestUsageReportOptions_GetYear(tt *testing.T) {
+	var zeroValue int
+	u := &UsageReportOptions{Year: &zeroValue}
+	u.GetYear()
+	u = &UsageReportOptions{}
+	u.GetYear()
+	u = nil
+	u.GetYear()
+}
+
 func TestUser_GetAvatarURL(tt *testing.T) {
 	var zeroValue string
 	u := &User{AvatarURL: &zeroValue}
@@ -19251,6 +22721,16 @@ func TestUser_GetReposURL(tt *testing.T) {
 	u.GetReposURL()
 }
 
+func TestUser_GetRoleName(tt *testing.T) {
+	var zeroValue string
+	u := &User{RoleName: &zeroValue}
+	u.GetRoleName()
+	u = &User{}
+	u.GetRoleName()
+	u = nil
+	u.GetRoleName()
+}
+
 func TestUser_GetSiteAdmin(tt *testing.T) {
 	var zeroValue bool
 	u := &User{SiteAdmin: &zeroValue}
@@ -19859,6 +23339,26 @@ func TestUserSuspendOptions_GetReason(tt *testing.T) {
 	u.GetReason()
 }
 
+func TestVulnerabilityPackage_GetEcosystem(tt *testing.T) {
+	var zeroValue string
+	v := &VulnerabilityPackage{Ecosystem: &zeroValue}
+	v.GetEcosystem()
+	v = &VulnerabilityPackage{}
+	v.GetEcosystem()
+	v = nil
+	v.GetEcosystem()
+}
+
+func TestVulnerabilityPackage_GetName(tt *testing.T) {
+	var zeroValue string
+	v := &VulnerabilityPackage{Name: &zeroValue}
+	v.GetName()
+	v = &VulnerabilityPackage{}
+	v.GetName()
+	v = nil
+	v.GetName()
+}
+
 func TestWatchEvent_GetAction(tt *testing.T) {
 	var zeroValue string
 	w := &WatchEvent{Action: &zeroValue}
@@ -20408,6 +23908,16 @@ func TestWorkflowJob_GetNodeID(tt *testing.T) {
 	w.GetNodeID()
 }
 
+func TestWorkflowJob_GetRunAttempt(tt *testing.T) {
+	var zeroValue int64
+	w := &WorkflowJob{RunAttempt: &zeroValue}
+	w.GetRunAttempt()
+	w = &WorkflowJob{}
+	w.GetRunAttempt()
+	w = nil
+	w.GetRunAttempt()
+}
+
 func TestWorkflowJob_GetRunID(tt *testing.T) {
 	var zeroValue int64
 	w := &WorkflowJob{RunID: &zeroValue}
@@ -20418,6 +23928,46 @@ func TestWorkflowJob_GetRunID(tt *testing.T) {
 	w.GetRunID()
 }
 
+func TestWorkflowJob_GetRunnerGroupID(tt *testing.T) {
+	var zeroValue int64
+	w := &WorkflowJob{RunnerGroupID: &zeroValue}
+	w.GetRunnerGroupID()
+	w = &WorkflowJob{}
+	w.GetRunnerGroupID()
+	w = nil
+	w.GetRunnerGroupID()
+}
+
+func TestWorkflowJob_GetRunnerGroupName(tt *testing.T) {
+	var zeroValue string
+	w := &WorkflowJob{RunnerGroupName: &zeroValue}
+	w.GetRunnerGroupName()
+	w = &WorkflowJob{}
+	w.GetRunnerGroupName()
+	w = nil
+	w.GetRunnerGroupName()
+}
+
+func TestWorkflowJob_GetRunnerID(tt *testing.T) {
+	var zeroValue int64
+	w := &WorkflowJob{RunnerID: &zeroValue}
+	w.GetRunnerID()
+	w = &WorkflowJob{}
+	w.GetRunnerID()
+	w = nil
+	w.GetRunnerID()
+}
+
+func TestWorkflowJob_GetRunnerName(tt *testing.T) {
+	var zeroValue string
+	w := &WorkflowJob{RunnerName: &zeroValue}
+	w.GetRunnerName()
+	w = &WorkflowJob{}
+	w.GetRunnerName()
+	w = nil
+	w.GetRunnerName()
+}
+
 func TestWorkflowJob_GetRunURL(tt *testing.T) {
 	var zeroValue string
 	w := &WorkflowJob{RunURL: &zeroValue}
@@ -20458,6 +24008,51 @@ func TestWorkflowJob_GetURL(tt *testing.T) {
 	w.GetURL()
 }
 
+func TestWorkflowJobEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	w := &WorkflowJobEvent{Action: &zeroValue}
+	w.GetAction()
+	w = &WorkflowJobEvent{}
+	w.GetAction()
+	w = nil
+	w.GetAction()
+}
+
+func TestWorkflowJobEvent_GetInstallation(tt *testing.T) {
+	w := &WorkflowJobEvent{}
+	w.GetInstallation()
+	w = nil
+	w.GetInstallation()
+}
+
+func TestWorkflowJobEvent_GetOrg(tt *testing.T) {
+	w := &WorkflowJobEvent{}
+	w.GetOrg()
+	w = nil
+	w.GetOrg()
+}
+
+func TestWorkflowJobEvent_GetRepo(tt *testing.T) {
+	w := &WorkflowJobEvent{}
+	w.GetRepo()
+	w = nil
+	w.GetRepo()
+}
+
+func TestWorkflowJobEvent_GetSender(tt *testing.T) {
+	w := &WorkflowJobEvent{}
+	w.GetSender()
+	w = nil
+	w.GetSender()
+}
+
+func TestWorkflowJobEvent_GetWorkflowJob(tt *testing.T) {
+	w := &WorkflowJobEvent{}
+	w.GetWorkflowJob()
+	w = nil
+	w.GetWorkflowJob()
+}
+
 func TestWorkflowRun_GetArtifactsURL(tt *testing.T) {
 	var zeroValue string
 	w := &WorkflowRun{ArtifactsURL: &zeroValue}
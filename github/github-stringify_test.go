@@ -36,6 +36,18 @@ func TestActionsPermissions_String(t *testing.T) {
 	}
 }
 
+func TestActionsPermissionsEnterprise_String(t *testing.T) {
+	v := ActionsPermissionsEnterprise{
+		EnabledOrganizations: String(""),
+		AllowedActions:       String(""),
+		SelectedActionsURL:   String(""),
+	}
+	want := `github.ActionsPermissionsEnterprise{EnabledOrganizations:"", AllowedActions:"", SelectedActionsURL:""}`
+	if got := v.String(); got != want {
+		t.Errorf("ActionsPermissionsEnterprise.String = %v, want %v", got, want)
+	}
+}
+
 func TestAdminStats_String(t *testing.T) {
 	v := AdminStats{
 		Issues:     &IssueStats{},
@@ -55,6 +67,18 @@ func TestAdminStats_String(t *testing.T) {
 	}
 }
 
+func TestAnnouncement_String(t *testing.T) {
+	v := Announcement{
+		Message:         String(""),
+		Expiration:      &Timestamp{},
+		UserDismissible: Bool(false),
+	}
+	want := `github.Announcement{Message:"", Expiration:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UserDismissible:false}`
+	if got := v.String(); got != want {
+		t.Errorf("Announcement.String = %v, want %v", got, want)
+	}
+}
+
 func TestAuthorization_String(t *testing.T) {
 	v := Authorization{
 		ID:             Int64(0),
@@ -666,6 +690,8 @@ func TestIssue_String(t *testing.T) {
 		Locked:            Bool(false),
 		Title:             String(""),
 		Body:              String(""),
+		BodyText:          String(""),
+		BodyHTML:          String(""),
 		AuthorAssociation: String(""),
 		User:              &User{},
 		Assignee:          &User{},
@@ -684,7 +710,7 @@ func TestIssue_String(t *testing.T) {
 		NodeID:            String(""),
 		ActiveLockReason:  String(""),
 	}
-	want := `github.Issue{ID:0, Number:0, State:"", Locked:false, Title:"", Body:"", AuthorAssociation:"", User:github.User{}, Assignee:github.User{}, Comments:0, ClosedBy:github.User{}, URL:"", HTMLURL:"", CommentsURL:"", EventsURL:"", LabelsURL:"", RepositoryURL:"", Milestone:github.Milestone{}, PullRequestLinks:github.PullRequestLinks{}, Repository:github.Repository{}, Reactions:github.Reactions{}, NodeID:"", ActiveLockReason:""}`
+	want := `github.Issue{ID:0, Number:0, State:"", Locked:false, Title:"", Body:"", BodyText:"", BodyHTML:"", AuthorAssociation:"", User:github.User{}, Assignee:github.User{}, Comments:0, ClosedBy:github.User{}, URL:"", HTMLURL:"", CommentsURL:"", EventsURL:"", LabelsURL:"", RepositoryURL:"", Milestone:github.Milestone{}, PullRequestLinks:github.PullRequestLinks{}, Repository:github.Repository{}, Reactions:github.Reactions{}, NodeID:"", ActiveLockReason:""}`
 	if got := v.String(); got != want {
 		t.Errorf("Issue.String = %v, want %v", got, want)
 	}
@@ -695,6 +721,8 @@ func TestIssueComment_String(t *testing.T) {
 		ID:                Int64(0),
 		NodeID:            String(""),
 		Body:              String(""),
+		BodyText:          String(""),
+		BodyHTML:          String(""),
 		User:              &User{},
 		Reactions:         &Reactions{},
 		AuthorAssociation: String(""),
@@ -702,7 +730,7 @@ func TestIssueComment_String(t *testing.T) {
 		HTMLURL:           String(""),
 		IssueURL:          String(""),
 	}
-	want := `github.IssueComment{ID:0, NodeID:"", Body:"", User:github.User{}, Reactions:github.Reactions{}, AuthorAssociation:"", URL:"", HTMLURL:"", IssueURL:""}`
+	want := `github.IssueComment{ID:0, NodeID:"", Body:"", BodyText:"", BodyHTML:"", User:github.User{}, Reactions:github.Reactions{}, AuthorAssociation:"", URL:"", HTMLURL:"", IssueURL:""}`
 	if got := v.String(); got != want {
 		t.Errorf("IssueComment.String = %v, want %v", got, want)
 	}
@@ -729,8 +757,10 @@ func TestKey_String(t *testing.T) {
 		ReadOnly:  Bool(false),
 		Verified:  Bool(false),
 		CreatedAt: &Timestamp{},
+		AddedBy:   String(""),
+		LastUsed:  &Timestamp{},
 	}
-	want := `github.Key{ID:0, Key:"", URL:"", Title:"", ReadOnly:false, Verified:false, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}`
+	want := `github.Key{ID:0, Key:"", URL:"", Title:"", ReadOnly:false, Verified:false, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, AddedBy:"", LastUsed:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}`
 	if got := v.String(); got != want {
 		t.Errorf("Key.String = %v, want %v", got, want)
 	}
@@ -786,6 +816,7 @@ func TestLicense_String(t *testing.T) {
 		Key:            String(""),
 		Name:           String(""),
 		URL:            String(""),
+		NodeID:         String(""),
 		SPDXID:         String(""),
 		HTMLURL:        String(""),
 		Featured:       Bool(false),
@@ -793,7 +824,7 @@ func TestLicense_String(t *testing.T) {
 		Implementation: String(""),
 		Body:           String(""),
 	}
-	want := `github.License{Key:"", Name:"", URL:"", SPDXID:"", HTMLURL:"", Featured:false, Description:"", Implementation:"", Body:""}`
+	want := `github.License{Key:"", Name:"", URL:"", NodeID:"", SPDXID:"", HTMLURL:"", Featured:false, Description:"", Implementation:"", Body:""}`
 	if got := v.String(); got != want {
 		t.Errorf("License.String = %v, want %v", got, want)
 	}
@@ -944,15 +975,17 @@ func TestOrganization_String(t *testing.T) {
 		MembersCanCreatePages:                Bool(false),
 		MembersCanCreatePublicPages:          Bool(false),
 		MembersCanCreatePrivatePages:         Bool(false),
-		URL:                                  String(""),
-		EventsURL:                            String(""),
-		HooksURL:                             String(""),
-		IssuesURL:                            String(""),
-		MembersURL:                           String(""),
-		PublicMembersURL:                     String(""),
-		ReposURL:                             String(""),
-	}
-	want := `github.Organization{Login:"", ID:0, NodeID:"", AvatarURL:"", HTMLURL:"", Name:"", Company:"", Blog:"", Location:"", Email:"", TwitterUsername:"", Description:"", PublicRepos:0, PublicGists:0, Followers:0, Following:0, TotalPrivateRepos:0, OwnedPrivateRepos:0, PrivateGists:0, DiskUsage:0, Collaborators:0, BillingEmail:"", Type:"", Plan:github.Plan{}, TwoFactorRequirementEnabled:false, IsVerified:false, HasOrganizationProjects:false, HasRepositoryProjects:false, DefaultRepoPermission:"", DefaultRepoSettings:"", MembersCanCreateRepos:false, MembersCanCreatePublicRepos:false, MembersCanCreatePrivateRepos:false, MembersCanCreateInternalRepos:false, MembersAllowedRepositoryCreationType:"", MembersCanCreatePages:false, MembersCanCreatePublicPages:false, MembersCanCreatePrivatePages:false, URL:"", EventsURL:"", HooksURL:"", IssuesURL:"", MembersURL:"", PublicMembersURL:"", ReposURL:""}`
+		WebCommitSignoffRequired:             Bool(false),
+		SecretScanningPushProtectionEnabledForNewRepos: Bool(false),
+		URL:              String(""),
+		EventsURL:        String(""),
+		HooksURL:         String(""),
+		IssuesURL:        String(""),
+		MembersURL:       String(""),
+		PublicMembersURL: String(""),
+		ReposURL:         String(""),
+	}
+	want := `github.Organization{Login:"", ID:0, NodeID:"", AvatarURL:"", HTMLURL:"", Name:"", Company:"", Blog:"", Location:"", Email:"", TwitterUsername:"", Description:"", PublicRepos:0, PublicGists:0, Followers:0, Following:0, TotalPrivateRepos:0, OwnedPrivateRepos:0, PrivateGists:0, DiskUsage:0, Collaborators:0, BillingEmail:"", Type:"", Plan:github.Plan{}, TwoFactorRequirementEnabled:false, IsVerified:false, HasOrganizationProjects:false, HasRepositoryProjects:false, DefaultRepoPermission:"", DefaultRepoSettings:"", MembersCanCreateRepos:false, MembersCanCreatePublicRepos:false, MembersCanCreatePrivateRepos:false, MembersCanCreateInternalRepos:false, MembersAllowedRepositoryCreationType:"", MembersCanCreatePages:false, MembersCanCreatePublicPages:false, MembersCanCreatePrivatePages:false, WebCommitSignoffRequired:false, SecretScanningPushProtectionEnabledForNewRepos:false, URL:"", EventsURL:"", HooksURL:"", IssuesURL:"", MembersURL:"", PublicMembersURL:"", ReposURL:""}`
 	if got := v.String(); got != want {
 		t.Errorf("Organization.String = %v, want %v", got, want)
 	}
@@ -1084,12 +1117,16 @@ func TestPlan_String(t *testing.T) {
 
 func TestPreReceiveHook_String(t *testing.T) {
 	v := PreReceiveHook{
-		ID:          Int64(0),
-		Name:        String(""),
-		Enforcement: String(""),
-		ConfigURL:   String(""),
+		ID:                           Int64(0),
+		Name:                         String(""),
+		Enforcement:                  String(""),
+		ConfigURL:                    String(""),
+		Script:                       String(""),
+		ScriptRepository:             &Repository{},
+		Environment:                  &PreReceiveEnvironment{},
+		AllowDownstreamConfiguration: Bool(false),
 	}
-	want := `github.PreReceiveHook{ID:0, Name:"", Enforcement:"", ConfigURL:""}`
+	want := `github.PreReceiveHook{ID:0, Name:"", Enforcement:"", ConfigURL:"", Script:"", ScriptRepository:github.Repository{}, Environment:github.PreReceiveEnvironment{}, AllowDownstreamConfiguration:false}`
 	if got := v.String(); got != want {
 		t.Errorf("PreReceiveHook.String = %v, want %v", got, want)
 	}
@@ -1493,8 +1530,9 @@ func TestRepositoryComment_String(t *testing.T) {
 		Body:      String(""),
 		Path:      String(""),
 		Position:  Int(0),
+		Line:      Int(0),
 	}
-	want := `github.RepositoryComment{HTMLURL:"", URL:"", ID:0, NodeID:"", CommitID:"", User:github.User{}, Reactions:github.Reactions{}, Body:"", Path:"", Position:0}`
+	want := `github.RepositoryComment{HTMLURL:"", URL:"", ID:0, NodeID:"", CommitID:"", User:github.User{}, Reactions:github.Reactions{}, Body:"", Path:"", Position:0, Line:0}`
 	if got := v.String(); got != want {
 		t.Errorf("RepositoryComment.String = %v, want %v", got, want)
 	}
@@ -1569,6 +1607,7 @@ func TestRepositoryRelease_String(t *testing.T) {
 		Draft:                  Bool(false),
 		Prerelease:             Bool(false),
 		DiscussionCategoryName: String(""),
+		MakeLatest:             String(""),
 		ID:                     Int64(0),
 		CreatedAt:              &Timestamp{},
 		PublishedAt:            &Timestamp{},
@@ -1581,12 +1620,25 @@ func TestRepositoryRelease_String(t *testing.T) {
 		Author:                 &User{},
 		NodeID:                 String(""),
 	}
-	want := `github.RepositoryRelease{TagName:"", TargetCommitish:"", Name:"", Body:"", Draft:false, Prerelease:false, DiscussionCategoryName:"", ID:0, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, PublishedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, URL:"", HTMLURL:"", AssetsURL:"", UploadURL:"", ZipballURL:"", TarballURL:"", Author:github.User{}, NodeID:""}`
+	want := `github.RepositoryRelease{TagName:"", TargetCommitish:"", Name:"", Body:"", Draft:false, Prerelease:false, DiscussionCategoryName:"", MakeLatest:"", ID:0, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, PublishedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, URL:"", HTMLURL:"", AssetsURL:"", UploadURL:"", ZipballURL:"", TarballURL:"", Author:github.User{}, NodeID:""}`
 	if got := v.String(); got != want {
 		t.Errorf("RepositoryRelease.String = %v, want %v", got, want)
 	}
 }
 
+func TestSSHSigningKey_String(t *testing.T) {
+	v := SSHSigningKey{
+		ID:        Int64(0),
+		Key:       String(""),
+		Title:     String(""),
+		CreatedAt: &Timestamp{},
+	}
+	want := `github.SSHSigningKey{ID:0, Key:"", Title:"", CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}`
+	if got := v.String(); got != want {
+		t.Errorf("SSHSigningKey.String = %v, want %v", got, want)
+	}
+}
+
 func TestSourceImportAuthor_String(t *testing.T) {
 	v := SourceImportAuthor{
 		ID:         Int64(0),
@@ -1756,8 +1808,9 @@ func TestUser_String(t *testing.T) {
 		ReposURL:                String(""),
 		StarredURL:              String(""),
 		SubscriptionsURL:        String(""),
+		RoleName:                String(""),
 	}
-	want := `github.User{Login:"", ID:0, NodeID:"", AvatarURL:"", HTMLURL:"", GravatarID:"", Name:"", Company:"", Blog:"", Location:"", Email:"", Hireable:false, Bio:"", TwitterUsername:"", PublicRepos:0, PublicGists:0, Followers:0, Following:0, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, SuspendedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Type:"", SiteAdmin:false, TotalPrivateRepos:0, OwnedPrivateRepos:0, PrivateGists:0, DiskUsage:0, Collaborators:0, TwoFactorAuthentication:false, Plan:github.Plan{}, LdapDn:"", URL:"", EventsURL:"", FollowingURL:"", FollowersURL:"", GistsURL:"", OrganizationsURL:"", ReceivedEventsURL:"", ReposURL:"", StarredURL:"", SubscriptionsURL:""}`
+	want := `github.User{Login:"", ID:0, NodeID:"", AvatarURL:"", HTMLURL:"", GravatarID:"", Name:"", Company:"", Blog:"", Location:"", Email:"", Hireable:false, Bio:"", TwitterUsername:"", PublicRepos:0, PublicGists:0, Followers:0, Following:0, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, SuspendedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Type:"", SiteAdmin:false, TotalPrivateRepos:0, OwnedPrivateRepos:0, PrivateGists:0, DiskUsage:0, Collaborators:0, TwoFactorAuthentication:false, Plan:github.Plan{}, LdapDn:"", URL:"", EventsURL:"", FollowingURL:"", FollowersURL:"", GistsURL:"", OrganizationsURL:"", ReceivedEventsURL:"", ReposURL:"", StarredURL:"", SubscriptionsURL:"", RoleName:""}`
 	if got := v.String(); got != want {
 		t.Errorf("User.String = %v, want %v", got, want)
 	}
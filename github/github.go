@@ -16,9 +16,11 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"net/url"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -35,15 +37,32 @@ const (
 	headerRateLimit     = "X-RateLimit-Limit"
 	headerRateRemaining = "X-RateLimit-Remaining"
 	headerRateReset     = "X-RateLimit-Reset"
+	headerRateResource  = "X-RateLimit-Resource"
+	headerRetryAfter    = "Retry-After"
 	headerOTP           = "X-GitHub-OTP"
+	headerRequestID     = "X-GitHub-Request-Id"
+	headerPollInterval  = "X-Poll-Interval"
+	headerETag          = "ETag"
+
+	headerOAuthScopes         = "X-OAuth-Scopes"
+	headerAcceptedOAuthScopes = "X-Accepted-OAuth-Scopes"
 
 	headerTokenExpiration = "GitHub-Authentication-Token-Expiration"
 
+	headerSunset      = "Sunset"
+	headerDeprecation = "Deprecation"
+
+	headerSSO = "X-GitHub-SSO"
+
 	mediaTypeV3                = "application/vnd.github.v3+json"
 	defaultMediaType           = "application/octet-stream"
 	mediaTypeV3SHA             = "application/vnd.github.v3.sha"
 	mediaTypeV3Diff            = "application/vnd.github.v3.diff"
 	mediaTypeV3Patch           = "application/vnd.github.v3.patch"
+	mediaTypeV3RawJSON         = "application/vnd.github.v3.raw+json"
+	mediaTypeV3TextJSON        = "application/vnd.github.v3.text+json"
+	mediaTypeV3HTMLJSON        = "application/vnd.github.v3.html+json"
+	mediaTypeV3FullJSON        = "application/vnd.github.v3.full+json"
 	mediaTypeOrgPermissionRepo = "application/vnd.github.v3.repository+json"
 	mediaTypeIssueImportAPI    = "application/vnd.github.golden-comet-preview+json"
 
@@ -152,38 +171,59 @@ type Client struct {
 	// User agent used when communicating with the GitHub API.
 	UserAgent string
 
+	// Logger, if set, receives a one-time warning for each endpoint whose
+	// responses carry a Deprecation or Sunset header, and a note for each
+	// per_page value clamped by SetClampPerPage.
+	Logger *log.Logger
+
+	deprecationMu     sync.Mutex
+	deprecationWarned map[string]bool // Endpoints already reported to Logger.
+
 	rateMu     sync.Mutex
 	rateLimits [categories]Rate // Rate limits for the client as determined by the most recent API calls.
 
+	limiter Limiter // Limiter consulted before each request, see WithLimiter.
+
+	clampPerPage          bool // Whether NewRequest clamps per_page, see SetClampPerPage.
+	disableRateLimitCheck bool // Whether rate limits go untracked, see DisableRateLimitCheck.
+
+	emojis emojiCache // Cached result of ListEmojis, see EnableEmojiCache.
+
+	hookIPs hookIPCache // Cached hooks CIDRs of APIMeta, see ValidateHookIP.
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the GitHub API.
-	Actions        *ActionsService
-	Activity       *ActivityService
-	Admin          *AdminService
-	Apps           *AppsService
-	Authorizations *AuthorizationsService
-	Billing        *BillingService
-	Checks         *ChecksService
-	CodeScanning   *CodeScanningService
-	Enterprise     *EnterpriseService
-	Gists          *GistsService
-	Git            *GitService
-	Gitignores     *GitignoresService
-	Interactions   *InteractionsService
-	IssueImport    *IssueImportService
-	Issues         *IssuesService
-	Licenses       *LicensesService
-	Marketplace    *MarketplaceService
-	Migrations     *MigrationService
-	Organizations  *OrganizationsService
-	Projects       *ProjectsService
-	PullRequests   *PullRequestsService
-	Reactions      *ReactionsService
-	Repositories   *RepositoriesService
-	Search         *SearchService
-	Teams          *TeamsService
-	Users          *UsersService
+	Actions            *ActionsService
+	Activity           *ActivityService
+	Admin              *AdminService
+	Apps               *AppsService
+	Authorizations     *AuthorizationsService
+	Billing            *BillingService
+	Checks             *ChecksService
+	CodeScanning       *CodeScanningService
+	Codespaces         *CodespacesService
+	Dependabot         *DependabotService
+	Enterprise         *EnterpriseService
+	Gists              *GistsService
+	Git                *GitService
+	Gitignores         *GitignoresService
+	Interactions       *InteractionsService
+	IssueImport        *IssueImportService
+	Issues             *IssuesService
+	Licenses           *LicensesService
+	Marketplace        *MarketplaceService
+	Migrations         *MigrationService
+	Organizations      *OrganizationsService
+	Projects           *ProjectsService
+	PullRequests       *PullRequestsService
+	Reactions          *ReactionsService
+	Repositories       *RepositoriesService
+	Search             *SearchService
+	SecretScanning     *SecretScanningService
+	SecurityAdvisories *SecurityAdvisoriesService
+	Teams              *TeamsService
+	Users              *UsersService
 }
 
 type service struct {
@@ -245,11 +285,49 @@ const (
 )
 
 // RawOptions specifies parameters when user wants to get raw format of
-// a response instead of JSON.
+// a response instead of JSON. It is used by methods such as
+// PullRequestsService.GetRaw, RepositoriesService.GetCommitRaw and
+// RepositoriesService.CompareCommitsRaw.
 type RawOptions struct {
 	Type RawType
 }
 
+// BodyFormat represents the representation of Markdown bodies, such as the
+// bodies of issues and comments, returned by GitHub.
+type BodyFormat uint8
+
+const (
+	// BodyRaw returns the raw Markdown body in the Body field.
+	BodyRaw BodyFormat = 1 + iota
+	// BodyText returns a plain text rendering of the body in the BodyText field.
+	BodyText
+	// BodyHTML returns an HTML rendering of the body in the BodyHTML field.
+	BodyHTML
+	// BodyFull returns the Body, BodyText and BodyHTML fields.
+	BodyFull
+)
+
+// BodyOptions specifies the representation of the Markdown bodies of a
+// response.
+type BodyOptions struct {
+	Format BodyFormat
+}
+
+// mediaType returns the media type that requests the body format of opts.
+func (opts BodyOptions) mediaType() (string, error) {
+	switch opts.Format {
+	case BodyRaw:
+		return mediaTypeV3RawJSON, nil
+	case BodyText:
+		return mediaTypeV3TextJSON, nil
+	case BodyHTML:
+		return mediaTypeV3HTMLJSON, nil
+	case BodyFull:
+		return mediaTypeV3FullJSON, nil
+	}
+	return "", fmt.Errorf("unsupported body format %d", opts.Format)
+}
+
 // addOptions adds the parameters in opts as URL query parameters to s. opts
 // must be a struct whose fields may contain "url" tags.
 func addOptions(s string, opts interface{}) (string, error) {
@@ -267,11 +345,67 @@ func addOptions(s string, opts interface{}) (string, error) {
 	if err != nil {
 		return s, err
 	}
+	formatTimeValues(qs, v)
 
 	u.RawQuery = qs.Encode()
 	return u.String(), nil
 }
 
+var timeType = reflect.TypeOf(time.Time{})
+
+// formatTimeValues rewrites the query parameters of the time.Time and
+// *time.Time fields of opts, including those of embedded structs, as UTC
+// RFC 3339 timestamps, which is the format GitHub expects for filters such
+// as "since" and "until". Parameters of zero times are removed, so a zero
+// time never filters results.
+func formatTimeValues(qs url.Values, opts reflect.Value) {
+	for opts.Kind() == reflect.Ptr {
+		if opts.IsNil() {
+			return
+		}
+		opts = opts.Elem()
+	}
+	if opts.Kind() != reflect.Struct {
+		return
+	}
+
+	typ := opts.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+		tag := f.Tag.Get("url")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		fv := opts.Field(i)
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		if fv.Type() != timeType {
+			if f.Anonymous && name == "" {
+				formatTimeValues(qs, fv)
+			}
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		if t := fv.Interface().(time.Time); t.IsZero() {
+			qs.Del(name)
+		} else {
+			qs.Set(name, t.UTC().Format(time.RFC3339))
+		}
+	}
+}
+
 // NewClient returns a new GitHub API client. If a nil httpClient is
 // provided, a new http.Client will be used. To use API methods which require
 // authentication, provide an http.Client that will perform the authentication
@@ -293,6 +427,8 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Billing = (*BillingService)(&c.common)
 	c.Checks = (*ChecksService)(&c.common)
 	c.CodeScanning = (*CodeScanningService)(&c.common)
+	c.Codespaces = (*CodespacesService)(&c.common)
+	c.Dependabot = (*DependabotService)(&c.common)
 	c.Enterprise = (*EnterpriseService)(&c.common)
 	c.Gists = (*GistsService)(&c.common)
 	c.Git = (*GitService)(&c.common)
@@ -309,6 +445,8 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Reactions = (*ReactionsService)(&c.common)
 	c.Repositories = (*RepositoriesService)(&c.common)
 	c.Search = (*SearchService)(&c.common)
+	c.SecretScanning = (*SecretScanningService)(&c.common)
+	c.SecurityAdvisories = (*SecurityAdvisoriesService)(&c.common)
 	c.Teams = (*TeamsService)(&c.common)
 	c.Users = (*UsersService)(&c.common)
 	return c
@@ -321,45 +459,172 @@ func NewClient(httpClient *http.Client) *Client {
 // If a nil httpClient is provided, a new http.Client will be used.
 //
 // Note that NewEnterpriseClient is a convenience helper only;
-// its behavior is equivalent to using NewClient, followed by setting
-// the BaseURL and UploadURL fields.
+// its behavior is equivalent to using NewClient, followed by
+// WithEnterpriseURLs.
 //
 // Another important thing is that by default, the GitHub Enterprise URL format
 // should be http(s)://[hostname]/api/v3/ or you will always receive the 406 status code.
 // The upload URL format should be http(s)://[hostname]/api/uploads/.
 func NewEnterpriseClient(baseURL, uploadURL string, httpClient *http.Client) (*Client, error) {
+	return NewClient(httpClient).WithEnterpriseURLs(baseURL, uploadURL)
+}
+
+// WithEnterpriseURLs sets the BaseURL and UploadURL of c to the provided
+// GitHub Enterprise URLs and returns c.
+//
+// Both URLs are normalized to end with a trailing slash. Unless the host is an
+// API host (such as "api.github.com" or "api.example.com"), the "api/v3/"
+// suffix is appended to the base URL and the "api/uploads/" suffix is appended
+// to the upload URL when they are missing, so that passing only the root of
+// the GitHub Enterprise host is enough. Fully specified URLs are left untouched.
+//
+// If either URL cannot be parsed, an error is returned and c is not modified.
+func (c *Client) WithEnterpriseURLs(baseURL, uploadURL string) (*Client, error) {
+	// Both URLs are built before c is touched, so that a failed call leaves
+	// c unmodified.
 	baseEndpoint, err := url.Parse(baseURL)
 	if err != nil {
 		return nil, err
 	}
-	if !strings.HasSuffix(baseEndpoint.Path, "/") {
-		baseEndpoint.Path += "/"
-	}
-	if !strings.HasSuffix(baseEndpoint.Path, "/api/v3/") &&
-		!strings.HasPrefix(baseEndpoint.Host, "api.") &&
-		!strings.Contains(baseEndpoint.Host, ".api.") {
-		baseEndpoint.Path += "api/v3/"
-	}
+	addEnterpriseSuffix(baseEndpoint, "api/v3/")
 
 	uploadEndpoint, err := url.Parse(uploadURL)
 	if err != nil {
 		return nil, err
 	}
-	if !strings.HasSuffix(uploadEndpoint.Path, "/") {
-		uploadEndpoint.Path += "/"
-	}
-	if !strings.HasSuffix(uploadEndpoint.Path, "/api/uploads/") &&
-		!strings.HasPrefix(uploadEndpoint.Host, "api.") &&
-		!strings.Contains(uploadEndpoint.Host, ".api.") {
-		uploadEndpoint.Path += "api/uploads/"
-	}
+	addEnterpriseSuffix(uploadEndpoint, "api/uploads/")
 
-	c := NewClient(httpClient)
 	c.BaseURL = baseEndpoint
 	c.UploadURL = uploadEndpoint
 	return c, nil
 }
 
+// dataResidencyTenantRE matches the subdomain of a GitHub Enterprise Cloud
+// tenant with data residency, such as "octocorp" in "octocorp.ghe.com".
+var dataResidencyTenantRE = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// WithEnterpriseCloudDataResidency sets the BaseURL and UploadURL of c to the
+// API and upload URLs of the given GitHub Enterprise Cloud with data residency
+// tenant, "https://api.<tenant>.ghe.com/" and "https://uploads.<tenant>.ghe.com/",
+// and returns c.
+//
+// The tenant is the subdomain of the enterprise on ghe.com. If it is not a
+// valid subdomain, an error is returned and c is not modified.
+func (c *Client) WithEnterpriseCloudDataResidency(tenant string) (*Client, error) {
+	if !dataResidencyTenantRE.MatchString(tenant) {
+		return nil, fmt.Errorf("invalid ghe.com tenant %q", tenant)
+	}
+
+	c.BaseURL = &url.URL{Scheme: "https", Host: "api." + tenant + ".ghe.com", Path: "/"}
+	c.UploadURL = &url.URL{Scheme: "https", Host: "uploads." + tenant + ".ghe.com", Path: "/"}
+	return c, nil
+}
+
+// userAgentTokenRE matches a token as defined by RFC 7230, section 3.2.6,
+// which is what the product and version of a User-Agent header consist of.
+var userAgentTokenRE = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+
+// WithUserAgent sets the UserAgent of c to the given product and version,
+// followed by the default go-github user agent, and returns c. For example,
+// WithUserAgent("my-app", "1.2.3") results in "my-app/1.2.3 go-github".
+// Keeping the go-github part lets GitHub tell which library made a request.
+//
+// The version may be empty, in which case only the product is prepended. If
+// the product is empty, or the product or version contain characters that
+// are not allowed in a User-Agent token, an error is returned and c is not
+// modified.
+func (c *Client) WithUserAgent(product, version string) (*Client, error) {
+	if !userAgentTokenRE.MatchString(product) {
+		return nil, fmt.Errorf("invalid user agent product %q", product)
+	}
+	if version != "" {
+		if !userAgentTokenRE.MatchString(version) {
+			return nil, fmt.Errorf("invalid user agent version %q", version)
+		}
+		product += "/" + version
+	}
+
+	c.UserAgent = product + " " + userAgent
+	return c, nil
+}
+
+// Limiter paces the requests made by a Client. It is consulted before each
+// request is sent, in addition to the client's own tracking of the rate limit
+// reset time. A *rate.Limiter from golang.org/x/time/rate satisfies this
+// interface.
+type Limiter interface {
+	// Wait blocks until the request may proceed, or returns an error if it
+	// may not, for instance because ctx is done.
+	Wait(ctx context.Context) error
+}
+
+// WithLimiter sets the Limiter consulted by c before each request and
+// returns c. Sharing one Limiter between several clients that use the same
+// token keeps their combined request rate under control. Passing nil removes
+// the limiter.
+func (c *Client) WithLimiter(l Limiter) *Client {
+	c.limiter = l
+	return c
+}
+
+// maxPerPage is the largest per_page value accepted by the GitHub REST API.
+// Larger values are silently treated as maxPerPage by GitHub.
+const maxPerPage = 100
+
+// SetClampPerPage sets whether c lowers per_page query parameters above the
+// maximum accepted by GitHub, 100, to that maximum before sending requests.
+// Without it, GitHub silently returns pages of at most 100 items, which makes
+// a large ListOptions.PerPage surprising when paginating. Each clamp is
+// reported to c.Logger, if set. Clamping is disabled by default.
+func (c *Client) SetClampPerPage(clamp bool) {
+	c.clampPerPage = clamp
+}
+
+// DisableRateLimitCheck sets whether c stops tracking rate limits. By
+// default, c records the rate limit reported by each response and, once a
+// limit is exhausted, fails further requests counting against it with a
+// *RateLimitError until the limit resets, without contacting GitHub.
+//
+// Disabling the check skips both the bookkeeping and the pre-flight check,
+// which suits clients that share an external rate limiter between many
+// goroutines or processes. Response.Rate is still parsed from each response.
+// It should be called before c is used.
+func (c *Client) DisableRateLimitCheck(disable bool) {
+	c.disableRateLimitCheck = disable
+}
+
+// clampPerPageParam lowers the per_page query parameter of u to maxPerPage
+// if c.clampPerPage is set and it is larger.
+func (c *Client) clampPerPageParam(u *url.URL) {
+	if !c.clampPerPage || u.RawQuery == "" {
+		return
+	}
+	q := u.Query()
+	perPage, err := strconv.Atoi(q.Get("per_page"))
+	if err != nil || perPage <= maxPerPage {
+		return
+	}
+
+	q.Set("per_page", strconv.Itoa(maxPerPage))
+	u.RawQuery = q.Encode()
+	if c.Logger != nil {
+		c.Logger.Printf("go-github: clamped per_page=%d to %d for %v", perPage, maxPerPage, u.Path)
+	}
+}
+
+// addEnterpriseSuffix makes sure the path of u ends with a trailing slash and,
+// unless u points to an API host, with suffix.
+func addEnterpriseSuffix(u *url.URL, suffix string) {
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	if !strings.HasSuffix(u.Path, "/"+suffix) &&
+		!strings.HasPrefix(u.Host, "api.") &&
+		!strings.Contains(u.Host, ".api.") {
+		u.Path += suffix
+	}
+}
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr,
 // in which case it is resolved relative to the BaseURL of the Client.
 // Relative URLs should always be specified without a preceding slash. If
@@ -373,6 +638,7 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	if err != nil {
 		return nil, err
 	}
+	c.clampPerPageParam(u)
 
 	var buf io.ReadWriter
 	if body != nil {
@@ -431,6 +697,9 @@ func (c *Client) NewUploadRequest(urlStr string, reader io.Reader, size int64, m
 // returned from GitHub and provides convenient access to things like
 // pagination links.
 type Response struct {
+	// The embedded http.Response provides the Request that produced it,
+	// with credential headers and URL secrets removed so that it can be
+	// safely included in error reports.
 	*http.Response
 
 	// These fields provide the page values for paginating through a set of
@@ -463,12 +732,56 @@ type Response struct {
 	// Set ListCursorOptions.Cursor to this value when calling the endpoint again.
 	Cursor string
 
+	// For APIs that support before/after pagination, such as
+	// SecurityAdvisoriesService.ListGlobalSecurityAdvisories, the following
+	// field will be populated to point to the next page.
+	// Set ListCursorOptions.After to this value when calling the endpoint again.
+	After string
+
+	// Links holds the raw URLs of the "next", "prev", "first" and "last"
+	// relations of the Link header, keyed by relation name. It allows
+	// following pagination schemes that don't use plain page numbers.
+	Links map[string]string
+
 	// Explicitly specify the Rate type so Rate's String() receiver doesn't
 	// propagate to Response.
+	//
+	// Rate is parsed from the response's own rate limit headers, so it
+	// describes the rate limit of the resource the request counted against,
+	// such as "search" for SearchService calls.
 	Rate Rate
 
+	// Resource is the rate limit resource the request counted against, such
+	// as "core", "search" or "graphql", as reported by the
+	// X-RateLimit-Resource header.
+	Resource string
+
 	// token's expiration date
 	TokenExpiration Timestamp
+
+	// Sunset is the time after which the endpoint is expected to stop
+	// working, as advertised by the Sunset header. It is the zero value if
+	// the header is absent or could not be parsed.
+	Sunset time.Time
+
+	// Deprecated reports whether the response carried a Deprecation header,
+	// indicating that the endpoint is deprecated.
+	Deprecated bool
+
+	// RequestID is the value of the X-GitHub-Request-Id header, which GitHub
+	// Support may ask for when investigating a problem.
+	RequestID string
+
+	// PollInterval is how long GitHub asks clients to wait before polling
+	// the endpoint again, as advertised by the X-Poll-Interval header on
+	// endpoints such as ActivityService.ListNotifications. It is zero if the
+	// header is absent or could not be parsed.
+	PollInterval time.Duration
+
+	// ETag is the value of the ETag header. Sending it back in an
+	// If-None-Match header makes the request conditional: GitHub then
+	// responds with 304 Not Modified if the resource is unchanged.
+	ETag string
 }
 
 // newResponse creates a new Response for the provided http.Response.
@@ -477,7 +790,12 @@ func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
 	response.populatePageValues()
 	response.Rate = parseRate(r)
+	response.Resource = r.Header.Get(headerRateResource)
 	response.TokenExpiration = parseTokenExpiration(r)
+	response.Sunset, response.Deprecated = parseDeprecation(r)
+	response.RequestID = r.Header.Get(headerRequestID)
+	response.PollInterval = parsePollInterval(r)
+	response.ETag = r.Header.Get(headerETag)
 	return response
 }
 
@@ -499,11 +817,22 @@ func (r *Response) populatePageValues() {
 			}
 
 			// try to pull out page parameter
-			url, err := url.Parse(segments[0][1 : len(segments[0])-1])
+			rawURL := segments[0][1 : len(segments[0])-1]
+			url, err := url.Parse(rawURL)
 			if err != nil {
 				continue
 			}
 
+			for _, segment := range segments[1:] {
+				switch rel := strings.TrimSpace(segment); rel {
+				case `rel="next"`, `rel="prev"`, `rel="first"`, `rel="last"`:
+					if r.Links == nil {
+						r.Links = make(map[string]string)
+					}
+					r.Links[rel[5:len(rel)-1]] = rawURL
+				}
+			}
+
 			q := url.Query()
 
 			if cursor := q.Get("cursor"); cursor != "" {
@@ -517,6 +846,17 @@ func (r *Response) populatePageValues() {
 				continue
 			}
 
+			if after := q.Get("after"); after != "" {
+				for _, segment := range segments[1:] {
+					switch strings.TrimSpace(segment) {
+					case `rel="next"`:
+						r.After = after
+					}
+				}
+
+				continue
+			}
+
 			page := q.Get("page")
 			if page == "" {
 				continue
@@ -557,6 +897,115 @@ func parseRate(r *http.Response) Rate {
 	return rate
 }
 
+// parseAbuseRetryAfter determines how long to wait before retrying after an
+// abuse rate limit response. A positive "Retry-After" header is preferred,
+// falling back to the time remaining until "X-RateLimit-Reset". It returns nil
+// if neither header is usable, and otherwise a duration of at least a second.
+func parseAbuseRetryAfter(r *http.Response) *time.Duration {
+	var retryAfter time.Duration
+	// According to GitHub support, the "Retry-After" header value will be
+	// an integer which represents the number of seconds that one should
+	// wait before resuming making requests.
+	if seconds, err := strconv.ParseInt(r.Header.Get(headerRetryAfter), 10, 64); err == nil && seconds > 0 {
+		retryAfter = time.Duration(seconds) * time.Second
+	} else if reset, err := strconv.ParseInt(r.Header.Get(headerRateReset), 10, 64); err == nil && reset > 0 {
+		retryAfter = time.Until(time.Unix(reset, 0))
+		if retryAfter < time.Second {
+			retryAfter = time.Second
+		}
+	} else {
+		return nil
+	}
+	return &retryAfter
+}
+
+// defaultRateLimitBackoff is how long the helpers that wait out rate limits
+// pause after a rate limit error that does not say when requests may resume.
+var defaultRateLimitBackoff = time.Minute
+
+// sleepUntil blocks until t, or until ctx is done.
+func sleepUntil(ctx context.Context, t time.Time) error {
+	wait := time.Until(t)
+	if wait <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// defaultConcurrentRequests is the number of requests that the helpers
+// making many requests at once, such as UsersService.GetMany, have in flight
+// at any time unless told otherwise.
+const defaultConcurrentRequests = 4
+
+// runBounded calls fn for each index in [0, n), with at most workers calls
+// in flight, and returns the errors fn returned keyed by index. Once fn
+// returns a *RateLimitError or *AbuseRateLimitError, or ctx is done, the
+// indexes that have not been started yet are not passed to fn, and fail
+// with that error instead.
+func runBounded(ctx context.Context, n, workers int, fn func(i int) error) map[int]error {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		errs    = make(map[int]error)
+		stopErr error
+	)
+
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	jobs := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				mu.Lock()
+				err := stopErr
+				mu.Unlock()
+				if err == nil {
+					err = ctx.Err()
+				}
+				if err == nil {
+					err = fn(i)
+				}
+				if err == nil {
+					continue
+				}
+
+				mu.Lock()
+				errs[i] = err
+				switch err.(type) {
+				case *RateLimitError, *AbuseRateLimitError:
+					if stopErr == nil {
+						stopErr = err
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return errs
+}
+
 // parseTokenExpiration parses the TokenExpiration related headers.
 func parseTokenExpiration(r *http.Response) Timestamp {
 	var exp Timestamp
@@ -568,12 +1017,134 @@ func parseTokenExpiration(r *http.Response) Timestamp {
 	return exp
 }
 
+// parsePollInterval parses the X-Poll-Interval header, given in seconds.
+func parsePollInterval(r *http.Response) time.Duration {
+	if v := r.Header.Get(headerPollInterval); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+			return time.Duration(secs) * time.Second
+		}
+	}
+	return 0
+}
+
+// parseDeprecation parses the Sunset and Deprecation headers.
+func parseDeprecation(r *http.Response) (sunset time.Time, deprecated bool) {
+	if v := r.Header.Get(headerSunset); v != "" {
+		if t, err := http.ParseTime(v); err == nil {
+			sunset = t
+		}
+	}
+	if v := r.Header.Get(headerDeprecation); v != "" && v != "false" {
+		deprecated = true
+	}
+	return sunset, deprecated
+}
+
+// maxDeprecationWarnings bounds the number of endpoints remembered by
+// warnDeprecation. Once reached, the endpoints are forgotten, so a long-running
+// client may warn about an endpoint again rather than grow without bounds.
+const maxDeprecationWarnings = 100
+
+// warnDeprecation logs a warning to c.Logger the first time a
+// deprecated or sunsetting endpoint is hit. Numeric path segments, such as
+// issue numbers, are replaced by "{id}", so that the requests to the same
+// endpoint for different resources are reported once.
+func (c *Client) warnDeprecation(req *http.Request, response *Response) {
+	if c.Logger == nil || (!response.Deprecated && response.Sunset.IsZero()) {
+		return
+	}
+
+	endpoint := req.Method + " " + endpointTemplate(req.URL.Path)
+	c.deprecationMu.Lock()
+	defer c.deprecationMu.Unlock()
+	if c.deprecationWarned[endpoint] {
+		return
+	}
+	if c.deprecationWarned == nil || len(c.deprecationWarned) >= maxDeprecationWarnings {
+		c.deprecationWarned = make(map[string]bool)
+	}
+	c.deprecationWarned[endpoint] = true
+
+	if response.Sunset.IsZero() {
+		c.Logger.Printf("go-github: %v is deprecated", endpoint)
+	} else {
+		c.Logger.Printf("go-github: %v is deprecated and will be removed after %v", endpoint, response.Sunset.Format(time.RFC1123))
+	}
+}
+
+// endpointTemplate returns path with its numeric segments replaced by "{id}".
+func endpointTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if segment == "" {
+			continue
+		}
+		if _, err := strconv.ParseUint(segment, 10, 64); err == nil {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
 type requestContext uint8
 
 const (
 	bypassRateLimitCheck requestContext = iota
+	extraRequestHeaders
 )
 
+// WithRequestHeader returns a copy of ctx that makes the Client add the given
+// header to the requests sent with it. It is meant for one-off headers, such
+// as "X-GitHub-Next-Global-ID", that only some calls need:
+//
+//	ctx := github.WithRequestHeader(ctx, "X-GitHub-Next-Global-ID", "1")
+//	repo, _, err := client.Repositories.Get(ctx, "o", "r")
+//
+// Calling WithRequestHeader several times adds several headers. Headers
+// already set on the request by go-github, such as "Accept" or "User-Agent",
+// are never overridden.
+func WithRequestHeader(ctx context.Context, key, value string) context.Context {
+	header := make(http.Header)
+	if h, ok := ctx.Value(extraRequestHeaders).(http.Header); ok {
+		header = h.Clone()
+	}
+	header.Add(key, value)
+	return context.WithValue(ctx, extraRequestHeaders, header)
+}
+
+// addRequestHeaders adds the headers attached to ctx with WithRequestHeader
+// to req, skipping those already set on req. req itself is not modified.
+func addRequestHeaders(ctx context.Context, req *http.Request) *http.Request {
+	header, ok := ctx.Value(extraRequestHeaders).(http.Header)
+	if !ok {
+		return req
+	}
+
+	r := new(http.Request)
+	*r = *req
+	r.Header = req.Header.Clone()
+	if r.Header == nil {
+		r.Header = make(http.Header)
+	}
+	for key, values := range header {
+		if _, ok := r.Header[key]; ok {
+			continue
+		}
+		r.Header[key] = append([]string(nil), values...)
+	}
+	return r
+}
+
+// redirectClient returns an HTTP client for fetching content that GitHub
+// redirects to outside the API, such as logs, archives and release assets.
+// It uses http.DefaultTransport rather than the client's transport, because
+// authenticating transports such as oauth2.Transport or BasicAuthTransport
+// add their credentials in RoundTrip, and those must not be sent to the
+// storage backend.
+func redirectClient() *http.Client {
+	return &http.Client{Transport: http.DefaultTransport}
+}
+
 // BareDo sends an API request and lets you handle the api response. If an error
 // or API Error occurs, the error will contain more information. Otherwise you
 // are supposed to read and close the response's Body. If rate limit is exceeded
@@ -587,10 +1158,11 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 		return nil, errNonNilContext
 	}
 	req = withContext(ctx, req)
+	req = addRequestHeaders(ctx, req)
 
-	rateLimitCategory := category(req.URL.Path)
+	rateLimitCategory := category(strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(c.BaseURL.Path, "/")))
 
-	if bypass := ctx.Value(bypassRateLimitCheck); bypass == nil {
+	if bypass := ctx.Value(bypassRateLimitCheck); bypass == nil && !c.disableRateLimitCheck {
 		// If we've hit rate limit, don't make further requests before Reset time.
 		if err := c.checkRateLimitBeforeDo(req, rateLimitCategory); err != nil {
 			return &Response{
@@ -600,6 +1172,12 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 		}
 	}
 
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	resp, err := c.client.Do(req)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
@@ -621,11 +1199,12 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 		return nil, err
 	}
 
+	resp.Request = sanitizeRequest(resp.Request)
 	response := newResponse(resp)
 
-	c.rateMu.Lock()
-	c.rateLimits[rateLimitCategory] = response.Rate
-	c.rateMu.Unlock()
+	c.recordRate(response, rateLimitCategory)
+
+	c.warnDeprecation(req, response)
 
 	err = CheckResponse(resp)
 	if err != nil {
@@ -682,6 +1261,76 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return resp, err
 }
 
+// DoStream sends an API request whose response body is a JSON array, and
+// calls fn with each element of the array as it is decoded, so that large
+// responses can be processed without buffering the whole slice in memory.
+// If fn returns an error, decoding stops and that error is returned.
+// The returned Response, including its pagination fields, is populated as by
+// Do, and API errors are reported in the same way.
+func (c *Client) DoStream(ctx context.Context, req *http.Request, fn func(json.RawMessage) error) (*Response, error) {
+	resp, err := c.BareDo(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	defer resp.Body.Close()
+
+	return resp, decodeJSONArray(resp.Body, fn)
+}
+
+// decodeJSONArray reads a JSON array from r and calls fn with each of its
+// elements in turn. An empty body is treated as an empty array.
+func decodeJSONArray(r io.Reader, fn func(json.RawMessage) error) error {
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected JSON array, got %v", tok)
+	}
+
+	for dec.More() {
+		var elem json.RawMessage
+		if err := dec.Decode(&elem); err != nil {
+			return err
+		}
+		if err := fn(elem); err != nil {
+			return err
+		}
+	}
+
+	// Consume the closing bracket.
+	_, err = dec.Token()
+	return err
+}
+
+// recordRate records the rate limit reported by response, unless rate limit
+// tracking is disabled. fallback is the category of the request, used when
+// GitHub does not report the resource it counted against.
+func (c *Client) recordRate(response *Response, fallback rateLimitCategory) {
+	if c.disableRateLimitCheck {
+		return
+	}
+
+	// Prefer the resource GitHub reports the request counted against, and
+	// don't let the rate limits of other resources, such as "graphql",
+	// overwrite the ones tracked here.
+	cat, ok := resourceCategory(response.Resource)
+	if !ok {
+		if response.Resource != "" {
+			return
+		}
+		cat = fallback
+	}
+
+	c.rateMu.Lock()
+	c.rateLimits[cat] = response.Rate
+	c.rateMu.Unlock()
+}
+
 // checkRateLimitBeforeDo does not make any network calls, but uses existing knowledge from
 // current client state in order to quickly check if *RateLimitError can be immediately returned
 // from Client.Do, and if so, returns it so that Client.Do can skip making a network API call unnecessarily.
@@ -695,7 +1344,7 @@ func (c *Client) checkRateLimitBeforeDo(req *http.Request, rateLimitCategory rat
 		resp := &http.Response{
 			Status:     http.StatusText(http.StatusForbidden),
 			StatusCode: http.StatusForbidden,
-			Request:    req,
+			Request:    sanitizeRequest(req),
 			Header:     make(http.Header),
 			Body:       ioutil.NopCloser(strings.NewReader("")),
 		}
@@ -749,9 +1398,13 @@ type ErrorBlock struct {
 }
 
 func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: %d %v %+v",
+	msg := fmt.Sprintf("%v %v: %d %v %+v",
 		r.Response.Request.Method, sanitizeURL(r.Response.Request.URL),
 		r.Response.StatusCode, r.Message, r.Errors)
+	if id := r.Response.Header.Get(headerRequestID); id != "" {
+		msg += fmt.Sprintf(" (request ID: %v)", id)
+	}
+	return msg
 }
 
 // Is returns whether the provided error equals this error.
@@ -862,7 +1515,9 @@ type AbuseRateLimitError struct {
 	Message  string         `json:"message"` // error message
 
 	// RetryAfter is provided with some abuse rate limit errors. If present,
-	// it is the amount of time that the client should wait before retrying.
+	// it is the amount of time that the client should wait before retrying,
+	// taken from the "Retry-After" header or, failing that, the time until
+	// "X-RateLimit-Reset".
 	// Otherwise, the client should try again later (after an unspecified amount of time).
 	RetryAfter *time.Duration
 }
@@ -885,6 +1540,154 @@ func (r *AbuseRateLimitError) Is(target error) bool {
 		compareHttpResponse(r.Response, v.Response)
 }
 
+// InsufficientScopesError occurs when GitHub returns 403 Forbidden response
+// because the OAuth token used does not have any of the scopes accepted by
+// the endpoint, as reported by the "X-Accepted-OAuth-Scopes" and
+// "X-OAuth-Scopes" headers. It wraps the *ErrorResponse decoded from the
+// response, so errors.As also matches it as an *ErrorResponse.
+type InsufficientScopesError struct {
+	*ErrorResponse
+
+	// AcceptedScopes are the scopes accepted by the endpoint; any one of
+	// them is sufficient.
+	AcceptedScopes []string
+	// ProvidedScopes are the scopes the token used for the request has.
+	ProvidedScopes []string
+}
+
+func (r *InsufficientScopesError) Error() string {
+	return fmt.Sprintf("%v; token has scopes %q, endpoint accepts %q",
+		r.ErrorResponse.Error(), r.ProvidedScopes, r.AcceptedScopes)
+}
+
+// Unwrap returns the *ErrorResponse of the 403 Forbidden response.
+func (r *InsufficientScopesError) Unwrap() error { return r.ErrorResponse }
+
+// Is returns whether the provided error equals this error.
+func (r *InsufficientScopesError) Is(target error) bool {
+	v, ok := target.(*InsufficientScopesError)
+	if !ok {
+		return false
+	}
+
+	return r.ErrorResponse.Is(v.ErrorResponse) &&
+		reflect.DeepEqual(r.AcceptedScopes, v.AcceptedScopes) &&
+		reflect.DeepEqual(r.ProvidedScopes, v.ProvidedScopes)
+}
+
+// SSOError occurs when GitHub returns 403 Forbidden because the token used
+// has not been authorized for an organization that enforces SAML single
+// sign-on, as reported by the "X-GitHub-SSO" header. It wraps the
+// *ErrorResponse decoded from the response, so errors.As also matches it as
+// an *ErrorResponse.
+type SSOError struct {
+	*ErrorResponse
+
+	// AuthorizationURL is the URL the user can visit to authorize the token
+	// for the organization. It is empty if GitHub didn't provide one.
+	AuthorizationURL string
+}
+
+func (r *SSOError) Error() string {
+	if r.AuthorizationURL == "" {
+		return r.ErrorResponse.Error()
+	}
+	return fmt.Sprintf("%v; authorize the token for SAML SSO at %v", r.ErrorResponse.Error(), r.AuthorizationURL)
+}
+
+// Unwrap returns the *ErrorResponse of the 403 Forbidden response.
+func (r *SSOError) Unwrap() error { return r.ErrorResponse }
+
+// Is returns whether the provided error equals this error.
+func (r *SSOError) Is(target error) bool {
+	v, ok := target.(*SSOError)
+	if !ok {
+		return false
+	}
+
+	return r.ErrorResponse.Is(v.ErrorResponse) &&
+		r.AuthorizationURL == v.AuthorizationURL
+}
+
+// parseSSORequired reports whether r says that the token used must be
+// authorized for SAML SSO, and returns the authorization URL it carries.
+// The header has the form "required; url=<authorization URL>".
+func parseSSORequired(r *http.Response) (authorizationURL string, ok bool) {
+	parts := strings.Split(r.Header.Get(headerSSO), ";")
+	if strings.TrimSpace(parts[0]) != "required" {
+		return "", false
+	}
+	for _, part := range parts[1:] {
+		if v := strings.TrimSpace(part); strings.HasPrefix(v, "url=") {
+			return strings.TrimPrefix(v, "url="), true
+		}
+	}
+	return "", true
+}
+
+// parseInsufficientScopes reports whether r says that the token used lacks
+// the scopes accepted by the endpoint. It also returns the accepted and
+// provided scopes. Both scope headers must be present, since GitHub omits
+// them for requests that are not authenticated with an OAuth token.
+func parseInsufficientScopes(r *http.Response) (accepted, provided []string, ok bool) {
+	acceptedHeader, hasAccepted := r.Header[http.CanonicalHeaderKey(headerAcceptedOAuthScopes)]
+	providedHeader, hasProvided := r.Header[http.CanonicalHeaderKey(headerOAuthScopes)]
+	if !hasAccepted || !hasProvided {
+		return nil, nil, false
+	}
+
+	accepted = splitScopes(strings.Join(acceptedHeader, ","))
+	provided = splitScopes(strings.Join(providedHeader, ","))
+	if len(accepted) == 0 {
+		return nil, nil, false
+	}
+	for _, a := range accepted {
+		for _, p := range provided {
+			if a == p {
+				return nil, nil, false
+			}
+		}
+	}
+	return accepted, provided, true
+}
+
+// splitScopes splits a comma-separated list of OAuth scopes.
+func splitScopes(s string) []string {
+	var scopes []string
+	for _, scope := range strings.Split(s, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// sensitiveHeaders are the request headers that may carry credentials and
+// are removed by sanitizeRequest.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", headerOTP}
+
+// sanitizeRequest returns a shallow copy of req without credential headers,
+// URL user info or client_secret, suitable for retaining on a Response.
+// req itself is not modified.
+func sanitizeRequest(req *http.Request) *http.Request {
+	if req == nil {
+		return nil
+	}
+
+	r := new(http.Request)
+	*r = *req
+	r.Header = req.Header.Clone()
+	for _, h := range sensitiveHeaders {
+		r.Header.Del(h)
+	}
+	if req.URL != nil {
+		u := *req.URL
+		u.User = nil
+		r.URL = sanitizeURL(&u)
+	}
+	return r
+}
+
 // sanitizeURL redacts the client_secret parameter from the URL which may be
 // exposed to the user.
 func sanitizeURL(uri *url.URL) *url.URL {
@@ -949,6 +1752,7 @@ func (e *Error) UnmarshalJSON(data []byte) error {
 //
 // The error type will be *RateLimitError for rate limit exceeded errors,
 // *AcceptedError for 202 Accepted status codes,
+// *InsufficientScopesError for OAuth tokens lacking a required scope,
 // and *TwoFactorAuthError for two-factor authentication errors.
 func CheckResponse(r *http.Response) error {
 	if r.StatusCode == http.StatusAccepted {
@@ -980,15 +1784,24 @@ func CheckResponse(r *http.Response) error {
 			Response: errorResponse.Response,
 			Message:  errorResponse.Message,
 		}
-		if v := r.Header["Retry-After"]; len(v) > 0 {
-			// According to GitHub support, the "Retry-After" header value will be
-			// an integer which represents the number of seconds that one should
-			// wait before resuming making requests.
-			retryAfterSeconds, _ := strconv.ParseInt(v[0], 10, 64) // Error handling is noop.
-			retryAfter := time.Duration(retryAfterSeconds) * time.Second
-			abuseRateLimitError.RetryAfter = &retryAfter
-		}
+		abuseRateLimitError.RetryAfter = parseAbuseRetryAfter(r)
 		return abuseRateLimitError
+	case r.StatusCode == http.StatusForbidden:
+		if authorizationURL, ok := parseSSORequired(r); ok {
+			return &SSOError{
+				ErrorResponse:    errorResponse,
+				AuthorizationURL: authorizationURL,
+			}
+		}
+		accepted, provided, ok := parseInsufficientScopes(r)
+		if !ok {
+			return errorResponse
+		}
+		return &InsufficientScopesError{
+			ErrorResponse:  errorResponse,
+			AcceptedScopes: accepted,
+			ProvidedScopes: provided,
+		}
 	default:
 		return errorResponse
 	}
@@ -1069,6 +1882,25 @@ func category(path string) rateLimitCategory {
 	}
 }
 
+// categoryResources holds the name of the resource, as reported by the
+// X-RateLimit-Resource header, of each rate limit category.
+var categoryResources = [categories]string{
+	coreCategory:   "core",
+	searchCategory: "search",
+}
+
+// resourceCategory returns the rate limit category of a resource reported by
+// the X-RateLimit-Resource header, and whether the resource is tracked.
+func resourceCategory(resource string) (rateLimitCategory, bool) {
+	switch resource {
+	case "core":
+		return coreCategory, true
+	case "search":
+		return searchCategory, true
+	}
+	return 0, false
+}
+
 // RateLimits returns the rate limits for the current client.
 func (c *Client) RateLimits(ctx context.Context) (*RateLimits, *Response, error) {
 	req, err := c.NewRequest("GET", "rate_limit", nil)
@@ -1087,7 +1919,7 @@ func (c *Client) RateLimits(ctx context.Context) (*RateLimits, *Response, error)
 		return nil, resp, err
 	}
 
-	if response.Resources != nil {
+	if response.Resources != nil && !c.disableRateLimitCheck {
 		c.rateMu.Lock()
 		if response.Resources.Core != nil {
 			c.rateLimits[coreCategory] = *response.Resources.Core
@@ -1101,6 +1933,25 @@ func (c *Client) RateLimits(ctx context.Context) (*RateLimits, *Response, error)
 	return response.Resources, resp, nil
 }
 
+// LastRateLimits returns the most recently observed rate limits of the client,
+// keyed by resource name such as "core" or "search", without making a network
+// call. They are taken from the rate limit headers of API responses and from
+// RateLimits, whichever was received last. Resources that no response has
+// reported yet are omitted, as are all of them if DisableRateLimitCheck is set.
+func (c *Client) LastRateLimits() map[string]Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+
+	limits := make(map[string]Rate)
+	for cat, rate := range c.rateLimits {
+		if rate == (Rate{}) {
+			continue
+		}
+		limits[categoryResources[cat]] = rate
+	}
+	return limits
+}
+
 func setCredentialsAsHeaders(req *http.Request, id, secret string) *http.Request {
 	// To set extra headers, we must make a copy of the Request so
 	// that we don't modify the Request we were given. This is required by the
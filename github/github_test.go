@@ -6,11 +6,13 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -18,6 +20,7 @@ import (
 	"path"
 	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -439,6 +442,208 @@ func TestNewEnterpriseClient_URLIsNotAProperAPISubdomain_addsEnterpriseSuffix(t
 	}
 }
 
+func TestClient_WithEnterpriseURLs(t *testing.T) {
+	tests := []struct {
+		name                 string
+		baseURL, uploadURL   string
+		wantBase, wantUpload string
+	}{
+		{
+			name:       "root host",
+			baseURL:    "https://ghe.example.com",
+			uploadURL:  "https://ghe.example.com",
+			wantBase:   "https://ghe.example.com/api/v3/",
+			wantUpload: "https://ghe.example.com/api/uploads/",
+		},
+		{
+			name:       "fully specified",
+			baseURL:    "https://ghe.example.com/api/v3/",
+			uploadURL:  "https://ghe.example.com/api/uploads/",
+			wantBase:   "https://ghe.example.com/api/v3/",
+			wantUpload: "https://ghe.example.com/api/uploads/",
+		},
+		{
+			name:       "fully specified without trailing slash",
+			baseURL:    "https://ghe.example.com/api/v3",
+			uploadURL:  "https://ghe.example.com/api/uploads",
+			wantBase:   "https://ghe.example.com/api/v3/",
+			wantUpload: "https://ghe.example.com/api/uploads/",
+		},
+		{
+			name:       "API host",
+			baseURL:    "https://api.ghe.example.com",
+			uploadURL:  "https://ghe.example.com",
+			wantBase:   "https://api.ghe.example.com/",
+			wantUpload: "https://ghe.example.com/api/uploads/",
+		},
+		{
+			name:       "API subdomain",
+			baseURL:    "https://ghe.api.example.com/",
+			uploadURL:  "https://ghe.example.com/",
+			wantBase:   "https://ghe.api.example.com/",
+			wantUpload: "https://ghe.example.com/api/uploads/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClient(nil).WithEnterpriseURLs(tt.baseURL, tt.uploadURL)
+			if err != nil {
+				t.Fatalf("WithEnterpriseURLs returned unexpected error: %v", err)
+			}
+			if got := c.BaseURL.String(); got != tt.wantBase {
+				t.Errorf("WithEnterpriseURLs BaseURL is %v, want %v", got, tt.wantBase)
+			}
+			if got := c.UploadURL.String(); got != tt.wantUpload {
+				t.Errorf("WithEnterpriseURLs UploadURL is %v, want %v", got, tt.wantUpload)
+			}
+		})
+	}
+}
+
+func TestClient_WithEnterpriseURLs_invalidURL(t *testing.T) {
+	c := NewClient(nil)
+	for _, urls := range [][2]string{
+		{"bogus\nbase\nURL", "https://ghe.example.com/"},
+		{"https://ghe.example.com/", "bogus\nupload\nURL"},
+	} {
+		if _, err := c.WithEnterpriseURLs(urls[0], urls[1]); err == nil {
+			t.Errorf("WithEnterpriseURLs(%q, %q) returned nil error, want error", urls[0], urls[1])
+		}
+		if got, want := c.BaseURL.String(), defaultBaseURL; got != want {
+			t.Errorf("WithEnterpriseURLs modified BaseURL to %v, want %v", got, want)
+		}
+		if got, want := c.UploadURL.String(), uploadBaseURL; got != want {
+			t.Errorf("WithEnterpriseURLs modified UploadURL to %v, want %v", got, want)
+		}
+	}
+}
+
+func TestClient_WithEnterpriseCloudDataResidency(t *testing.T) {
+	c, err := NewClient(nil).WithEnterpriseCloudDataResidency("octo-corp")
+	if err != nil {
+		t.Fatalf("WithEnterpriseCloudDataResidency returned unexpected error: %v", err)
+	}
+	if got, want := c.BaseURL.String(), "https://api.octo-corp.ghe.com/"; got != want {
+		t.Errorf("WithEnterpriseCloudDataResidency BaseURL is %v, want %v", got, want)
+	}
+	if got, want := c.UploadURL.String(), "https://uploads.octo-corp.ghe.com/"; got != want {
+		t.Errorf("WithEnterpriseCloudDataResidency UploadURL is %v, want %v", got, want)
+	}
+
+	req, err := c.NewRequest("GET", "user", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned unexpected error: %v", err)
+	}
+	if got, want := req.URL.String(), "https://api.octo-corp.ghe.com/user"; got != want {
+		t.Errorf("NewRequest URL is %v, want %v", got, want)
+	}
+}
+
+func TestClient_WithEnterpriseCloudDataResidency_invalidTenant(t *testing.T) {
+	c := NewClient(nil)
+	for _, tenant := range []string{"", "-octo", "octo-", "Octo", "octo.corp", "octo/corp", strings.Repeat("a", 64)} {
+		if _, err := c.WithEnterpriseCloudDataResidency(tenant); err == nil {
+			t.Errorf("WithEnterpriseCloudDataResidency(%q) returned nil error, want error", tenant)
+		}
+		if got, want := c.BaseURL.String(), defaultBaseURL; got != want {
+			t.Errorf("WithEnterpriseCloudDataResidency modified BaseURL to %v, want %v", got, want)
+		}
+	}
+}
+
+func TestClient_WithUserAgent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	c, err := client.WithUserAgent("my-app", "1.2.3")
+	if err != nil {
+		t.Fatalf("WithUserAgent returned unexpected error: %v", err)
+	}
+	if c != client {
+		t.Error("WithUserAgent returned a different client")
+	}
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "User-Agent", "my-app/1.2.3 go-github")
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Users.Get(ctx, ""); err != nil {
+		t.Fatalf("Users.Get returned error: %v", err)
+	}
+
+	if _, err := client.WithUserAgent("my-app", ""); err != nil {
+		t.Fatalf("WithUserAgent returned unexpected error: %v", err)
+	}
+	if got, want := client.UserAgent, "my-app go-github"; got != want {
+		t.Errorf("WithUserAgent set UserAgent to %q, want %q", got, want)
+	}
+}
+
+func TestClient_WithUserAgent_invalid(t *testing.T) {
+	c := NewClient(nil)
+	for _, tt := range []struct{ product, version string }{
+		{"", "1.0"},
+		{"my app", "1.0"},
+		{"my-app\r\nX-Injected: 1", ""},
+		{"my/app", "1.0"},
+		{"my-app", "1.0 (linux)"},
+		{"my-app", "1/0"},
+	} {
+		if _, err := c.WithUserAgent(tt.product, tt.version); err == nil {
+			t.Errorf("WithUserAgent(%q, %q) returned nil error, want error", tt.product, tt.version)
+		}
+		if got, want := c.UserAgent, userAgent; got != want {
+			t.Errorf("WithUserAgent modified UserAgent to %q, want %q", got, want)
+		}
+	}
+}
+
+func TestClient_SetClampPerPage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var wantPerPage string
+	mux.HandleFunc("/repos/o/r/forks", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"per_page": wantPerPage, "page": "2"})
+		fmt.Fprint(w, `[]`)
+	})
+
+	opt := &RepositoryListForksOptions{ListOptions: ListOptions{Page: 2, PerPage: 500}}
+	ctx := context.Background()
+
+	wantPerPage = "500"
+	if _, _, err := client.Repositories.ListForks(ctx, "o", "r", opt); err != nil {
+		t.Fatalf("Repositories.ListForks returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	client.Logger = log.New(&buf, "", 0)
+	client.SetClampPerPage(true)
+	wantPerPage = "100"
+	if _, _, err := client.Repositories.ListForks(ctx, "o", "r", opt); err != nil {
+		t.Fatalf("Repositories.ListForks returned error: %v", err)
+	}
+	if got, want := buf.String(), "go-github: clamped per_page=500 to 100 for "+baseURLPath+"/repos/o/r/forks\n"; got != want {
+		t.Errorf("Logger output is %q, want %q", got, want)
+	}
+
+	opt.PerPage = 50
+	wantPerPage = "50"
+	if _, _, err := client.Repositories.ListForks(ctx, "o", "r", opt); err != nil {
+		t.Fatalf("Repositories.ListForks returned error: %v", err)
+	}
+
+	client.SetClampPerPage(false)
+	opt.PerPage = 500
+	wantPerPage = "500"
+	if _, _, err := client.Repositories.ListForks(ctx, "o", "r", opt); err != nil {
+		t.Fatalf("Repositories.ListForks returned error: %v", err)
+	}
+}
+
 // Ensure that length of Client.rateLimits is the same as number of fields in RateLimits struct.
 func TestClient_rateLimits(t *testing.T) {
 	if got, want := len(Client{}.rateLimits), reflect.TypeOf(RateLimits{}).NumField(); got != want {
@@ -660,6 +865,53 @@ func TestResponse_cursorPagination(t *testing.T) {
 	if got, want := response.Cursor, "v1_12345678"; got != want {
 		t.Errorf("response.Cursor: %v, want %v", got, want)
 	}
+
+	// cursor-based pagination with "after" param
+	r = http.Response{
+		Header: http.Header{
+			"Link": {
+				`<https://api.github.com/?after=Y3Vyc29yOjE%3D&per_page=2>; rel="next"`,
+			},
+		},
+	}
+
+	response = newResponse(&r)
+	if got, want := response.After, "Y3Vyc29yOjE="; got != want {
+		t.Errorf("response.After: %v, want %v", got, want)
+	}
+}
+
+func TestResponse_links(t *testing.T) {
+	r := http.Response{
+		Header: http.Header{
+			"Link": {`<https://api.github.com/?cursor=v1_2>; rel="next",` +
+				` <https://api.github.com/?cursor=v1_0>; rel="prev",` +
+				` <https://api.github.com/?page=1>; rel="first"`,
+			},
+		},
+	}
+
+	response := newResponse(&r)
+	want := map[string]string{
+		"next":  "https://api.github.com/?cursor=v1_2",
+		"prev":  "https://api.github.com/?cursor=v1_0",
+		"first": "https://api.github.com/?page=1",
+	}
+	if !cmp.Equal(response.Links, want) {
+		t.Errorf("response.Links: %v, want %v", response.Links, want)
+	}
+	if got, want := response.Cursor, "v1_2"; got != want {
+		t.Errorf("response.Cursor: %v, want %v", got, want)
+	}
+	if got, want := response.FirstPage, 1; got != want {
+		t.Errorf("response.FirstPage: %v, want %v", got, want)
+	}
+
+	// no Link header
+	response = newResponse(&http.Response{Header: http.Header{}})
+	if response.Links != nil {
+		t.Errorf("response.Links: %v, want nil", response.Links)
+	}
 }
 
 func TestResponse_populatePageValues_invalid(t *testing.T) {
@@ -780,6 +1032,98 @@ func TestDo_redirectLoop(t *testing.T) {
 	}
 }
 
+func TestRunBounded(t *testing.T) {
+	var inFlight, maxInFlight int32
+	errs := runBounded(context.Background(), 10, 3, func(i int) error {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		if i == 4 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	if maxInFlight > 3 {
+		t.Errorf("runBounded had %v calls in flight, want at most 3", maxInFlight)
+	}
+	if len(errs) != 1 || errs[4] == nil {
+		t.Errorf("runBounded returned errors %v, want one for index 4", errs)
+	}
+}
+
+func TestRunBounded_rateLimit(t *testing.T) {
+	rateErr := &RateLimitError{Message: "API rate limit exceeded"}
+	var calls int32
+	errs := runBounded(context.Background(), 5, 1, func(i int) error {
+		atomic.AddInt32(&calls, 1)
+		if i == 1 {
+			return rateErr
+		}
+		return nil
+	})
+
+	if calls != 2 {
+		t.Errorf("runBounded made %v calls, want 2", calls)
+	}
+	want := map[int]error{1: rateErr, 2: rateErr, 3: rateErr, 4: rateErr}
+	if !cmp.Equal(errs, want) {
+		t.Errorf("runBounded returned errors %v, want %v", errs, want)
+	}
+}
+
+// authTransport adds an Authorization header to every request in RoundTrip,
+// the way oauth2.Transport and BasicAuthTransport do.
+func authTransport() http.RoundTripper {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		r = r.Clone(r.Context())
+		r.Header.Set("Authorization", "token t")
+		return http.DefaultTransport.RoundTrip(r)
+	})
+}
+
+// testNoAuthorization fails t if r carries an Authorization header.
+func testNoAuthorization(t *testing.T, r *http.Request) {
+	t.Helper()
+	if got := r.Header.Get("Authorization"); got != "" {
+		t.Errorf("Authorization header = %q at %v, want none", got, r.URL.Path)
+	}
+}
+
+func TestRedirectClient(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var storageHits int
+	mux.HandleFunc("/a", func(w http.ResponseWriter, r *http.Request) {
+		storageHits++
+		testNoAuthorization(t, r)
+		http.Redirect(w, r, baseURLPath+"/b", http.StatusFound)
+	})
+	mux.HandleFunc("/b", func(w http.ResponseWriter, r *http.Request) {
+		storageHits++
+		testNoAuthorization(t, r)
+	})
+
+	client.client.Transport = authTransport()
+
+	req, _ := http.NewRequest("GET", client.BaseURL.String()+"a", nil)
+	resp, err := redirectClient().Do(req)
+	if err != nil {
+		t.Fatalf("redirectClient().Do returned error: %v", err)
+	}
+	resp.Body.Close()
+	if storageHits != 2 {
+		t.Errorf("redirectClient made %d requests to the storage server, want 2", storageHits)
+	}
+}
+
 // Test that an error caused by the internal http client's Do() function
 // does not leak the client secret.
 func TestDo_sanitizeURL(t *testing.T) {
@@ -803,6 +1147,49 @@ func TestDo_sanitizeURL(t *testing.T) {
 	}
 }
 
+func TestDo_withRequestHeader(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			testHeader(t, r, "X-Github-Next-Global-Id", "1")
+			if got, want := r.Header.Values("X-Custom"), []string{"a", "b"}; !cmp.Equal(got, want) {
+				t.Errorf("X-Custom headers are %v, want %v", got, want)
+			}
+			testHeader(t, r, "Accept", mediaTypeV3)
+			testHeader(t, r, "User-Agent", client.UserAgent)
+		default:
+			if got := r.Header.Get("X-Github-Next-Global-Id"); got != "" {
+				t.Errorf("X-GitHub-Next-Global-ID header is %q on a later call, want none", got)
+			}
+		}
+	})
+
+	ctx := context.Background()
+	headerCtx := WithRequestHeader(ctx, "X-GitHub-Next-Global-ID", "1")
+	headerCtx = WithRequestHeader(headerCtx, "X-Custom", "a")
+	headerCtx = WithRequestHeader(headerCtx, "X-Custom", "b")
+	headerCtx = WithRequestHeader(headerCtx, "Accept", "text/plain")
+	headerCtx = WithRequestHeader(headerCtx, "User-Agent", "other")
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(headerCtx, req, nil); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+	if got := req.Header.Get("X-Github-Next-Global-Id"); got != "" {
+		t.Errorf("Do modified the request headers, got X-GitHub-Next-Global-ID %q", got)
+	}
+
+	req, _ = client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+}
+
 func TestDo_rateLimit(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -831,6 +1218,250 @@ func TestDo_rateLimit(t *testing.T) {
 	}
 }
 
+func TestDo_rateLimitResource(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/repositories", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "30")
+		w.Header().Set(headerRateRemaining, "29")
+		w.Header().Set(headerRateReset, "1372700873")
+		w.Header().Set(headerRateResource, "search")
+		fmt.Fprint(w, `{"total_count":0}`)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Search.Repositories(ctx, "q", nil)
+	if err != nil {
+		t.Fatalf("Search.Repositories returned error: %v", err)
+	}
+	if got, want := resp.Resource, "search"; got != want {
+		t.Errorf("Response resource = %v, want %v", got, want)
+	}
+	want := Rate{Limit: 30, Remaining: 29, Reset: Timestamp{time.Date(2013, time.July, 1, 17, 47, 53, 0, time.UTC).Local()}}
+	if !cmp.Equal(resp.Rate, want) {
+		t.Errorf("Response rate = %v, want %v", resp.Rate, want)
+	}
+
+	client.rateMu.Lock()
+	search, core := client.rateLimits[searchCategory], client.rateLimits[coreCategory]
+	client.rateMu.Unlock()
+	if !cmp.Equal(search, want) {
+		t.Errorf("Client search rate = %v, want %v", search, want)
+	}
+	if !cmp.Equal(core, Rate{}) {
+		t.Errorf("Client core rate = %v, want zero value", core)
+	}
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "4999")
+		w.Header().Set(headerRateResource, "graphql")
+	})
+	req, _ := client.NewRequest("POST", "graphql", nil)
+	if resp, err = client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if got, want := resp.Resource, "graphql"; got != want {
+		t.Errorf("Response resource = %v, want %v", got, want)
+	}
+	client.rateMu.Lock()
+	core = client.rateLimits[coreCategory]
+	client.rateMu.Unlock()
+	if !cmp.Equal(core, Rate{}) {
+		t.Errorf("Client core rate = %v after a graphql request, want zero value", core)
+	}
+}
+
+// tokenLimiter is a Limiter that lets one request through for each token
+// sent on its channel.
+type tokenLimiter struct {
+	tokens chan struct{}
+	waits  int32
+}
+
+func (l *tokenLimiter) Wait(ctx context.Context) error {
+	atomic.AddInt32(&l.waits, 1)
+	select {
+	case <-l.tokens:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestDo_sharedLimiter(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var hits int32
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+	})
+
+	limiter := &tokenLimiter{tokens: make(chan struct{})}
+	client.WithLimiter(limiter)
+	client2 := NewClient(nil).WithLimiter(limiter)
+	client2.BaseURL = client.BaseURL
+
+	ctx := context.Background()
+	done := make(chan error)
+	for _, c := range []*Client{client, client2} {
+		go func(c *Client) {
+			req, _ := c.NewRequest("GET", ".", nil)
+			_, err := c.Do(ctx, req, nil)
+			done <- err
+		}(c)
+	}
+
+	limiter.tokens <- struct{}{}
+	if err := <-done; err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt32(&hits); got != 1 {
+		t.Errorf("server hits after one token = %v, want 1", got)
+	}
+
+	limiter.tokens <- struct{}{}
+	if err := <-done; err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt32(&hits); got != 2 {
+		t.Errorf("server hits after two tokens = %v, want 2", got)
+	}
+}
+
+func TestDo_limiterCanceledContext(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request was sent despite the limiter refusing it")
+	})
+
+	client.WithLimiter(&tokenLimiter{tokens: make(chan struct{})})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(ctx, req, nil); err != context.Canceled {
+		t.Errorf("Do returned error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestDo_limiterAfterRateLimitReset(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	limiter := &tokenLimiter{tokens: make(chan struct{})}
+	client.WithLimiter(limiter)
+	client.rateLimits[coreCategory].Reset.Time = time.Now().Add(time.Minute)
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx := context.Background()
+	_, err := client.Do(ctx, req, nil)
+	if _, ok := err.(*RateLimitError); !ok {
+		t.Errorf("Do returned error %#v, want *RateLimitError", err)
+	}
+	if got := atomic.LoadInt32(&limiter.waits); got != 0 {
+		t.Errorf("Limiter.Wait called %v times, want 0", got)
+	}
+}
+
+func TestDo_sunset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerSunset, "Wed, 11 Nov 2020 23:59:59 GMT")
+		w.Header().Set(headerDeprecation, "true")
+	})
+
+	var buf bytes.Buffer
+	client.Logger = log.New(&buf, "", 0)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		req, _ := client.NewRequest("GET", "a", nil)
+		resp, err := client.Do(ctx, req, nil)
+		if err != nil {
+			t.Fatalf("Do returned unexpected error: %v", err)
+		}
+		if !resp.Deprecated {
+			t.Error("Response.Deprecated = false, want true")
+		}
+		if want := time.Date(2020, time.November, 11, 23, 59, 59, 0, time.UTC); !resp.Sunset.Equal(want) {
+			t.Errorf("Response.Sunset = %v, want %v", resp.Sunset, want)
+		}
+	}
+
+	want := "go-github: GET /api-v3/a is deprecated and will be removed after Wed, 11 Nov 2020 23:59:59 UTC\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Logger output = %q, want %q", got, want)
+	}
+}
+
+func TestDo_deprecationWarnedEndpoints(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerDeprecation, "true")
+	})
+
+	var buf bytes.Buffer
+	client.Logger = log.New(&buf, "", 0)
+
+	ctx := context.Background()
+	for _, u := range []string{"repos/o/r/issues/1", "repos/o/r/issues/2"} {
+		req, _ := client.NewRequest("GET", u, nil)
+		if _, err := client.Do(ctx, req, nil); err != nil {
+			t.Fatalf("Do returned unexpected error: %v", err)
+		}
+	}
+
+	want := "go-github: GET /api-v3/repos/o/r/issues/{id} is deprecated\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Logger output = %q, want %q", got, want)
+	}
+
+	for i := 0; i < 2*maxDeprecationWarnings; i++ {
+		req, _ := client.NewRequest("GET", fmt.Sprintf("r%d", i), nil)
+		if _, err := client.Do(ctx, req, nil); err != nil {
+			t.Fatalf("Do returned unexpected error: %v", err)
+		}
+	}
+	if got := len(client.deprecationWarned); got > maxDeprecationWarnings {
+		t.Errorf("Client remembers %d deprecated endpoints, want at most %d", got, maxDeprecationWarnings)
+	}
+}
+
+func TestDo_noSunset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {})
+
+	var buf bytes.Buffer
+	client.Logger = log.New(&buf, "", 0)
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx := context.Background()
+	resp, err := client.Do(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+	if resp.Deprecated {
+		t.Error("Response.Deprecated = true, want false")
+	}
+	if !resp.Sunset.IsZero() {
+		t.Errorf("Response.Sunset = %v, want zero time", resp.Sunset)
+	}
+	if got := buf.String(); got != "" {
+		t.Errorf("Logger output = %q, want empty", got)
+	}
+}
+
 // ensure rate limit is still parsed, even for error responses
 func TestDo_rateLimit_errorResponse(t *testing.T) {
 	client, mux, _, teardown := setup()
@@ -959,6 +1590,56 @@ func TestDo_rateLimit_noNetworkCall(t *testing.T) {
 	}
 }
 
+// Ensure no pre-flight rate limit check is made when it is disabled.
+func TestDo_rateLimit_disabled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.DisableRateLimitCheck(true)
+
+	reset := time.Now().UTC().Add(time.Minute).Round(time.Second)
+
+	mux.HandleFunc("/first", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, fmt.Sprint(reset.Unix()))
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprintln(w, `{"message":"API rate limit exceeded for xxx.xxx.xxx.xxx."}`)
+	})
+
+	madeNetworkCall := false
+	mux.HandleFunc("/second", func(w http.ResponseWriter, r *http.Request) {
+		madeNetworkCall = true
+	})
+
+	req, _ := client.NewRequest("GET", "first", nil)
+	ctx := context.Background()
+	resp, err := client.Do(ctx, req, nil)
+	if _, ok := err.(*RateLimitError); !ok {
+		t.Fatalf("Expected a *RateLimitError error; got %#v.", err)
+	}
+	if got, want := resp.Rate.Remaining, 0; got != want {
+		t.Errorf("Response.Rate.Remaining = %v, want %v", got, want)
+	}
+	if got := resp.Rate.Reset.UTC(); got != reset {
+		t.Errorf("Response.Rate.Reset = %v, want %v", got, reset)
+	}
+
+	req, _ = client.NewRequest("GET", "second", nil)
+	if _, err = client.Do(ctx, req, nil); err != nil {
+		t.Errorf("Do returned unexpected error: %v", err)
+	}
+	if !madeNetworkCall {
+		t.Error("Network call was not made, even though the rate limit check is disabled.")
+	}
+
+	client.rateMu.Lock()
+	rate := client.rateLimits[coreCategory]
+	client.rateMu.Unlock()
+	if rate != (Rate{}) {
+		t.Errorf("Client recorded rate %+v, want none", rate)
+	}
+}
+
 // Ensure *AbuseRateLimitError is returned when the response indicates that
 // the client has triggered an abuse detection mechanism.
 func TestDo_rateLimit_abuseRateLimitError(t *testing.T) {
@@ -987,77 +1668,278 @@ func TestDo_rateLimit_abuseRateLimitError(t *testing.T) {
 	if !ok {
 		t.Fatalf("Expected a *AbuseRateLimitError error; got %#v.", err)
 	}
-	if got, want := abuseRateLimitErr.RetryAfter, (*time.Duration)(nil); got != want {
-		t.Errorf("abuseRateLimitErr RetryAfter = %v, want %v", got, want)
+	if got, want := abuseRateLimitErr.RetryAfter, (*time.Duration)(nil); got != want {
+		t.Errorf("abuseRateLimitErr RetryAfter = %v, want %v", got, want)
+	}
+}
+
+// Ensure *AbuseRateLimitError is returned when the response indicates that
+// the client has triggered an abuse detection mechanism on GitHub Enterprise.
+func TestDo_rateLimit_abuseRateLimitErrorEnterprise(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusForbidden)
+		// When the abuse rate limit error is of the "temporarily blocked from content creation" type,
+		// there is no "Retry-After" header.
+		// This response returns a documentation url like the one returned for GitHub Enterprise, this
+		// url changes between versions but follows roughly the same format.
+		fmt.Fprintln(w, `{
+   "message": "You have triggered an abuse detection mechanism and have been temporarily blocked from content creation. Please retry your request again later.",
+   "documentation_url": "https://docs.github.com/en/free-pro-team@latest/rest/overview/resources-in-the-rest-api#abuse-rate-limits"
+}`)
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx := context.Background()
+	_, err := client.Do(ctx, req, nil)
+
+	if err == nil {
+		t.Error("Expected error to be returned.")
+	}
+	abuseRateLimitErr, ok := err.(*AbuseRateLimitError)
+	if !ok {
+		t.Fatalf("Expected a *AbuseRateLimitError error; got %#v.", err)
+	}
+	if got, want := abuseRateLimitErr.RetryAfter, (*time.Duration)(nil); got != want {
+		t.Errorf("abuseRateLimitErr RetryAfter = %v, want %v", got, want)
+	}
+}
+
+// Ensure *AbuseRateLimitError.RetryAfter is parsed correctly.
+func TestDo_rateLimit_abuseRateLimitError_retryAfter(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Retry-After", "123") // Retry after value of 123 seconds.
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprintln(w, `{
+   "message": "You have triggered an abuse detection mechanism ...",
+   "documentation_url": "https://docs.github.com/en/free-pro-team@latest/rest/overview/resources-in-the-rest-api#abuse-rate-limits"
+}`)
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx := context.Background()
+	_, err := client.Do(ctx, req, nil)
+
+	if err == nil {
+		t.Error("Expected error to be returned.")
+	}
+	abuseRateLimitErr, ok := err.(*AbuseRateLimitError)
+	if !ok {
+		t.Fatalf("Expected a *AbuseRateLimitError error; got %#v.", err)
+	}
+	if abuseRateLimitErr.RetryAfter == nil {
+		t.Fatalf("abuseRateLimitErr RetryAfter is nil, expected not-nil")
+	}
+	if got, want := *abuseRateLimitErr.RetryAfter, 123*time.Second; got != want {
+		t.Errorf("abuseRateLimitErr RetryAfter = %v, want %v", got, want)
+	}
+}
+
+func TestParseAbuseRetryAfter(t *testing.T) {
+	future := time.Now().Add(time.Hour).Unix()
+	past := time.Now().Add(-time.Hour).Unix()
+
+	tests := []struct {
+		name     string
+		header   map[string]string
+		min, max time.Duration
+		wantNil  bool
+	}{
+		{
+			name:    "no headers",
+			header:  map[string]string{},
+			wantNil: true,
+		},
+		{
+			name: "both headers prefer Retry-After",
+			header: map[string]string{
+				headerRetryAfter: "30",
+				headerRateReset:  fmt.Sprint(future),
+			},
+			min: 30 * time.Second,
+			max: 30 * time.Second,
+		},
+		{
+			name:   "only reset",
+			header: map[string]string{headerRateReset: fmt.Sprint(future)},
+			min:    59 * time.Minute,
+			max:    time.Hour,
+		},
+		{
+			name: "invalid Retry-After falls back to reset",
+			header: map[string]string{
+				headerRetryAfter: "soon",
+				headerRateReset:  fmt.Sprint(future),
+			},
+			min: 59 * time.Minute,
+			max: time.Hour,
+		},
+		{
+			name:   "reset in the past",
+			header: map[string]string{headerRateReset: fmt.Sprint(past)},
+			min:    time.Second,
+			max:    time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tt.header {
+				header.Set(k, v)
+			}
+			got := parseAbuseRetryAfter(&http.Response{Header: header})
+			if tt.wantNil {
+				if got != nil {
+					t.Errorf("parseAbuseRetryAfter = %v, want nil", *got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("parseAbuseRetryAfter = nil, want non-nil")
+			}
+			if *got < tt.min || *got > tt.max {
+				t.Errorf("parseAbuseRetryAfter = %v, want between %v and %v", *got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+// Ensure *AbuseRateLimitError.RetryAfter falls back to X-RateLimit-Reset.
+func TestDo_rateLimit_abuseRateLimitError_reset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	reset := time.Now().Add(10 * time.Minute)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set(headerRateReset, fmt.Sprint(reset.Unix()))
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprintln(w, `{
+   "message": "You have triggered an abuse detection mechanism ...",
+   "documentation_url": "https://docs.github.com/en/free-pro-team@latest/rest/overview/resources-in-the-rest-api#abuse-rate-limits"
+}`)
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx := context.Background()
+	_, err := client.Do(ctx, req, nil)
+
+	abuseRateLimitErr, ok := err.(*AbuseRateLimitError)
+	if !ok {
+		t.Fatalf("Expected a *AbuseRateLimitError error; got %#v.", err)
+	}
+	if abuseRateLimitErr.RetryAfter == nil {
+		t.Fatalf("abuseRateLimitErr RetryAfter is nil, expected not-nil")
+	}
+	if got := *abuseRateLimitErr.RetryAfter; got <= 9*time.Minute || got > 10*time.Minute {
+		t.Errorf("abuseRateLimitErr RetryAfter = %v, want about 10m", got)
+	}
+}
+
+func TestDoStream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set("Link", `<https://api.github.com/?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"id":1}, {"id":2}, {"id":3}]`)
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx := context.Background()
+	var got []*Repository
+	resp, err := client.DoStream(ctx, req, func(elem json.RawMessage) error {
+		r := new(Repository)
+		if err := json.Unmarshal(elem, r); err != nil {
+			return err
+		}
+		got = append(got, r)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("DoStream returned error: %v", err)
+	}
+
+	want := []*Repository{{ID: Int64(1)}, {ID: Int64(2)}, {ID: Int64(3)}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("DoStream decoded %+v, want %+v", got, want)
+	}
+	if got, want := resp.NextPage, 2; got != want {
+		t.Errorf("DoStream Response.NextPage = %v, want %v", got, want)
+	}
+}
+
+func TestDoStream_callbackError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[1, 2, 3]`)
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx := context.Background()
+	stop := errors.New("stop")
+	calls := 0
+	_, err := client.DoStream(ctx, req, func(elem json.RawMessage) error {
+		calls++
+		if calls == 2 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Errorf("DoStream returned error %v, want %v", err, stop)
+	}
+	if calls != 2 {
+		t.Errorf("DoStream called fn %v times, want 2", calls)
 	}
 }
 
-// Ensure *AbuseRateLimitError is returned when the response indicates that
-// the client has triggered an abuse detection mechanism on GitHub Enterprise.
-func TestDo_rateLimit_abuseRateLimitErrorEnterprise(t *testing.T) {
+func TestDoStream_notArray(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(http.StatusForbidden)
-		// When the abuse rate limit error is of the "temporarily blocked from content creation" type,
-		// there is no "Retry-After" header.
-		// This response returns a documentation url like the one returned for GitHub Enterprise, this
-		// url changes between versions but follows roughly the same format.
-		fmt.Fprintln(w, `{
-   "message": "You have triggered an abuse detection mechanism and have been temporarily blocked from content creation. Please retry your request again later.",
-   "documentation_url": "https://docs.github.com/en/free-pro-team@latest/rest/overview/resources-in-the-rest-api#abuse-rate-limits"
-}`)
+		fmt.Fprint(w, `{"id":1}`)
 	})
 
 	req, _ := client.NewRequest("GET", ".", nil)
 	ctx := context.Background()
-	_, err := client.Do(ctx, req, nil)
-
+	_, err := client.DoStream(ctx, req, func(elem json.RawMessage) error {
+		t.Errorf("DoStream called fn with %s, want no calls", elem)
+		return nil
+	})
 	if err == nil {
-		t.Error("Expected error to be returned.")
-	}
-	abuseRateLimitErr, ok := err.(*AbuseRateLimitError)
-	if !ok {
-		t.Fatalf("Expected a *AbuseRateLimitError error; got %#v.", err)
-	}
-	if got, want := abuseRateLimitErr.RetryAfter, (*time.Duration)(nil); got != want {
-		t.Errorf("abuseRateLimitErr RetryAfter = %v, want %v", got, want)
+		t.Error("DoStream returned no error for a non-array response")
 	}
 }
 
-// Ensure *AbuseRateLimitError.RetryAfter is parsed correctly.
-func TestDo_rateLimit_abuseRateLimitError_retryAfter(t *testing.T) {
+func TestDoStream_httpError(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.Header().Set("Retry-After", "123") // Retry after value of 123 seconds.
-		w.WriteHeader(http.StatusForbidden)
-		fmt.Fprintln(w, `{
-   "message": "You have triggered an abuse detection mechanism ...",
-   "documentation_url": "https://docs.github.com/en/free-pro-team@latest/rest/overview/resources-in-the-rest-api#abuse-rate-limits"
-}`)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
 	})
 
 	req, _ := client.NewRequest("GET", ".", nil)
 	ctx := context.Background()
-	_, err := client.Do(ctx, req, nil)
-
+	resp, err := client.DoStream(ctx, req, func(json.RawMessage) error { return nil })
 	if err == nil {
-		t.Error("Expected error to be returned.")
-	}
-	abuseRateLimitErr, ok := err.(*AbuseRateLimitError)
-	if !ok {
-		t.Fatalf("Expected a *AbuseRateLimitError error; got %#v.", err)
-	}
-	if abuseRateLimitErr.RetryAfter == nil {
-		t.Fatalf("abuseRateLimitErr RetryAfter is nil, expected not-nil")
+		t.Fatal("Expected HTTP 400 error, got no error.")
 	}
-	if got, want := *abuseRateLimitErr.RetryAfter, 123*time.Second; got != want {
-		t.Errorf("abuseRateLimitErr RetryAfter = %v, want %v", got, want)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected HTTP 400 error, got %d status code.", resp.StatusCode)
 	}
 }
 
@@ -1098,6 +1980,69 @@ func TestSanitizeURL(t *testing.T) {
 	}
 }
 
+func TestSanitizeRequest(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://u:p@api.github.com/a?client_secret=secret", nil)
+	req.Header.Set("Authorization", "token t")
+	req.Header.Set(headerOTP, "123456")
+	req.Header.Set("Accept", mediaTypeV3)
+
+	got := sanitizeRequest(req)
+	if want := "https://api.github.com/a?client_secret=REDACTED"; got.URL.String() != want {
+		t.Errorf("sanitizeRequest URL = %v, want %v", got.URL, want)
+	}
+	for _, h := range []string{"Authorization", headerOTP} {
+		if v := got.Header.Get(h); v != "" {
+			t.Errorf("sanitizeRequest kept header %v = %q", h, v)
+		}
+	}
+	if got, want := got.Header.Get("Accept"), mediaTypeV3; got != want {
+		t.Errorf("sanitizeRequest Accept header = %q, want %q", got, want)
+	}
+
+	if got, want := req.Header.Get("Authorization"), "token t"; got != want {
+		t.Errorf("sanitizeRequest modified original Authorization header to %q, want %q", got, want)
+	}
+	if got, want := req.URL.String(), "https://u:p@api.github.com/a?client_secret=secret"; got != want {
+		t.Errorf("sanitizeRequest modified original URL to %v, want %v", got, want)
+	}
+
+	if sanitizeRequest(nil) != nil {
+		t.Error("sanitizeRequest(nil) returned non-nil request")
+	}
+}
+
+func TestDo_errorIncludesSanitizedRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/a", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Bad"}`, http.StatusBadRequest)
+	})
+
+	req, _ := client.NewRequest("GET", "a?client_id=id&client_secret=secret", nil)
+	req.Header.Set("Authorization", "token t")
+	ctx := context.Background()
+	resp, err := client.Do(ctx, req, nil)
+	if err == nil {
+		t.Fatal("Expected HTTP 400 error, got no error.")
+	}
+
+	if got := resp.Request.Header.Get("Authorization"); got != "" {
+		t.Errorf("Response.Request kept Authorization header %q", got)
+	}
+	if got, want := resp.Request.Method, "GET"; got != want {
+		t.Errorf("Response.Request.Method = %v, want %v", got, want)
+	}
+
+	msg := err.Error()
+	if want := "GET " + client.BaseURL.String() + "a?client_id=id&client_secret=REDACTED"; !strings.Contains(msg, want) {
+		t.Errorf("Error() = %q, want it to contain %q", msg, want)
+	}
+	if strings.Contains(msg, "secret=secret") || strings.Contains(msg, "token t") {
+		t.Errorf("Error() = %q leaks credentials", msg)
+	}
+}
+
 func TestCheckResponse(t *testing.T) {
 	res := &http.Response{
 		Request:    &http.Request{},
@@ -1176,6 +2121,150 @@ func TestCheckResponse_AbuseRateLimit(t *testing.T) {
 	}
 }
 
+func TestCheckResponse_InsufficientScopes(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusForbidden,
+		Header: http.Header{
+			"X-Accepted-Oauth-Scopes": {"admin:org, write:org"},
+			"X-Oauth-Scopes":          {"repo, read:org"},
+		},
+		Body: ioutil.NopCloser(strings.NewReader(`{"message":"Resource not accessible by integration"}`)),
+	}
+	err, ok := CheckResponse(res).(*InsufficientScopesError)
+	if !ok {
+		t.Fatalf("Expected *InsufficientScopesError, got %v", CheckResponse(res))
+	}
+
+	want := &InsufficientScopesError{
+		ErrorResponse: &ErrorResponse{
+			Response: res,
+			Message:  "Resource not accessible by integration",
+		},
+		AcceptedScopes: []string{"admin:org", "write:org"},
+		ProvidedScopes: []string{"repo", "read:org"},
+	}
+	if !errors.Is(err, want) {
+		t.Errorf("Error = %#v, want %#v", err, want)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Message != "Resource not accessible by integration" {
+		t.Errorf("errors.As(%v, *ErrorResponse) = %#v, want the 403 *ErrorResponse", err, errResp)
+	}
+}
+
+func TestCheckResponse_InsufficientScopes_scopeProvided(t *testing.T) {
+	tests := map[string]http.Header{
+		"accepted scope provided": {
+			"X-Accepted-Oauth-Scopes": {"repo, public_repo"},
+			"X-Oauth-Scopes":          {"public_repo"},
+		},
+		"no scope required": {
+			"X-Accepted-Oauth-Scopes": {""},
+			"X-Oauth-Scopes":          {""},
+		},
+		"no scope headers": {},
+	}
+
+	for name, header := range tests {
+		t.Run(name, func(t *testing.T) {
+			res := &http.Response{
+				Request:    &http.Request{},
+				StatusCode: http.StatusForbidden,
+				Header:     header,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"message":"m"}`)),
+			}
+			if _, ok := CheckResponse(res).(*ErrorResponse); !ok {
+				t.Errorf("CheckResponse returned %#v, want *ErrorResponse", CheckResponse(res))
+			}
+		})
+	}
+}
+
+func TestCheckResponse_SSORequired(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusForbidden,
+		Header: http.Header{
+			"X-Github-Sso": {"required; url=https://github.com/orgs/o/sso?authorization_request=abc"},
+		},
+		Body: ioutil.NopCloser(strings.NewReader(`{"message":"Resource protected by organization SAML enforcement."}`)),
+	}
+	err, ok := CheckResponse(res).(*SSOError)
+	if !ok {
+		t.Fatalf("Expected *SSOError, got %v", CheckResponse(res))
+	}
+
+	want := &SSOError{
+		ErrorResponse: &ErrorResponse{
+			Response: res,
+			Message:  "Resource protected by organization SAML enforcement.",
+		},
+		AuthorizationURL: "https://github.com/orgs/o/sso?authorization_request=abc",
+	}
+	if !errors.Is(err, want) {
+		t.Errorf("Error = %#v, want %#v", err, want)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Message != "Resource protected by organization SAML enforcement." {
+		t.Errorf("errors.As(%v, *ErrorResponse) = %#v, want the 403 *ErrorResponse", err, errResp)
+	}
+}
+
+func TestSSOError_Error(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{Method: "GET", URL: &url.URL{Path: "/orgs/o"}},
+		StatusCode: http.StatusForbidden,
+	}
+	err := &SSOError{ErrorResponse: &ErrorResponse{Response: res, Message: "m"}}
+	if got, want := err.Error(), "GET /orgs/o: 403 m []"; got != want {
+		t.Errorf("SSOError.Error() = %q, want %q", got, want)
+	}
+
+	err.AuthorizationURL = "https://github.com/orgs/o/sso"
+	if got, want := err.Error(), "GET /orgs/o: 403 m []; authorize the token for SAML SSO at https://github.com/orgs/o/sso"; got != want {
+		t.Errorf("SSOError.Error() = %q, want %q", got, want)
+	}
+}
+
+func TestCheckResponse_SSONotRequired(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusForbidden,
+		Header:     http.Header{"X-Github-Sso": {"partial-results; organizations=1,2"}},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"message":"m"}`)),
+	}
+	if _, ok := CheckResponse(res).(*ErrorResponse); !ok {
+		t.Errorf("CheckResponse returned %#v, want *ErrorResponse", CheckResponse(res))
+	}
+}
+
+func TestDo_SSORequired(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-GitHub-SSO", "required; url=https://github.com/orgs/o/sso?authorization_request=abc")
+		http.Error(w, `{"message":"Resource protected by organization SAML enforcement."}`, http.StatusForbidden)
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx := context.Background()
+	_, err := client.Do(ctx, req, nil)
+	ssoErr, ok := err.(*SSOError)
+	if !ok {
+		t.Fatalf("Do returned error %v, want *SSOError", err)
+	}
+	if got, want := ssoErr.AuthorizationURL, "https://github.com/orgs/o/sso?authorization_request=abc"; got != want {
+		t.Errorf("SSOError.AuthorizationURL = %q, want %q", got, want)
+	}
+	if !strings.Contains(ssoErr.Error(), "authorization_request=abc") {
+		t.Errorf("SSOError.Error() = %q, want it to contain the authorization URL", ssoErr.Error())
+	}
+}
+
 func TestCompareHttpResponse(t *testing.T) {
 	testcases := map[string]struct {
 		h1       *http.Response
@@ -1641,6 +2730,41 @@ func TestErrorResponse_Error(t *testing.T) {
 	}
 }
 
+func TestDo_requestID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRequestID, "ABCD:1234")
+	})
+	mux.HandleFunc("/fail", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRequestID, "EFGH:5678")
+		http.Error(w, `{"message":"Bad"}`, http.StatusBadRequest)
+	})
+
+	ctx := context.Background()
+	req, _ := client.NewRequest("GET", "ok", nil)
+	resp, err := client.Do(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+	if got, want := resp.RequestID, "ABCD:1234"; got != want {
+		t.Errorf("Response.RequestID = %q, want %q", got, want)
+	}
+
+	req, _ = client.NewRequest("GET", "fail", nil)
+	resp, err = client.Do(ctx, req, nil)
+	if err == nil {
+		t.Fatal("Expected HTTP 400 error, got no error.")
+	}
+	if got, want := resp.RequestID, "EFGH:5678"; got != want {
+		t.Errorf("Response.RequestID = %q, want %q", got, want)
+	}
+	if want := "(request ID: EFGH:5678)"; !strings.Contains(err.Error(), want) {
+		t.Errorf("Error() = %q, want it to contain %q", err.Error(), want)
+	}
+}
+
 func TestError_Error(t *testing.T) {
 	err := Error{}
 	if err.Error() == "" {
@@ -1749,6 +2873,74 @@ func TestRateLimits_overQuota(t *testing.T) {
 	}
 }
 
+func TestLastRateLimits(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	if got := client.LastRateLimits(); len(got) != 0 {
+		t.Errorf("LastRateLimits returned %+v before any request, want none", got)
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "59")
+		w.Header().Set(headerRateReset, "1372700873")
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/rate_limit", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"resources":{
+			"core": {"limit":2,"remaining":1,"reset":1372700874},
+			"search": {"limit":3,"remaining":2,"reset":1372700875}
+		}}`)
+	})
+
+	ctx := context.Background()
+	req, _ := client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	want := map[string]Rate{
+		"core": {Limit: 60, Remaining: 59, Reset: Timestamp{time.Unix(1372700873, 0)}},
+	}
+	if got := client.LastRateLimits(); !cmp.Equal(got, want) {
+		t.Errorf("LastRateLimits returned %+v after Do, want %+v", got, want)
+	}
+
+	if _, _, err := client.RateLimits(ctx); err != nil {
+		t.Fatalf("RateLimits returned error: %v", err)
+	}
+
+	want = map[string]Rate{
+		"core":   {Limit: 2, Remaining: 1, Reset: Timestamp{time.Unix(1372700874, 0)}},
+		"search": {Limit: 3, Remaining: 2, Reset: Timestamp{time.Unix(1372700875, 0)}},
+	}
+	if got := client.LastRateLimits(); !cmp.Equal(got, want) {
+		t.Errorf("LastRateLimits returned %+v after RateLimits, want %+v", got, want)
+	}
+}
+
+func TestLastRateLimits_disabled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	client.DisableRateLimitCheck(true)
+	mux.HandleFunc("/rate_limit", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "59")
+		w.Header().Set(headerRateReset, "1372700873")
+		fmt.Fprint(w, `{"resources":{"core": {"limit":2,"remaining":1,"reset":1372700874}}}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.RateLimits(ctx); err != nil {
+		t.Fatalf("RateLimits returned error: %v", err)
+	}
+	if got := client.LastRateLimits(); len(got) != 0 {
+		t.Errorf("LastRateLimits returned %+v, want none", got)
+	}
+}
+
 func TestSetCredentialsAsHeaders(t *testing.T) {
 	req := new(http.Request)
 	id, secret := "id", "secret"
@@ -2001,6 +3193,42 @@ func TestAddOptions_QueryValues(t *testing.T) {
 	}
 }
 
+func TestAddOptions_timeValues(t *testing.T) {
+	type embedded struct {
+		Until time.Time `url:"until"`
+	}
+	zero := time.Time{}
+	nonUTC := time.Date(2021, time.March, 4, 10, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		opts interface{}
+		want string
+	}{
+		{&IssueListByRepoOptions{Since: zero}, ""},
+		{&IssueListByRepoOptions{Since: nonUTC}, "since=2021-03-04T09%3A30%3A00Z"},
+		{&NotificationListOptions{Since: nonUTC, Before: zero}, "since=2021-03-04T09%3A30%3A00Z"},
+		{&CommitsListOptions{Since: nonUTC, Until: nonUTC.Add(time.Hour)}, "since=2021-03-04T09%3A30%3A00Z&until=2021-03-04T10%3A30%3A00Z"},
+		{&IssueListCommentsOptions{Since: &zero}, ""},
+		{&IssueListCommentsOptions{Since: &nonUTC}, "since=2021-03-04T09%3A30%3A00Z"},
+		{&struct{ embedded }{embedded{Until: zero}}, ""},
+		{&struct{ embedded }{embedded{Until: nonUTC}}, "until=2021-03-04T09%3A30%3A00Z"},
+	}
+
+	for _, tt := range tests {
+		got, err := addOptions("u", tt.opts)
+		if err != nil {
+			t.Fatalf("addOptions(%+v) returned error: %v", tt.opts, err)
+		}
+		want := "u"
+		if tt.want != "" {
+			want += "?" + tt.want
+		}
+		if got != want {
+			t.Errorf("addOptions(%+v) = %q, want %q", tt.opts, got, want)
+		}
+	}
+}
+
 func TestBareDo_returnsOpenBody(t *testing.T) {
 
 	client, mux, _, teardown := setup()
@@ -2239,3 +3467,35 @@ func TestParseTokenExpiration(t *testing.T) {
 		}
 	}
 }
+
+func TestParseDeprecation(t *testing.T) {
+	tests := []struct {
+		sunset, deprecation string
+		wantSunset          time.Time
+		wantDeprecated      bool
+	}{
+		{},
+		{sunset: "this is a garbage"},
+		{
+			sunset:     "Sat, 31 Dec 2022 23:59:59 GMT",
+			wantSunset: time.Date(2022, time.December, 31, 23, 59, 59, 0, time.UTC),
+		},
+		{deprecation: "true", wantDeprecated: true},
+		{deprecation: "@1688169599", wantDeprecated: true},
+		{deprecation: "false"},
+	}
+
+	for _, tt := range tests {
+		res := &http.Response{
+			Request: &http.Request{},
+			Header:  http.Header{},
+		}
+
+		res.Header.Set(headerSunset, tt.sunset)
+		res.Header.Set(headerDeprecation, tt.deprecation)
+		sunset, deprecated := parseDeprecation(res)
+		if !sunset.Equal(tt.wantSunset) || deprecated != tt.wantDeprecated {
+			t.Errorf("parseDeprecation(%q, %q) returned (%v, %v), want (%v, %v)", tt.sunset, tt.deprecation, sunset, deprecated, tt.wantSunset, tt.wantDeprecated)
+		}
+	}
+}
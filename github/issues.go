@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -31,6 +32,8 @@ type Issue struct {
 	Locked            *bool             `json:"locked,omitempty"`
 	Title             *string           `json:"title,omitempty"`
 	Body              *string           `json:"body,omitempty"`
+	BodyText          *string           `json:"body_text,omitempty"` // Only populated when requested with BodyOptions.
+	BodyHTML          *string           `json:"body_html,omitempty"` // Only populated when requested with BodyOptions.
 	AuthorAssociation *string           `json:"author_association,omitempty"`
 	User              *User             `json:"user,omitempty"`
 	Labels            []*Label          `json:"labels,omitempty"`
@@ -239,18 +242,91 @@ func (s *IssuesService) ListByRepo(ctx context.Context, owner string, repo strin
 	return issues, resp, nil
 }
 
+// ListByRepoSince calls fn for each issue and pull request of the specified
+// repository, open or closed, that was updated at or after since, in order of
+// their update time.
+//
+// Rather than paginating by page number, which skips or repeats issues when
+// issues change during the traversal, ListByRepoSince lists the issues sorted
+// by update time in ascending order and moves the since watermark forward to
+// the update time of the last issue of each page. Issues that are updated or
+// created during the traversal therefore move to its end instead of shifting
+// the pages. fn is called at most once per issue. If fn returns an error, the
+// traversal stops and the error is returned.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#list-repository-issues
+func (s *IssuesService) ListByRepoSince(ctx context.Context, owner, repo string, since time.Time, fn func(*Issue) error) error {
+	opts := &IssueListByRepoOptions{
+		State:       "all",
+		Sort:        "updated",
+		Direction:   "asc",
+		Since:       since,
+		ListOptions: ListOptions{PerPage: 100},
+	}
+	seen := make(map[int64]bool)
+	for {
+		issues, resp, err := s.ListByRepo(ctx, owner, repo, opts)
+		if err != nil {
+			return err
+		}
+
+		for _, issue := range issues {
+			if seen[issue.GetID()] {
+				continue
+			}
+			seen[issue.GetID()] = true
+			if err := fn(issue); err != nil {
+				return err
+			}
+		}
+
+		if resp.NextPage == 0 || len(issues) == 0 {
+			return nil
+		}
+
+		// The since filter is inclusive, so the issues updated at the
+		// watermark are listed again and skipped above. Only if a whole page
+		// was updated at the watermark does the traversal fall back to the
+		// next page.
+		if last := issues[len(issues)-1].GetUpdatedAt(); last.After(opts.Since) {
+			opts.Since = last
+			opts.Page = 0
+		} else {
+			opts.Page = resp.NextPage
+		}
+	}
+}
+
 // Get a single issue.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#get-an-issue
 func (s *IssuesService) Get(ctx context.Context, owner string, repo string, number int) (*Issue, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/%d", owner, repo, number)
-	req, err := s.client.NewRequest("GET", u, nil)
+	// TODO: remove custom Accept header when this API fully launch.
+	return s.get(ctx, owner, repo, number, mediaTypeReactionsPreview)
+}
+
+// GetFormatted gets a single issue, with its body in the representation
+// requested by opts.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#get-an-issue
+func (s *IssuesService) GetFormatted(ctx context.Context, owner string, repo string, number int, opts BodyOptions) (*Issue, *Response, error) {
+	mediaType, err := opts.mediaType()
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// TODO: remove custom Accept header when this API fully launch.
-	req.Header.Set("Accept", mediaTypeReactionsPreview)
+	return s.get(ctx, owner, repo, number, strings.Join([]string{mediaType, mediaTypeReactionsPreview}, ", "))
+}
+
+// get fetches a single issue, requesting it with the given Accept header.
+func (s *IssuesService) get(ctx context.Context, owner string, repo string, number int, accept string) (*Issue, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/issues/%d", owner, repo, number)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", accept)
 
 	issue := new(Issue)
 	resp, err := s.client.Do(ctx, req, issue)
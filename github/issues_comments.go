@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -16,6 +17,8 @@ type IssueComment struct {
 	ID        *int64     `json:"id,omitempty"`
 	NodeID    *string    `json:"node_id,omitempty"`
 	Body      *string    `json:"body,omitempty"`
+	BodyText  *string    `json:"body_text,omitempty"` // Only populated when requested with BodyOptions.
+	BodyHTML  *string    `json:"body_html,omitempty"` // Only populated when requested with BodyOptions.
 	User      *User      `json:"user,omitempty"`
 	Reactions *Reactions `json:"reactions,omitempty"`
 	CreatedAt *time.Time `json:"created_at,omitempty"`
@@ -44,6 +47,10 @@ type IssueListCommentsOptions struct {
 	// Since filters comments by time.
 	Since *time.Time `url:"since,omitempty"`
 
+	// BodyFormat selects the representation of the comment bodies. The zero
+	// value returns the default Markdown body only.
+	BodyFormat BodyFormat `url:"-"`
+
 	ListOptions
 }
 
@@ -70,7 +77,15 @@ func (s *IssuesService) ListComments(ctx context.Context, owner string, repo str
 	}
 
 	// TODO: remove custom Accept header when this API fully launches.
-	req.Header.Set("Accept", mediaTypeReactionsPreview)
+	accept := mediaTypeReactionsPreview
+	if opts != nil && opts.BodyFormat != 0 {
+		mediaType, err := BodyOptions{Format: opts.BodyFormat}.mediaType()
+		if err != nil {
+			return nil, nil, err
+		}
+		accept = strings.Join([]string{mediaType, accept}, ", ")
+	}
+	req.Header.Set("Accept", accept)
 
 	var comments []*IssueComment
 	resp, err := s.client.Do(ctx, req, &comments)
@@ -85,15 +100,34 @@ func (s *IssuesService) ListComments(ctx context.Context, owner string, repo str
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#get-an-issue-comment
 func (s *IssuesService) GetComment(ctx context.Context, owner string, repo string, commentID int64) (*IssueComment, *Response, error) {
-	u := fmt.Sprintf("repos/%v/%v/issues/comments/%d", owner, repo, commentID)
+	// TODO: remove custom Accept header when this API fully launches.
+	return s.getComment(ctx, owner, repo, commentID, mediaTypeReactionsPreview)
+}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+// GetCommentFormatted fetches the specified issue comment, with its body in
+// the representation requested by opts.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#get-an-issue-comment
+func (s *IssuesService) GetCommentFormatted(ctx context.Context, owner string, repo string, commentID int64, opts BodyOptions) (*IssueComment, *Response, error) {
+	mediaType, err := opts.mediaType()
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// TODO: remove custom Accept header when this API fully launches.
-	req.Header.Set("Accept", mediaTypeReactionsPreview)
+	return s.getComment(ctx, owner, repo, commentID, strings.Join([]string{mediaType, mediaTypeReactionsPreview}, ", "))
+}
+
+// getComment fetches an issue comment, requesting it with the given Accept
+// header.
+func (s *IssuesService) getComment(ctx context.Context, owner string, repo string, commentID int64, accept string) (*IssueComment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/issues/comments/%d", owner, repo, commentID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", accept)
 
 	comment := new(IssueComment)
 	resp, err := s.client.Do(ctx, req, comment)
@@ -101,6 +101,33 @@ func TestIssuesService_ListComments_specificIssue(t *testing.T) {
 	})
 }
 
+func TestIssuesService_ListComments_bodyFormat(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3HTMLJSON+", "+mediaTypeReactionsPreview)
+		fmt.Fprint(w, `[{"id":1,"body_html":"<p><strong>bold</strong></p>"}]`)
+	})
+
+	opts := &IssueListCommentsOptions{BodyFormat: BodyHTML}
+	ctx := context.Background()
+	comments, _, err := client.Issues.ListComments(ctx, "o", "r", 1, opts)
+	if err != nil {
+		t.Errorf("Issues.ListComments returned error: %v", err)
+	}
+
+	want := []*IssueComment{{ID: Int64(1), BodyHTML: String("<p><strong>bold</strong></p>")}}
+	if !cmp.Equal(comments, want) {
+		t.Errorf("Issues.ListComments returned %+v, want %+v", comments, want)
+	}
+
+	if _, _, err := client.Issues.ListComments(ctx, "o", "r", 1, &IssueListCommentsOptions{BodyFormat: 42}); err == nil {
+		t.Error("Issues.ListComments returned no error for an unsupported body format")
+	}
+}
+
 func TestIssuesService_ListComments_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -146,6 +173,51 @@ func TestIssuesService_GetComment(t *testing.T) {
 	})
 }
 
+func TestIssuesService_GetCommentFormatted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/comments/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3FullJSON+", "+mediaTypeReactionsPreview)
+		fmt.Fprint(w, `{"id":1,"body":"**bold**","body_text":"bold","body_html":"<p><strong>bold</strong></p>"}`)
+	})
+
+	ctx := context.Background()
+	comment, _, err := client.Issues.GetCommentFormatted(ctx, "o", "r", 1, BodyOptions{Format: BodyFull})
+	if err != nil {
+		t.Errorf("Issues.GetCommentFormatted returned error: %v", err)
+	}
+
+	want := &IssueComment{
+		ID:       Int64(1),
+		Body:     String("**bold**"),
+		BodyText: String("bold"),
+		BodyHTML: String("<p><strong>bold</strong></p>"),
+	}
+	if !cmp.Equal(comment, want) {
+		t.Errorf("Issues.GetCommentFormatted returned %+v, want %+v", comment, want)
+	}
+
+	const methodName = "GetCommentFormatted"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.GetCommentFormatted(ctx, "\n", "\n", -1, BodyOptions{Format: BodyFull})
+		return err
+	})
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.GetCommentFormatted(ctx, "o", "r", 1, BodyOptions{Format: 42})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.GetCommentFormatted(ctx, "o", "r", 1, BodyOptions{Format: BodyFull})
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestIssuesService_GetComment_invalidOrg(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
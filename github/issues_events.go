@@ -88,6 +88,46 @@ type IssueEvent struct {
 	ReviewRequester   *User            `json:"review_requester,omitempty"`
 }
 
+// IssueEventType represents the value of IssueEvent.Event.
+type IssueEventType string
+
+// This is the set of issue event types reported by the GitHub API.
+// See the documentation of IssueEvent.Event for their meaning.
+const (
+	IssueEventAddedToProject        IssueEventType = "added_to_project"
+	IssueEventAssigned              IssueEventType = "assigned"
+	IssueEventClosed                IssueEventType = "closed"
+	IssueEventConvertToDraft        IssueEventType = "convert_to_draft"
+	IssueEventConvertedNoteToIssue  IssueEventType = "converted_note_to_issue"
+	IssueEventDemilestoned          IssueEventType = "demilestoned"
+	IssueEventHeadRefDeleted        IssueEventType = "head_ref_deleted"
+	IssueEventHeadRefRestored       IssueEventType = "head_ref_restored"
+	IssueEventLabeled               IssueEventType = "labeled"
+	IssueEventLocked                IssueEventType = "locked"
+	IssueEventMentioned             IssueEventType = "mentioned"
+	IssueEventMerged                IssueEventType = "merged"
+	IssueEventMilestoned            IssueEventType = "milestoned"
+	IssueEventMovedColumnsInProject IssueEventType = "moved_columns_in_project"
+	IssueEventReadyForReview        IssueEventType = "ready_for_review"
+	IssueEventReferenced            IssueEventType = "referenced"
+	IssueEventRemovedFromProject    IssueEventType = "removed_from_project"
+	IssueEventRenamed               IssueEventType = "renamed"
+	IssueEventReopened              IssueEventType = "reopened"
+	IssueEventReviewDismissed       IssueEventType = "review_dismissed"
+	IssueEventReviewRequested       IssueEventType = "review_requested"
+	IssueEventReviewRequestRemoved  IssueEventType = "review_request_removed"
+	IssueEventSubscribed            IssueEventType = "subscribed"
+	IssueEventUnassigned            IssueEventType = "unassigned"
+	IssueEventUnlabeled             IssueEventType = "unlabeled"
+	IssueEventUnlocked              IssueEventType = "unlocked"
+	IssueEventUnsubscribed          IssueEventType = "unsubscribed"
+)
+
+// Type returns the Event of the IssueEvent as an IssueEventType.
+func (e *IssueEvent) Type() IssueEventType {
+	return IssueEventType(e.GetEvent())
+}
+
 // DismissedReview represents details for 'dismissed_review' events.
 type DismissedReview struct {
 	// State represents the state of the dismissed review.
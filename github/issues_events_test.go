@@ -130,6 +130,65 @@ func TestIssuesService_GetEvent(t *testing.T) {
 	})
 }
 
+func TestIssuesService_ListIssueEvents_renamedAndMilestoned(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"id":1,"event":"renamed","actor":{"login":"a"},"rename":{"from":"old","to":"new"}},
+			{"id":2,"event":"milestoned","actor":{"login":"a"},"milestone":{"title":"v1.0"}},
+			{"id":3,"event":"labeled","label":{"name":"bug","color":"d73a4a"}}
+		]`)
+	})
+
+	ctx := context.Background()
+	events, _, err := client.Issues.ListIssueEvents(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Errorf("Issues.ListIssueEvents returned error: %v", err)
+	}
+
+	want := []*IssueEvent{
+		{
+			ID:     Int64(1),
+			Event:  String("renamed"),
+			Actor:  &User{Login: String("a")},
+			Rename: &Rename{From: String("old"), To: String("new")},
+		},
+		{
+			ID:        Int64(2),
+			Event:     String("milestoned"),
+			Actor:     &User{Login: String("a")},
+			Milestone: &Milestone{Title: String("v1.0")},
+		},
+		{
+			ID:    Int64(3),
+			Event: String("labeled"),
+			Label: &Label{Name: String("bug"), Color: String("d73a4a")},
+		},
+	}
+	if !cmp.Equal(events, want) {
+		t.Errorf("Issues.ListIssueEvents returned %+v, want %+v", events, want)
+	}
+
+	wantTypes := []IssueEventType{IssueEventRenamed, IssueEventMilestoned, IssueEventLabeled}
+	for i, e := range events {
+		if got := e.Type(); got != wantTypes[i] {
+			t.Errorf("IssueEvent.Type of event %d is %q, want %q", e.GetID(), got, wantTypes[i])
+		}
+	}
+}
+
+func TestIssueEvent_Type(t *testing.T) {
+	if got := (&IssueEvent{}).Type(); got != "" {
+		t.Errorf("IssueEvent.Type of an event without Event is %q, want empty", got)
+	}
+	if got, want := (&IssueEvent{Event: String("closed")}).Type(), IssueEventClosed; got != want {
+		t.Errorf("IssueEvent.Type is %q, want %q", got, want)
+	}
+}
+
 func TestRename_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Rename{}, "{}")
 
@@ -7,7 +7,11 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
 )
 
 // Label represents a GitHub label on an Issue
@@ -25,6 +29,26 @@ func (l Label) String() string {
 	return Stringify(l)
 }
 
+var labelColorRE = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
+// normalizeLabelColor returns a copy of label with a leading "#" stripped
+// from its Color, or an error if the color is not 6 hexadecimal digits.
+// label is returned unchanged if it has no Color.
+func normalizeLabelColor(label *Label) (*Label, error) {
+	if label == nil || label.Color == nil {
+		return label, nil
+	}
+
+	color := strings.TrimPrefix(*label.Color, "#")
+	if !labelColorRE.MatchString(color) {
+		return nil, fmt.Errorf("invalid label color %q: must be 6 hexadecimal digits", *label.Color)
+	}
+
+	l := *label
+	l.Color = String(color)
+	return &l, nil
+}
+
 // ListLabels lists all labels for a repository.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#list-labels-for-a-repository
@@ -69,9 +93,16 @@ func (s *IssuesService) GetLabel(ctx context.Context, owner string, repo string,
 }
 
 // CreateLabel creates a new label on the specified repository.
+// A leading "#" is stripped from label.Color, which must otherwise be 6
+// hexadecimal digits.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#create-a-label
 func (s *IssuesService) CreateLabel(ctx context.Context, owner string, repo string, label *Label) (*Label, *Response, error) {
+	label, err := normalizeLabelColor(label)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/labels", owner, repo)
 	req, err := s.client.NewRequest("POST", u, label)
 	if err != nil {
@@ -87,10 +118,16 @@ func (s *IssuesService) CreateLabel(ctx context.Context, owner string, repo stri
 	return l, resp, nil
 }
 
-// EditLabel edits a label.
+// EditLabel edits a label. A leading "#" is stripped from label.Color,
+// which must otherwise be 6 hexadecimal digits.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#update-a-label
 func (s *IssuesService) EditLabel(ctx context.Context, owner string, repo string, name string, label *Label) (*Label, *Response, error) {
+	label, err := normalizeLabelColor(label)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/labels/%v", owner, repo, name)
 	req, err := s.client.NewRequest("PATCH", u, label)
 	if err != nil {
@@ -106,6 +143,52 @@ func (s *IssuesService) EditLabel(ctx context.Context, owner string, repo string
 	return l, resp, nil
 }
 
+// EnsureLabel makes sure a label named label.Name exists in a repository
+// and returns it. The label is created if it does not exist yet. Otherwise
+// the existing label is fetched and, if label sets a Color or Description
+// that differs from it, edited to match.
+func (s *IssuesService) EnsureLabel(ctx context.Context, owner string, repo string, label *Label) (*Label, *Response, error) {
+	if label == nil || label.GetName() == "" {
+		return nil, nil, errors.New("label name must be set")
+	}
+	label, err := normalizeLabelColor(label)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l, resp, err := s.CreateLabel(ctx, owner, repo, label)
+	if !isAlreadyExistsError(err) {
+		return l, resp, err
+	}
+
+	l, resp, err = s.GetLabel(ctx, owner, repo, label.GetName())
+	if err != nil {
+		return nil, resp, err
+	}
+
+	colorDrift := label.Color != nil && !strings.EqualFold(label.GetColor(), l.GetColor())
+	descriptionDrift := label.Description != nil && label.GetDescription() != l.GetDescription()
+	if !colorDrift && !descriptionDrift {
+		return l, resp, nil
+	}
+	return s.EditLabel(ctx, owner, repo, label.GetName(), &Label{Color: label.Color, Description: label.Description})
+}
+
+// isAlreadyExistsError reports whether err is a validation error reporting
+// that the resource being created already exists.
+func isAlreadyExistsError(err error) bool {
+	e, ok := err.(*ErrorResponse)
+	if !ok || e.Response == nil || e.Response.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	for _, v := range e.Errors {
+		if v.Code == "already_exists" {
+			return true
+		}
+	}
+	return false
+}
+
 // DeleteLabel deletes a label.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#delete-a-label
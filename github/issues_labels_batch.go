@@ -0,0 +1,134 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// maxLabelRateLimitRetries is the number of times AddLabelsToIssues retries
+// an issue after GitHub reports that a rate limit is exceeded.
+const maxLabelRateLimitRetries = 3
+
+// IssueLabelsResult is the outcome of adding labels to a single issue with
+// IssuesService.AddLabelsToIssues.
+type IssueLabelsResult struct {
+	// Labels are the labels of the issue after the labels were added.
+	Labels []*Label
+	// Err is the error encountered for the issue, if any.
+	Err error
+}
+
+// AddLabelsToIssues adds labels to each of the given issues of a repository,
+// calling AddLabelsToIssue with a bounded number of requests in flight.
+//
+// When GitHub reports that a rate limit is exceeded, all requests are paused
+// until the limit resets and the issue is retried, up to a few times. If the
+// limit is still exceeded after the last retry, the issues that have not been
+// started yet fail with the same rate limit error. The
+// returned map holds a result for every issue number, and the error is
+// non-nil if labels could not be added to at least one of them.
+func (s *IssuesService) AddLabelsToIssues(ctx context.Context, owner, repo string, numbers []int, labels []string) (map[int]*IssueLabelsResult, error) {
+	var (
+		mu          sync.Mutex
+		results     = make(map[int]*IssueLabelsResult)
+		pausedUntil time.Time
+	)
+
+	// waitForRateLimit blocks until requests may resume, or ctx is done.
+	waitForRateLimit := func() error {
+		mu.Lock()
+		wait := time.Until(pausedUntil)
+		mu.Unlock()
+		if wait <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return nil
+		}
+	}
+
+	addLabels := func(number int) *IssueLabelsResult {
+		for attempt := 0; ; attempt++ {
+			if err := waitForRateLimit(); err != nil {
+				return &IssueLabelsResult{Err: err}
+			}
+
+			l, _, err := s.AddLabelsToIssue(ctx, owner, repo, number, labels)
+			if err == nil {
+				return &IssueLabelsResult{Labels: l}
+			}
+
+			var resume time.Time
+			switch e := err.(type) {
+			case *RateLimitError:
+				resume = e.Rate.Reset.Time
+			case *AbuseRateLimitError:
+				if e.RetryAfter != nil {
+					resume = time.Now().Add(*e.RetryAfter)
+				}
+			default:
+				return &IssueLabelsResult{Err: err}
+			}
+			if attempt == maxLabelRateLimitRetries {
+				return &IssueLabelsResult{Err: err}
+			}
+			if resume.IsZero() {
+				resume = time.Now().Add(defaultRateLimitBackoff)
+			}
+
+			mu.Lock()
+			if resume.After(pausedUntil) {
+				pausedUntil = resume
+			}
+			mu.Unlock()
+		}
+	}
+
+	seen := make(map[int]bool)
+	var distinct []int
+	for _, number := range numbers {
+		if !seen[number] {
+			seen[number] = true
+			distinct = append(distinct, number)
+		}
+	}
+
+	errs := runBounded(ctx, len(distinct), defaultConcurrentRequests, func(i int) error {
+		r := addLabels(distinct[i])
+		mu.Lock()
+		results[distinct[i]] = r
+		mu.Unlock()
+		return r.Err
+	})
+	// Issues that were never started, because the rate limit was still
+	// exceeded after the last retry of another issue, have no result yet.
+	for i, err := range errs {
+		if _, ok := results[distinct[i]]; !ok {
+			results[distinct[i]] = &IssueLabelsResult{Err: err}
+		}
+	}
+
+	var failed int
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return results, fmt.Errorf("failed to add labels to %d of %d issues", failed, len(results))
+	}
+	return results, nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_AddLabelsToIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	numbers := []int{1, 2, 3, 4, 5, 2}
+	for _, n := range numbers {
+		n := n
+		if n == 2 {
+			continue
+		}
+		mux.HandleFunc(fmt.Sprintf("/repos/o/r/issues/%d/labels", n), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testBody(t, r, `["triage"]`+"\n")
+			if n == 3 {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"message":"Not Found"}`)
+				return
+			}
+			fmt.Fprint(w, `[{"name":"triage"}]`)
+		})
+	}
+	var calls2 int
+	mux.HandleFunc("/repos/o/r/issues/2/labels", func(w http.ResponseWriter, r *http.Request) {
+		calls2++
+		fmt.Fprint(w, `[{"name":"triage"}]`)
+	})
+
+	ctx := context.Background()
+	results, err := client.Issues.AddLabelsToIssues(ctx, "o", "r", numbers, []string{"triage"})
+	if err == nil {
+		t.Error("Issues.AddLabelsToIssues returned no error, want one for issue 3")
+	}
+
+	if got, want := len(results), 5; got != want {
+		t.Fatalf("Issues.AddLabelsToIssues returned %d results, want %d", got, want)
+	}
+	if calls2 != 1 {
+		t.Errorf("Issues.AddLabelsToIssues labeled issue 2 %d times, want 1", calls2)
+	}
+	for _, n := range []int{1, 2, 4, 5} {
+		r := results[n]
+		if r.Err != nil {
+			t.Errorf("Issues.AddLabelsToIssues result for issue %d has error: %v", n, r.Err)
+		}
+		want := []*Label{{Name: String("triage")}}
+		if !cmp.Equal(r.Labels, want) {
+			t.Errorf("Issues.AddLabelsToIssues result for issue %d has labels %+v, want %+v", n, r.Labels, want)
+		}
+	}
+	if e, ok := results[3].Err.(*ErrorResponse); !ok || e.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("Issues.AddLabelsToIssues result for issue 3 has error %v, want a 404 *ErrorResponse", results[3].Err)
+	}
+}
+
+func TestIssuesService_AddLabelsToIssues_rateLimitBackoff(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	saved := defaultRateLimitBackoff
+	defaultRateLimitBackoff = 10 * time.Millisecond
+	defer func() { defaultRateLimitBackoff = saved }()
+
+	var (
+		mu    sync.Mutex
+		calls = make(map[int]int)
+	)
+	numbers := []int{1, 2, 3}
+	for _, n := range numbers {
+		n := n
+		mux.HandleFunc(fmt.Sprintf("/repos/o/r/issues/%d/labels", n), func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			calls[n]++
+			c := calls[n]
+			mu.Unlock()
+
+			switch {
+			case n == 1 && c == 1:
+				w.Header().Set(headerRateLimit, "60")
+				w.Header().Set(headerRateRemaining, "0")
+				w.Header().Set(headerRateReset, fmt.Sprint(time.Now().Unix()))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `{"message":"API rate limit exceeded for xxx.xxx.xxx.xxx."}`)
+			case n == 2 && c == 1:
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `{
+					"message": "You have triggered an abuse detection mechanism ...",
+					"documentation_url": "https://docs.github.com/en/free-pro-team@latest/rest/reference/#abuse-rate-limits"
+				}`)
+			default:
+				fmt.Fprint(w, `[{"name":"triage"}]`)
+			}
+		})
+	}
+
+	ctx := context.Background()
+	results, err := client.Issues.AddLabelsToIssues(ctx, "o", "r", numbers, []string{"triage"})
+	if err != nil {
+		t.Fatalf("Issues.AddLabelsToIssues returned error: %v", err)
+	}
+
+	for _, n := range numbers {
+		if r := results[n]; r == nil || r.Err != nil || len(r.Labels) != 1 {
+			t.Errorf("Issues.AddLabelsToIssues result for issue %d is %+v, want one label and no error", n, r)
+		}
+	}
+	if calls[1] != 2 || calls[2] != 2 {
+		t.Errorf("Issues.AddLabelsToIssues made %d and %d requests for issues 1 and 2, want 2 each", calls[1], calls[2])
+	}
+}
+
+func TestIssuesService_AddLabelsToIssues_rateLimitRetriesExhausted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	saved := defaultRateLimitBackoff
+	defaultRateLimitBackoff = time.Millisecond
+	defer func() { defaultRateLimitBackoff = saved }()
+
+	var calls int
+	mux.HandleFunc("/repos/o/r/issues/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{
+			"message": "You have triggered an abuse detection mechanism ...",
+			"documentation_url": "https://docs.github.com/en/free-pro-team@latest/rest/reference/#abuse-rate-limits"
+		}`)
+	})
+
+	ctx := context.Background()
+	results, err := client.Issues.AddLabelsToIssues(ctx, "o", "r", []int{1}, []string{"triage"})
+	if err == nil {
+		t.Error("Issues.AddLabelsToIssues returned no error, want one")
+	}
+	if _, ok := results[1].Err.(*AbuseRateLimitError); !ok {
+		t.Errorf("Issues.AddLabelsToIssues result for issue 1 has error %v, want *AbuseRateLimitError", results[1].Err)
+	}
+	if got, want := calls, maxLabelRateLimitRetries+1; got != want {
+		t.Errorf("Issues.AddLabelsToIssues made %d requests, want %d", got, want)
+	}
+}
+
+func TestIssuesService_AddLabelsToIssues_rateLimitStopsRemaining(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	saved := defaultRateLimitBackoff
+	defaultRateLimitBackoff = time.Millisecond
+	defer func() { defaultRateLimitBackoff = saved }()
+
+	var calls int32
+	mux.HandleFunc("/repos/o/r/issues/", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{
+			"message": "You have triggered an abuse detection mechanism ...",
+			"documentation_url": "https://docs.github.com/en/free-pro-team@latest/rest/reference/#abuse-rate-limits"
+		}`)
+	})
+
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	ctx := context.Background()
+	results, err := client.Issues.AddLabelsToIssues(ctx, "o", "r", numbers, []string{"triage"})
+	if err == nil {
+		t.Error("Issues.AddLabelsToIssues returned no error, want one")
+	}
+	for _, number := range numbers {
+		if r := results[number]; r == nil {
+			t.Errorf("Issues.AddLabelsToIssues returned no result for issue %d", number)
+		} else if _, ok := r.Err.(*AbuseRateLimitError); !ok {
+			t.Errorf("Issues.AddLabelsToIssues result for issue %d is %+v, want an *AbuseRateLimitError", number, results[number])
+		}
+	}
+	if max := int32(defaultConcurrentRequests * (maxLabelRateLimitRetries + 1)); calls > max {
+		t.Errorf("Issues.AddLabelsToIssues made %d requests, want at most %d", calls, max)
+	}
+}
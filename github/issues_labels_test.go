@@ -149,6 +149,47 @@ func TestIssuesService_CreateLabel(t *testing.T) {
 	})
 }
 
+func TestIssuesService_CreateLabel_color(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"n","color":"ff0000"}`+"\n")
+		fmt.Fprint(w, `{"name":"n","color":"ff0000"}`)
+	})
+
+	ctx := context.Background()
+	for _, color := range []string{"#ff0000", "ff0000"} {
+		input := &Label{Name: String("n"), Color: String(color)}
+		label, _, err := client.Issues.CreateLabel(ctx, "o", "r", input)
+		if err != nil {
+			t.Errorf("Issues.CreateLabel(%q) returned error: %v", color, err)
+		}
+
+		want := &Label{Name: String("n"), Color: String("ff0000")}
+		if !cmp.Equal(label, want) {
+			t.Errorf("Issues.CreateLabel(%q) returned %+v, want %+v", color, label, want)
+		}
+		if got := input.GetColor(); got != color {
+			t.Errorf("Issues.CreateLabel modified input color to %q, want %q", got, color)
+		}
+	}
+}
+
+func TestIssuesService_CreateLabel_invalidColor(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	for _, color := range []string{"xyz", "#ff00", "gg0000"} {
+		_, _, err := client.Issues.CreateLabel(ctx, "o", "r", &Label{Name: String("n"), Color: String(color)})
+		if err == nil {
+			t.Errorf("Issues.CreateLabel(%q) returned nil error, want error", color)
+		}
+	}
+}
+
 func TestIssuesService_CreateLabel_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -202,6 +243,17 @@ func TestIssuesService_EditLabel(t *testing.T) {
 	})
 }
 
+func TestIssuesService_EditLabel_invalidColor(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	_, _, err := client.Issues.EditLabel(ctx, "o", "r", "n", &Label{Color: String("xyz")})
+	if err == nil {
+		t.Error("Issues.EditLabel returned nil error, want error")
+	}
+}
+
 func TestIssuesService_EditLabel_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -211,6 +263,106 @@ func TestIssuesService_EditLabel_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestIssuesService_EnsureLabel_create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"n","color":"f29513"}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"n","color":"f29513"}`)
+	})
+
+	ctx := context.Background()
+	label, _, err := client.Issues.EnsureLabel(ctx, "o", "r", &Label{Name: String("n"), Color: String("#f29513")})
+	if err != nil {
+		t.Fatalf("Issues.EnsureLabel returned error: %v", err)
+	}
+
+	want := &Label{Name: String("n"), Color: String("f29513")}
+	if !cmp.Equal(label, want) {
+		t.Errorf("Issues.EnsureLabel returned %+v, want %+v", label, want)
+	}
+}
+
+func TestIssuesService_EnsureLabel_alreadyExists(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"Label","code":"already_exists","field":"name"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/labels/n", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"name":"n","color":"F29513","description":"d"}`)
+	})
+
+	ctx := context.Background()
+	label, _, err := client.Issues.EnsureLabel(ctx, "o", "r", &Label{Name: String("n"), Color: String("f29513"), Description: String("d")})
+	if err != nil {
+		t.Fatalf("Issues.EnsureLabel returned error: %v", err)
+	}
+
+	want := &Label{ID: Int64(1), Name: String("n"), Color: String("F29513"), Description: String("d")}
+	if !cmp.Equal(label, want) {
+		t.Errorf("Issues.EnsureLabel returned %+v, want %+v", label, want)
+	}
+}
+
+func TestIssuesService_EnsureLabel_updateOnDrift(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"Label","code":"already_exists","field":"name"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/labels/n", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id":1,"name":"n","color":"000000","description":"old"}`)
+		case "PATCH":
+			testBody(t, r, `{"color":"f29513","description":"new"}`+"\n")
+			fmt.Fprint(w, `{"id":1,"name":"n","color":"f29513","description":"new"}`)
+		default:
+			t.Errorf("Request method: %v, want GET or PATCH", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	label, _, err := client.Issues.EnsureLabel(ctx, "o", "r", &Label{Name: String("n"), Color: String("f29513"), Description: String("new")})
+	if err != nil {
+		t.Fatalf("Issues.EnsureLabel returned error: %v", err)
+	}
+
+	want := &Label{ID: Int64(1), Name: String("n"), Color: String("f29513"), Description: String("new")}
+	if !cmp.Equal(label, want) {
+		t.Errorf("Issues.EnsureLabel returned %+v, want %+v", label, want)
+	}
+}
+
+func TestIssuesService_EnsureLabel_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"Label","code":"invalid","field":"color"}]}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Issues.EnsureLabel(ctx, "o", "r", &Label{Name: String("n")}); err == nil {
+		t.Error("Issues.EnsureLabel returned no error for an invalid label")
+	}
+	if _, _, err := client.Issues.EnsureLabel(ctx, "o", "r", &Label{Color: String("f29513")}); err == nil {
+		t.Error("Issues.EnsureLabel returned no error for a label without a name")
+	}
+}
+
 func TestIssuesService_DeleteLabel(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
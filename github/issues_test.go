@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"testing"
 	"time"
 
@@ -190,6 +191,159 @@ func TestIssuesService_ListByRepo_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+// testIssueStore serves the issues of repository o/r the way GitHub lists
+// them when sorted by update time in ascending order, two per page.
+type testIssueStore struct {
+	t         *testing.T
+	issues    map[int64]time.Time // Update times by issue ID.
+	requests  int
+	onRequest func(s *testIssueStore)
+}
+
+func (s *testIssueStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	testMethod(s.t, r, "GET")
+	for k, v := range (values{"state": "all", "sort": "updated", "direction": "asc", "per_page": "100"}) {
+		if got := r.FormValue(k); got != v {
+			s.t.Errorf("Request parameter %v = %q, want %q", k, got, v)
+		}
+	}
+	since, err := time.Parse(time.RFC3339, r.FormValue("since"))
+	if err != nil {
+		s.t.Fatalf("Invalid since %q: %v", r.FormValue("since"), err)
+	}
+
+	var issues []*Issue
+	for id, updated := range s.issues {
+		updated := updated
+		if !updated.Before(since) {
+			issues = append(issues, &Issue{ID: Int64(id), UpdatedAt: &updated})
+		}
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		if !issues[i].GetUpdatedAt().Equal(issues[j].GetUpdatedAt()) {
+			return issues[i].GetUpdatedAt().Before(issues[j].GetUpdatedAt())
+		}
+		return issues[i].GetID() < issues[j].GetID()
+	})
+
+	const perPage = 2
+	page := 1
+	fmt.Sscan(r.FormValue("page"), &page)
+	start, end := (page-1)*perPage, page*perPage
+	if start > len(issues) {
+		start = len(issues)
+	}
+	if end < len(issues) {
+		w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/repos/o/r/issues?page=%d>; rel="next"`, page+1))
+	} else {
+		end = len(issues)
+	}
+	json.NewEncoder(w).Encode(issues[start:end])
+
+	s.requests++
+	if s.onRequest != nil {
+		s.onRequest(s)
+	}
+}
+
+func TestIssuesService_ListByRepoSince(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	t0 := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	store := &testIssueStore{t: t, issues: map[int64]time.Time{}}
+	for id := int64(1); id <= 5; id++ {
+		store.issues[id] = t0.Add(time.Duration(id) * time.Minute)
+	}
+	store.onRequest = func(s *testIssueStore) {
+		if s.requests != 1 {
+			return
+		}
+		// While the first page is being processed, issue 6 is created and
+		// the issues 1 and 4 are updated.
+		s.issues[6] = t0.Add(10 * time.Minute)
+		s.issues[1] = t0.Add(11 * time.Minute)
+		s.issues[4] = t0.Add(12 * time.Minute)
+	}
+	mux.Handle("/repos/o/r/issues", store)
+
+	ctx := context.Background()
+	var got []int64
+	err := client.Issues.ListByRepoSince(ctx, "o", "r", t0, func(issue *Issue) error {
+		got = append(got, issue.GetID())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Issues.ListByRepoSince returned error: %v", err)
+	}
+
+	if want := []int64{1, 2, 3, 5, 6, 4}; !cmp.Equal(got, want) {
+		t.Errorf("Issues.ListByRepoSince visited issues %v, want %v", got, want)
+	}
+}
+
+func TestIssuesService_ListByRepoSince_sameUpdateTime(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	t0 := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	store := &testIssueStore{t: t, issues: map[int64]time.Time{
+		1: t0, 2: t0, 3: t0, 4: t0.Add(time.Minute), 5: t0.Add(time.Minute),
+	}}
+	mux.Handle("/repos/o/r/issues", store)
+
+	ctx := context.Background()
+	var got []int64
+	err := client.Issues.ListByRepoSince(ctx, "o", "r", t0, func(issue *Issue) error {
+		got = append(got, issue.GetID())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Issues.ListByRepoSince returned error: %v", err)
+	}
+
+	if want := []int64{1, 2, 3, 4, 5}; !cmp.Equal(got, want) {
+		t.Errorf("Issues.ListByRepoSince visited issues %v, want %v", got, want)
+	}
+}
+
+func TestIssuesService_ListByRepoSince_fnError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	t0 := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	store := &testIssueStore{t: t, issues: map[int64]time.Time{
+		1: t0, 2: t0.Add(time.Minute), 3: t0.Add(2 * time.Minute),
+	}}
+	mux.Handle("/repos/o/r/issues", store)
+
+	ctx := context.Background()
+	stop := fmt.Errorf("stop")
+	var got []int64
+	err := client.Issues.ListByRepoSince(ctx, "o", "r", t0, func(issue *Issue) error {
+		got = append(got, issue.GetID())
+		return stop
+	})
+	if err != stop {
+		t.Errorf("Issues.ListByRepoSince returned error %v, want %v", err, stop)
+	}
+	if want := []int64{1}; !cmp.Equal(got, want) {
+		t.Errorf("Issues.ListByRepoSince visited issues %v, want %v", got, want)
+	}
+	if store.requests != 1 {
+		t.Errorf("Issues.ListByRepoSince made %d requests, want 1", store.requests)
+	}
+}
+
+func TestIssuesService_ListByRepoSince_listError(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	err := client.Issues.ListByRepoSince(ctx, "%", "r", time.Time{}, func(*Issue) error { return nil })
+	testURLParseError(t, err)
+}
+
 func TestIssuesService_Get(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -234,6 +388,46 @@ func TestIssuesService_Get(t *testing.T) {
 	})
 }
 
+func TestIssuesService_GetFormatted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3HTMLJSON+", "+mediaTypeReactionsPreview)
+		fmt.Fprint(w, `{"number":1,"body_html":"<p><strong>bold</strong></p>"}`)
+	})
+
+	ctx := context.Background()
+	issue, _, err := client.Issues.GetFormatted(ctx, "o", "r", 1, BodyOptions{Format: BodyHTML})
+	if err != nil {
+		t.Errorf("Issues.GetFormatted returned error: %v", err)
+	}
+
+	want := &Issue{Number: Int(1), BodyHTML: String("<p><strong>bold</strong></p>")}
+	if !cmp.Equal(issue, want) {
+		t.Errorf("Issues.GetFormatted returned %+v, want %+v", issue, want)
+	}
+
+	const methodName = "GetFormatted"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.GetFormatted(ctx, "\n", "\n", -1, BodyOptions{Format: BodyHTML})
+		return err
+	})
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.GetFormatted(ctx, "o", "r", 1, BodyOptions{})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.GetFormatted(ctx, "o", "r", 1, BodyOptions{Format: BodyHTML})
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestIssuesService_Get_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -345,6 +539,46 @@ func TestIssuesService_Edit(t *testing.T) {
 	})
 }
 
+func TestIssuesService_Edit_onlySettableFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &IssueRequest{
+		Title:     String("t"),
+		Body:      String("b"),
+		State:     String("closed"),
+		Labels:    &[]string{"l"},
+		Assignees: &[]string{"a"},
+		Milestone: Int(1),
+	}
+
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Unable to decode request body: %v", err)
+		}
+		var keys []string
+		for k := range body {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		want := []string{"assignees", "body", "labels", "milestone", "state", "title"}
+		if !cmp.Equal(keys, want) {
+			t.Errorf("Request body fields = %v, want %v", keys, want)
+		}
+
+		fmt.Fprint(w, `{"id":1,"number":1}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Issues.Edit(ctx, "o", "r", 1, input); err != nil {
+		t.Errorf("Issues.Edit returned error: %v", err)
+	}
+}
+
 func TestIssuesService_Edit_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
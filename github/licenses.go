@@ -39,9 +39,10 @@ func (l RepositoryLicense) String() string {
 
 // License represents an open source license.
 type License struct {
-	Key  *string `json:"key,omitempty"`
-	Name *string `json:"name,omitempty"`
-	URL  *string `json:"url,omitempty"`
+	Key    *string `json:"key,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	URL    *string `json:"url,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
 
 	SPDXID         *string   `json:"spdx_id,omitempty"`
 	HTMLURL        *string   `json:"html_url,omitempty"`
@@ -58,6 +59,53 @@ func (l License) String() string {
 	return Stringify(l)
 }
 
+// osiApprovedLicenses is the set of SPDX identifiers, as reported by the
+// GitHub API, of the licenses approved by the Open Source Initiative.
+//
+// See https://opensource.org/licenses/alphabetical for the complete list.
+var osiApprovedLicenses = map[string]bool{
+	"0BSD":         true,
+	"AFL-3.0":      true,
+	"AGPL-3.0":     true,
+	"Apache-2.0":   true,
+	"Artistic-2.0": true,
+	"BSD-2-Clause": true,
+	"BSD-3-Clause": true,
+	"BSL-1.0":      true,
+	"CECILL-2.1":   true,
+	"ECL-2.0":      true,
+	"EPL-1.0":      true,
+	"EPL-2.0":      true,
+	"EUPL-1.1":     true,
+	"EUPL-1.2":     true,
+	"GPL-2.0":      true,
+	"GPL-3.0":      true,
+	"ISC":          true,
+	"LGPL-2.1":     true,
+	"LGPL-3.0":     true,
+	"LPPL-1.3c":    true,
+	"MIT":          true,
+	"MIT-0":        true,
+	"MPL-2.0":      true,
+	"MS-PL":        true,
+	"MS-RL":        true,
+	"MulanPSL-2.0": true,
+	"NCSA":         true,
+	"OFL-1.1":      true,
+	"OSL-3.0":      true,
+	"PostgreSQL":   true,
+	"UPL-1.0":      true,
+	"Unlicense":    true,
+	"Zlib":         true,
+}
+
+// IsOSIApproved reports whether the license is approved by the Open Source
+// Initiative, based on its SPDX identifier. It returns false for a nil
+// License and for licenses GitHub could not identify ("NOASSERTION").
+func (l *License) IsOSIApproved() bool {
+	return osiApprovedLicenses[l.GetSPDXID()]
+}
+
 // List popular open source licenses.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/licenses/#list-all-licenses
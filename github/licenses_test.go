@@ -81,6 +81,7 @@ func TestLicense_Marshal(t *testing.T) {
 		Key:            String("k"),
 		Name:           String("n"),
 		URL:            String("u"),
+		NodeID:         String("nid"),
 		SPDXID:         String("s"),
 		HTMLURL:        String("h"),
 		Featured:       Bool(true),
@@ -95,6 +96,7 @@ func TestLicense_Marshal(t *testing.T) {
 		"key": "k",
 		"name": "n",
 		"url": "u",
+		"node_id": "nid",
 		"spdx_id": "s",
 		"html_url": "h",
 		"featured": true,
@@ -108,6 +110,26 @@ func TestLicense_Marshal(t *testing.T) {
 	testJSONMarshal(t, l, want)
 }
 
+func TestLicense_IsOSIApproved(t *testing.T) {
+	tests := []struct {
+		license *License
+		want    bool
+	}{
+		{&License{SPDXID: String("MIT")}, true},
+		{&License{SPDXID: String("Apache-2.0")}, true},
+		{&License{SPDXID: String("CC-BY-4.0")}, false},
+		{&License{SPDXID: String("NOASSERTION")}, false},
+		{&License{}, false},
+		{nil, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.license.IsOSIApproved(); got != tt.want {
+			t.Errorf("IsOSIApproved for %v returned %v, want %v", tt.license.GetSPDXID(), got, tt.want)
+		}
+	}
+}
+
 func TestLicensesService_List(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,145 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// RedactedValue is the value that replaces redacted fields in a webhook payload.
+const RedactedValue = "[REDACTED]"
+
+// defaultRedactedPaths maps webhook event types to the field paths that are
+// redacted from their payloads. Paths under the "" key apply to every event.
+var defaultRedactedPaths = map[string][]string{
+	"": {
+		"**.token",
+		"**.access_token",
+		"**.refresh_token",
+		"**.secret",
+		"**.client_secret",
+		"**.webhook_secret",
+		"**.password",
+		"**.private_key",
+		"**.signing_key",
+	},
+	"deploy_key":          {"key.key"},
+	"repository_dispatch": {"client_payload.*"},
+}
+
+// PayloadRedactor redacts sensitive fields from raw webhook payloads so that
+// they can be safely logged.
+//
+// Fields are selected with dot-separated paths into the JSON document, such as
+// "hook.config.secret". A "*" segment matches any single object key or array
+// index, and a "**" segment matches any number of segments, so "**.token"
+// matches a "token" field at any depth.
+type PayloadRedactor struct {
+	// Deny lists additional field paths to redact for every event type.
+	Deny []string
+
+	// Allow lists field paths that are kept as is, even when they are
+	// matched by a default path or by Deny.
+	Allow []string
+}
+
+// RedactPayload returns a copy of the webhook payload of the given event type
+// with known sensitive fields, such as tokens, secrets, signing keys and the
+// client_payload of repository_dispatch events, replaced by RedactedValue.
+// It is meant to be used to log the payload, not to parse it.
+//
+// The event type is the value of the X-GitHub-Event header, see WebHookType.
+func RedactPayload(event string, payload []byte) ([]byte, error) {
+	return (&PayloadRedactor{}).Redact(event, payload)
+}
+
+// Redact returns a copy of the webhook payload of the given event type with
+// the default sensitive fields and the fields listed in r.Deny, minus those
+// listed in r.Allow, replaced by RedactedValue.
+func (r *PayloadRedactor) Redact(event string, payload []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	var deny, allow [][]string
+	for _, p := range defaultRedactedPaths[""] {
+		deny = append(deny, strings.Split(p, "."))
+	}
+	for _, p := range defaultRedactedPaths[event] {
+		deny = append(deny, strings.Split(p, "."))
+	}
+	for _, p := range r.Deny {
+		deny = append(deny, strings.Split(p, "."))
+	}
+	for _, p := range r.Allow {
+		allow = append(allow, strings.Split(p, "."))
+	}
+
+	v = redactValue(v, nil, deny, allow)
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// redactValue replaces v, found at path, by RedactedValue if path is matched
+// by deny and not by allow, and otherwise redacts the values it contains.
+func redactValue(v interface{}, path []string, deny, allow [][]string) interface{} {
+	if v != nil && len(path) > 0 && matchAnyPath(deny, path) && !matchAnyPath(allow, path) {
+		return RedactedValue
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = redactValue(e, append(path[:len(path):len(path)], k), deny, allow)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactValue(e, append(path[:len(path):len(path)], strconv.Itoa(i)), deny, allow)
+		}
+	}
+	return v
+}
+
+func matchAnyPath(patterns [][]string, path []string) bool {
+	for _, pattern := range patterns {
+		if matchPath(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPath reports whether path is matched by pattern, where a "*" segment
+// matches any one segment and a "**" segment matches any number of segments.
+func matchPath(pattern, path []string) bool {
+	if len(pattern) == 0 {
+		return len(path) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(path); i++ {
+			if matchPath(pattern[1:], path[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(path) == 0 || (pattern[0] != "*" && pattern[0] != path[0]) {
+		return false
+	}
+	return matchPath(pattern[1:], path[1:])
+}
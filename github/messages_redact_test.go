@@ -0,0 +1,100 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func testRedactedJSON(t *testing.T, got []byte, want string) {
+	t.Helper()
+
+	var g, w interface{}
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatalf("Unable to unmarshal redacted JSON %s: %v", got, err)
+	}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatalf("Unable to unmarshal want JSON %s: %v", want, err)
+	}
+	if !cmp.Equal(g, w) {
+		t.Errorf("Redacted payload is %s, want %s", got, want)
+	}
+}
+
+func TestRedactPayload(t *testing.T) {
+	tests := []struct {
+		event   string
+		payload string
+		want    string
+	}{
+		{
+			event:   "installation",
+			payload: `{"action":"created","installation":{"id":1,"access_tokens_url":"u","token":"ghs_x"},"sender":{"login":"l"}}`,
+			want:    `{"action":"created","installation":{"id":1,"access_tokens_url":"u","token":"[REDACTED]"},"sender":{"login":"l"}}`,
+		},
+		{
+			event:   "repository_dispatch",
+			payload: `{"action":"deploy","client_payload":{"env":"prod","creds":{"key":"k"}},"repository":{"id":1}}`,
+			want:    `{"action":"deploy","client_payload":{"env":"[REDACTED]","creds":"[REDACTED]"},"repository":{"id":1}}`,
+		},
+		{
+			event:   "ping",
+			payload: `{"zen":"z","hook_id":123456789012,"hook":{"config":{"url":"u","secret":"s","insecure_ssl":"0"}}}`,
+			want:    `{"zen":"z","hook_id":123456789012,"hook":{"config":{"url":"u","secret":"[REDACTED]","insecure_ssl":"0"}}}`,
+		},
+		{
+			event:   "deploy_key",
+			payload: `{"action":"created","key":{"id":1,"key":"ssh-rsa AAA","signing_key":"k"}}`,
+			want:    `{"action":"created","key":{"id":1,"key":"[REDACTED]","signing_key":"[REDACTED]"}}`,
+		},
+		{
+			event:   "push",
+			payload: `{"ref":"r","commits":[{"id":"c","message":"<token>"}],"token":null}`,
+			want:    `{"ref":"r","commits":[{"id":"c","message":"<token>"}],"token":null}`,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := RedactPayload(test.event, []byte(test.payload))
+		if err != nil {
+			t.Fatalf("RedactPayload(%q) returned error: %v", test.event, err)
+		}
+		testRedactedJSON(t, got, test.want)
+	}
+}
+
+func TestRedactPayload_invalidPayload(t *testing.T) {
+	if _, err := RedactPayload("push", []byte("{")); err == nil {
+		t.Error("RedactPayload returned no error for invalid JSON")
+	}
+}
+
+func TestPayloadRedactor_Redact(t *testing.T) {
+	r := &PayloadRedactor{
+		Deny:  []string{"sender.email", "commits.*.author"},
+		Allow: []string{"client_payload.env"},
+	}
+
+	payload := `{
+		"client_payload": {"env": "prod", "secret": "s"},
+		"commits": [{"id": "a", "author": {"name": "n"}}, {"id": "b", "author": {"name": "m"}}],
+		"sender": {"login": "l", "email": "e"}
+	}`
+	got, err := r.Redact("repository_dispatch", []byte(payload))
+	if err != nil {
+		t.Fatalf("PayloadRedactor.Redact returned error: %v", err)
+	}
+
+	want := `{
+		"client_payload": {"env": "prod", "secret": "[REDACTED]"},
+		"commits": [{"id": "a", "author": "[REDACTED]"}, {"id": "b", "author": "[REDACTED]"}],
+		"sender": {"login": "l", "email": "[REDACTED]"}
+	}`
+	testRedactedJSON(t, got, want)
+}
@@ -450,10 +450,18 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &WorkflowDispatchEvent{},
 			messageType: "workflow_dispatch",
 		},
+		{
+			payload:     &WorkflowJobEvent{},
+			messageType: "workflow_job",
+		},
 		{
 			payload:     &WorkflowRunEvent{},
 			messageType: "workflow_run",
 		},
+		{
+			payload:     &DiscussionEvent{},
+			messageType: "discussion",
+		},
 	}
 
 	for _, test := range tests {
@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 )
@@ -189,6 +190,52 @@ func (s *MigrationService) MigrationArchiveURL(ctx context.Context, org string,
 	return loc, nil
 }
 
+// DownloadMigrationArchive streams a migration archive to w.
+// id is the migration ID.
+//
+// The archive is fetched from the location GitHub redirects to without the
+// client's transport, so that the client's credentials are not sent to the
+// storage backend.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/migrations/#download-an-organization-migration-archive
+func (s *MigrationService) DownloadMigrationArchive(ctx context.Context, org string, id int64, w io.Writer) error {
+	loc, err := s.MigrationArchiveURL(ctx, org, id)
+	if err != nil {
+		return err
+	}
+
+	return s.downloadMigrationArchive(ctx, loc, w)
+}
+
+// downloadMigrationArchive streams the archive at loc to w. A relative loc is
+// resolved against the client's BaseURL.
+func (s *MigrationService) downloadMigrationArchive(ctx context.Context, loc string, w io.Writer) error {
+	u, err := s.client.BaseURL.Parse(loc)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return err
+	}
+	req = withContext(ctx, req)
+	req.Header.Set("Accept", "*/*")
+
+	resp, err := redirectClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckResponse(resp); err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
 // DeleteMigration deletes a previous migration archive.
 // id is the migration ID.
 //
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net/http"
@@ -160,6 +161,62 @@ func TestMigrationService_MigrationArchiveURL(t *testing.T) {
 	})
 }
 
+func TestMigrationService_DownloadMigrationArchive(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+	client.client.Transport = authTransport()
+
+	mux.HandleFunc("/orgs/o/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeMigrationsPreview)
+
+		http.Redirect(w, r, serverURL+baseURLPath+"/yo", http.StatusFound)
+	})
+	mux.HandleFunc("/yo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testNoAuthorization(t, r)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("0123456789abcdef"))
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	if err := client.Migrations.DownloadMigrationArchive(ctx, "o", 1, &buf); err != nil {
+		t.Errorf("DownloadMigrationArchive returned error: %v", err)
+	}
+	if got, want := buf.String(), "0123456789abcdef"; got != want {
+		t.Errorf("DownloadMigrationArchive wrote %q, want %q", got, want)
+	}
+
+	const methodName = "DownloadMigrationArchive"
+	testBadOptions(t, methodName, func() (err error) {
+		return client.Migrations.DownloadMigrationArchive(ctx, "\n", -1, &buf)
+	})
+}
+
+func TestMigrationService_DownloadMigrationArchive_notFound(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/yo", http.StatusFound)
+	})
+	mux.HandleFunc("/yo", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	err := client.Migrations.DownloadMigrationArchive(ctx, "o", 1, &buf)
+	if err == nil {
+		t.Error("DownloadMigrationArchive returned no error, want error")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("DownloadMigrationArchive wrote %q, want nothing", buf.String())
+	}
+}
+
 func TestMigrationService_DeleteMigration(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
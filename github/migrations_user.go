@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -175,6 +176,23 @@ func (s *MigrationService) UserMigrationArchiveURL(ctx context.Context, id int64
 	return loc, nil
 }
 
+// DownloadUserMigrationArchive streams a user migration archive to w.
+// id is the migration ID.
+//
+// As with DownloadMigrationArchive, the archive is fetched without the
+// client's transport so that its credentials are not sent to the storage
+// backend.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/migrations/#download-a-user-migration-archive
+func (s *MigrationService) DownloadUserMigrationArchive(ctx context.Context, id int64, w io.Writer) error {
+	loc, err := s.UserMigrationArchiveURL(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.downloadMigrationArchive(ctx, loc, w)
+}
+
 // DeleteUserMigration will delete a previous migration archive.
 // id is the migration ID.
 //
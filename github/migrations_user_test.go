@@ -6,6 +6,7 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net/http"
@@ -148,6 +149,36 @@ func TestMigrationService_UserMigrationArchiveURL(t *testing.T) {
 	}
 }
 
+func TestMigrationService_DownloadUserMigrationArchive(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+	client.client.Transport = authTransport()
+
+	mux.HandleFunc("/user/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeMigrationsPreview)
+
+		http.Redirect(w, r, serverURL+baseURLPath+"/go-github", http.StatusFound)
+	})
+
+	mux.HandleFunc("/go-github", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testNoAuthorization(t, r)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("archive"))
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	if err := client.Migrations.DownloadUserMigrationArchive(ctx, 1, &buf); err != nil {
+		t.Errorf("DownloadUserMigrationArchive returned error %v", err)
+	}
+	if got, want := buf.String(), "archive"; got != want {
+		t.Errorf("DownloadUserMigrationArchive wrote %q, want %q", got, want)
+	}
+}
+
 func TestMigrationService_DeleteUserMigration(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -9,7 +9,11 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net"
 	"net/url"
+	"strings"
+	"sync"
+	"time"
 )
 
 // MarkdownOptions specifies optional parameters to the Markdown method.
@@ -65,10 +69,55 @@ func (c *Client) Markdown(ctx context.Context, text string, opts *MarkdownOption
 	return buf.String(), resp, nil
 }
 
+// DefaultEmojiCacheTTL is the duration for which the emoji list is cached
+// when EnableEmojiCache is called with a zero ttl.
+const DefaultEmojiCacheTTL = time.Hour
+
+// emojiCache holds the emoji list returned by ListEmojis when caching has been
+// enabled with EnableEmojiCache.
+type emojiCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration // zero means caching is disabled
+	emojis  map[string]string
+	expires time.Time
+}
+
+// EnableEmojiCache makes ListEmojis keep the emoji list in memory for ttl,
+// so that repeated calls within that duration don't make a network request.
+// If ttl is zero, DefaultEmojiCacheTTL is used. A negative ttl disables the
+// cache and discards any cached list.
+func (c *Client) EnableEmojiCache(ttl time.Duration) {
+	c.emojis.mu.Lock()
+	defer c.emojis.mu.Unlock()
+
+	switch {
+	case ttl == 0:
+		ttl = DefaultEmojiCacheTTL
+	case ttl < 0:
+		ttl = 0
+	}
+	c.emojis.ttl = ttl
+	c.emojis.emojis = nil
+	c.emojis.expires = time.Time{}
+}
+
 // ListEmojis returns the emojis available to use on GitHub.
 //
+// If the emoji cache has been enabled with EnableEmojiCache and holds a list
+// that has not expired yet, a copy of that list is returned without making a
+// network request, along with an empty Response. Its embedded *http.Response
+// is nil and its Rate is zero, since no response was received.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/emojis/
 func (c *Client) ListEmojis(ctx context.Context) (map[string]string, *Response, error) {
+	c.emojis.mu.Lock()
+	if c.emojis.ttl > 0 && c.emojis.emojis != nil && time.Now().Before(c.emojis.expires) {
+		emoji := copyEmojis(c.emojis.emojis)
+		c.emojis.mu.Unlock()
+		return emoji, &Response{}, nil
+	}
+	c.emojis.mu.Unlock()
+
 	req, err := c.NewRequest("GET", "emojis", nil)
 	if err != nil {
 		return nil, nil, err
@@ -80,9 +129,38 @@ func (c *Client) ListEmojis(ctx context.Context) (map[string]string, *Response,
 		return nil, resp, err
 	}
 
+	c.emojis.mu.Lock()
+	if c.emojis.ttl > 0 {
+		c.emojis.emojis = copyEmojis(emoji)
+		c.emojis.expires = time.Now().Add(c.emojis.ttl)
+	}
+	c.emojis.mu.Unlock()
+
 	return emoji, resp, nil
 }
 
+// EmojiURL returns the image URL of the emoji with the given name, such as
+// "+1" or ":+1:". It returns an empty string if no such emoji exists.
+//
+// EmojiURL fetches the whole emoji list using ListEmojis, so enabling the
+// emoji cache with EnableEmojiCache is recommended when calling it repeatedly.
+func (c *Client) EmojiURL(ctx context.Context, name string) (string, *Response, error) {
+	emojis, resp, err := c.ListEmojis(ctx)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return emojis[strings.Trim(name, ":")], resp, nil
+}
+
+func copyEmojis(emojis map[string]string) map[string]string {
+	m := make(map[string]string, len(emojis))
+	for k, v := range emojis {
+		m[k] = v
+	}
+	return m
+}
+
 // CodeOfConduct represents a code of conduct.
 type CodeOfConduct struct {
 	Name *string `json:"name,omitempty"`
@@ -192,6 +270,61 @@ func (c *Client) APIMeta(ctx context.Context) (*APIMeta, *Response, error) {
 	return meta, resp, nil
 }
 
+// hookIPCacheTTL is the duration for which ValidateHookIP caches the hooks
+// CIDRs returned by APIMeta.
+const hookIPCacheTTL = 10 * time.Minute
+
+// hookIPCache holds the hooks CIDRs used by ValidateHookIP.
+type hookIPCache struct {
+	mu      sync.Mutex
+	nets    []*net.IPNet
+	expires time.Time
+}
+
+// ValidateHookIP reports whether ip is within the CIDRs that GitHub sends
+// webhooks from, as listed in the Hooks field of APIMeta. It is meant as an
+// additional check on top of validating the payload signature with
+// ValidatePayload, not as a replacement for it.
+//
+// The CIDRs are cached for a few minutes. Calls answered from the cache
+// don't make a network request and return an empty Response, as ListEmojis
+// does for its cache.
+func (c *Client) ValidateHookIP(ctx context.Context, ip net.IP) (bool, *Response, error) {
+	c.hookIPs.mu.Lock()
+	nets, expires := c.hookIPs.nets, c.hookIPs.expires
+	c.hookIPs.mu.Unlock()
+
+	resp := &Response{}
+	if nets == nil || !time.Now().Before(expires) {
+		meta, r, err := c.APIMeta(ctx)
+		resp = r
+		if err != nil {
+			return false, resp, err
+		}
+
+		nets = make([]*net.IPNet, 0, len(meta.Hooks))
+		for _, cidr := range meta.Hooks {
+			_, ipNet, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return false, resp, fmt.Errorf("invalid hooks CIDR %q in API meta: %v", cidr, err)
+			}
+			nets = append(nets, ipNet)
+		}
+
+		c.hookIPs.mu.Lock()
+		c.hookIPs.nets = nets
+		c.hookIPs.expires = time.Now().Add(hookIPCacheTTL)
+		c.hookIPs.mu.Unlock()
+	}
+
+	for _, ipNet := range nets {
+		if ipNet.Contains(ip) {
+			return true, resp, nil
+		}
+	}
+	return false, resp, nil
+}
+
 // Octocat returns an ASCII art octocat with the specified message in a speech
 // bubble. If message is empty, a random zen phrase is used.
 func (c *Client) Octocat(ctx context.Context, message string) (string, *Response, error) {
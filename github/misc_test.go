@@ -9,8 +9,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -91,6 +93,91 @@ func TestListEmojis(t *testing.T) {
 	})
 }
 
+func TestListEmojis_cache(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/emojis", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		fmt.Fprint(w, `{"+1": "+1.png"}`)
+	})
+
+	client.EnableEmojiCache(0)
+	if got, want := client.emojis.ttl, DefaultEmojiCacheTTL; got != want {
+		t.Errorf("EnableEmojiCache(0) set TTL to %v, want %v", got, want)
+	}
+
+	ctx := context.Background()
+	want := map[string]string{"+1": "+1.png"}
+	for i := 0; i < 2; i++ {
+		emoji, resp, err := client.ListEmojis(ctx)
+		if err != nil {
+			t.Errorf("ListEmojis returned error: %v", err)
+		}
+		if resp == nil {
+			t.Fatal("ListEmojis returned a nil Response")
+		}
+		if !cmp.Equal(want, emoji) {
+			t.Errorf("ListEmojis returned %+v, want %+v", emoji, want)
+		}
+		// Mutating the returned map must not affect the cache.
+		emoji["-1"] = "-1.png"
+	}
+	if calls != 1 {
+		t.Errorf("ListEmojis made %v requests, want 1", calls)
+	}
+
+	// An expired cache is refreshed.
+	client.emojis.expires = time.Now().Add(-time.Second)
+	if _, _, err := client.ListEmojis(ctx); err != nil {
+		t.Errorf("ListEmojis returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("ListEmojis made %v requests, want 2", calls)
+	}
+
+	// A disabled cache always fetches the list.
+	client.EnableEmojiCache(-1)
+	if _, _, err := client.ListEmojis(ctx); err != nil {
+		t.Errorf("ListEmojis returned error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("ListEmojis made %v requests, want 3", calls)
+	}
+}
+
+func TestEmojiURL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/emojis", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"+1": "+1.png"}`)
+	})
+
+	ctx := context.Background()
+	for name, want := range map[string]string{"+1": "+1.png", ":+1:": "+1.png", "unknown": ""} {
+		got, _, err := client.EmojiURL(ctx, name)
+		if err != nil {
+			t.Errorf("EmojiURL returned error: %v", err)
+		}
+		if got != want {
+			t.Errorf("EmojiURL(%q) returned %q, want %q", name, got, want)
+		}
+	}
+
+	const methodName = "EmojiURL"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.EmojiURL(ctx, "+1")
+		if got != "" {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want empty string", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestListCodesOfConduct(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -177,6 +264,77 @@ func TestGetCodeOfConduct(t *testing.T) {
 	})
 }
 
+func TestValidateHookIP(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/meta", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		fmt.Fprint(w, `{"hooks":["192.30.252.0/22","2a0a:a440::/29"]}`)
+	})
+
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"192.30.252.1", true},
+		{"2a0a:a440::1", true},
+		{"192.30.256.1", false},
+		{"10.0.0.1", false},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		ok, resp, err := client.ValidateHookIP(ctx, net.ParseIP(tt.ip))
+		if err != nil {
+			t.Fatalf("ValidateHookIP(%v) returned error: %v", tt.ip, err)
+		}
+		if resp == nil {
+			t.Fatalf("ValidateHookIP(%v) returned a nil Response", tt.ip)
+		}
+		if ok != tt.want {
+			t.Errorf("ValidateHookIP(%v) = %v, want %v", tt.ip, ok, tt.want)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("ValidateHookIP fetched the API meta %d times, want 1", calls)
+	}
+
+	// An expired cache is refreshed.
+	client.hookIPs.expires = time.Now().Add(-time.Second)
+	if _, resp, err := client.ValidateHookIP(ctx, net.ParseIP("10.0.0.1")); err != nil || resp.Response == nil {
+		t.Errorf("ValidateHookIP returned response %v and error %v, want an http.Response", resp, err)
+	}
+	if calls != 2 {
+		t.Errorf("ValidateHookIP fetched the API meta %d times, want 2", calls)
+	}
+}
+
+func TestValidateHookIP_invalidCIDR(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/meta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"hooks":["bogus"]}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.ValidateHookIP(ctx, net.ParseIP("10.0.0.1")); err == nil {
+		t.Error("ValidateHookIP returned no error for an invalid CIDR")
+	}
+
+	const methodName = "ValidateHookIP"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.ValidateHookIP(ctx, net.ParseIP("10.0.0.1"))
+		if got {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want false", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestAPIMeta_Marshal(t *testing.T) {
 	testJSONMarshal(t, &APIMeta{}, "{}")
 
@@ -0,0 +1,183 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// NodeID is a decoded GraphQL global node ID, such as the NodeID field of
+// most resources. Most resources also have a database ID, their ID field,
+// which is what the REST API expects in URLs. The REST endpoints that look up
+// a resource by database ID alone are:
+//
+//	GET /repositories/{id}   RepositoriesService.GetByID
+//	GET /user/{id}           UsersService.GetByID
+//	GET /organizations/{id}  OrganizationsService.GetByID
+//
+// Use ParseNodeID to recover the database ID from a node ID, and
+// Client.ResolveNodeID to fetch the corresponding REST resource.
+type NodeID struct {
+	// Type is the GraphQL type of the node, such as "Repository" or "User".
+	Type string
+	// DatabaseID is the database ID of the node, that is the ID field of
+	// the corresponding REST resource.
+	DatabaseID int64
+}
+
+// legacyNodeIDRE matches a decoded legacy node ID, such as "04:User1".
+var legacyNodeIDRE = regexp.MustCompile(`^\d+:([A-Za-z]+)(\d+)$`)
+
+// nodeIDPrefixTypes maps the prefixes of node IDs in the "next" format to
+// GraphQL types.
+var nodeIDPrefixTypes = map[string]string{
+	"R":  "Repository",
+	"U":  "User",
+	"O":  "Organization",
+	"I":  "Issue",
+	"PR": "PullRequest",
+	"IC": "IssueComment",
+	"T":  "Team",
+}
+
+// ParseNodeID decodes a GraphQL global node ID into its type and database ID.
+//
+// Both the legacy format, such as "MDQ6VXNlcjU4MzIzMQ==", and the newer
+// prefixed format, such as "U_kgDOAAjmPw", are supported. Node IDs are
+// meant to be opaque, so this is best effort: an error is returned for node
+// IDs that cannot be decoded.
+func ParseNodeID(nodeID string) (*NodeID, error) {
+	if i := strings.Index(nodeID, "_"); i > 0 {
+		return parseNextNodeID(nodeID[:i], nodeID[i+1:])
+	}
+
+	b, err := base64.StdEncoding.DecodeString(nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node ID %q: %v", nodeID, err)
+	}
+	m := legacyNodeIDRE.FindStringSubmatch(string(b))
+	if m == nil {
+		return nil, fmt.Errorf("invalid node ID %q", nodeID)
+	}
+	id, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node ID %q: %v", nodeID, err)
+	}
+	return &NodeID{Type: m[1], DatabaseID: id}, nil
+}
+
+// parseNextNodeID decodes the payload of a prefixed node ID. The payload is a
+// base64url encoded MessagePack array of integers whose last element is the
+// database ID of the node.
+func parseNextNodeID(prefix, payload string) (*NodeID, error) {
+	nodeID := prefix + "_" + payload
+	typ, ok := nodeIDPrefixTypes[prefix]
+	if !ok {
+		return nil, fmt.Errorf("unknown type prefix in node ID %q", nodeID)
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(payload, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid node ID %q: %v", nodeID, err)
+	}
+	if len(b) == 0 || b[0]&0xf0 != 0x90 {
+		return nil, fmt.Errorf("invalid node ID %q", nodeID)
+	}
+
+	n := int(b[0] & 0x0f)
+	b = b[1:]
+	var id int64
+	for i := 0; i < n; i++ {
+		if id, b, err = readMsgpackInt(b); err != nil {
+			return nil, fmt.Errorf("invalid node ID %q: %v", nodeID, err)
+		}
+	}
+	if n == 0 || len(b) != 0 {
+		return nil, fmt.Errorf("invalid node ID %q", nodeID)
+	}
+	return &NodeID{Type: typ, DatabaseID: id}, nil
+}
+
+// readMsgpackInt reads a MessagePack encoded integer from the start of b and
+// returns it along with the remaining bytes.
+func readMsgpackInt(b []byte) (int64, []byte, error) {
+	if len(b) == 0 {
+		return 0, nil, errors.New("unexpected end of data")
+	}
+	if b[0] <= 0x7f {
+		return int64(b[0]), b[1:], nil
+	}
+
+	var size int
+	switch b[0] {
+	case 0xcc, 0xd0:
+		size = 1
+	case 0xcd, 0xd1:
+		size = 2
+	case 0xce, 0xd2:
+		size = 4
+	case 0xcf, 0xd3:
+		size = 8
+	default:
+		return 0, nil, fmt.Errorf("unsupported type 0x%x", b[0])
+	}
+	if len(b) < 1+size {
+		return 0, nil, errors.New("unexpected end of data")
+	}
+
+	buf := make([]byte, 8)
+	copy(buf[8-size:], b[1:1+size])
+	v := binary.BigEndian.Uint64(buf)
+	if b[0] >= 0xd0 {
+		// Sign-extend the signed integer types.
+		shift := uint(64 - 8*size)
+		return int64(v<<shift) >> shift, b[1+size:], nil
+	}
+	return int64(v), b[1+size:], nil
+}
+
+// ResolveNodeID fetches the REST resource identified by a GraphQL global node
+// ID. Only repository, user and organization node IDs can be resolved, since
+// they are the only resources the REST API can look up by database ID alone.
+// The returned value is a *Repository, a *User or an *Organization.
+func (c *Client) ResolveNodeID(ctx context.Context, nodeID string) (interface{}, *Response, error) {
+	id, err := ParseNodeID(nodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		v    interface{}
+		resp *Response
+	)
+	switch id.Type {
+	case "Repository":
+		var repo *Repository
+		repo, resp, err = c.Repositories.GetByID(ctx, id.DatabaseID)
+		v = repo
+	case "User":
+		var user *User
+		user, resp, err = c.Users.GetByID(ctx, id.DatabaseID)
+		v = user
+	case "Organization":
+		var org *Organization
+		org, resp, err = c.Organizations.GetByID(ctx, id.DatabaseID)
+		v = org
+	default:
+		return nil, nil, fmt.Errorf("cannot resolve node ID %q of type %v", nodeID, id.Type)
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
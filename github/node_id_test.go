@@ -0,0 +1,111 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseNodeID(t *testing.T) {
+	tests := []struct {
+		nodeID string
+		want   *NodeID
+	}{
+		{"MDQ6VXNlcjU4MzIzMQ==", &NodeID{Type: "User", DatabaseID: 583231}},
+		{"MDEwOlJlcG9zaXRvcnkxMjk2MjY5", &NodeID{Type: "Repository", DatabaseID: 1296269}},
+		{"U_kgDOAAjmPw", &NodeID{Type: "User", DatabaseID: 583231}},
+		{"R_kgDOABPHjQ", &NodeID{Type: "Repository", DatabaseID: 1296269}},
+		{"I_kwDOABPHjc4Nyr9P", &NodeID{Type: "Issue", DatabaseID: 231391055}},
+		{"O_kgAq", &NodeID{Type: "Organization", DatabaseID: 42}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseNodeID(tt.nodeID)
+		if err != nil {
+			t.Errorf("ParseNodeID(%q) returned error: %v", tt.nodeID, err)
+			continue
+		}
+		if !cmp.Equal(got, tt.want) {
+			t.Errorf("ParseNodeID(%q) = %+v, want %+v", tt.nodeID, got, tt.want)
+		}
+	}
+}
+
+func TestParseNodeID_invalid(t *testing.T) {
+	for _, nodeID := range []string{
+		"",
+		"not base64!",
+		"aGVsbG8=",     // "hello"
+		"X_kgDOAAjmPw", // unknown prefix
+		"U_kgDO",       // truncated
+		"U_kgDOAAjmPwA",
+	} {
+		if _, err := ParseNodeID(nodeID); err == nil {
+			t.Errorf("ParseNodeID(%q) returned no error", nodeID)
+		}
+	}
+}
+
+func TestClient_ResolveNodeID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repositories/1296269", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1296269,"node_id":"R_kgDOABPHjQ"}`)
+	})
+	mux.HandleFunc("/user/583231", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":583231,"node_id":"MDQ6VXNlcjU4MzIzMQ=="}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.ResolveNodeID(ctx, "R_kgDOABPHjQ")
+	if err != nil {
+		t.Fatalf("ResolveNodeID returned error: %v", err)
+	}
+	if want := (&Repository{ID: Int64(1296269), NodeID: String("R_kgDOABPHjQ")}); !cmp.Equal(got, want) {
+		t.Errorf("ResolveNodeID returned %+v, want %+v", got, want)
+	}
+
+	got, _, err = client.ResolveNodeID(ctx, "MDQ6VXNlcjU4MzIzMQ==")
+	if err != nil {
+		t.Fatalf("ResolveNodeID returned error: %v", err)
+	}
+	if want := (&User{ID: Int64(583231), NodeID: String("MDQ6VXNlcjU4MzIzMQ==")}); !cmp.Equal(got, want) {
+		t.Errorf("ResolveNodeID returned %+v, want %+v", got, want)
+	}
+
+	if _, _, err := client.ResolveNodeID(ctx, "I_kwDOABPHjc4Nyr9P"); err == nil {
+		t.Error("ResolveNodeID returned no error for an issue node ID")
+	}
+}
+
+func TestClient_ResolveNodeID_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/organizations/42", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	got, resp, err := client.ResolveNodeID(ctx, "O_kgAq")
+	if err == nil {
+		t.Error("ResolveNodeID returned no error")
+	}
+	if got != nil {
+		t.Errorf("ResolveNodeID returned %#v, want nil", got)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("ResolveNodeID returned response %v, want a 404", resp)
+	}
+}
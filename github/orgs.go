@@ -51,13 +51,12 @@ type Organization struct {
 	HasRepositoryProjects       *bool      `json:"has_repository_projects,omitempty"`
 
 	// DefaultRepoPermission can be one of: "read", "write", "admin", or "none". (Default: "read").
-	// It is only used in OrganizationsService.Edit.
 	DefaultRepoPermission *string `json:"default_repository_permission,omitempty"`
 	// DefaultRepoSettings can be one of: "read", "write", "admin", or "none". (Default: "read").
 	// It is only used in OrganizationsService.Get.
 	DefaultRepoSettings *string `json:"default_repository_settings,omitempty"`
 
-	// MembersCanCreateRepos default value is true and is only used in Organizations.Edit.
+	// MembersCanCreateRepos default value is true.
 	MembersCanCreateRepos *bool `json:"members_can_create_repositories,omitempty"`
 
 	// https://developer.github.com/changes/2019-12-03-internal-visibility-changes/#rest-v3-api
@@ -80,6 +79,13 @@ type Organization struct {
 	// MembersCanCreatePrivatePages toggles whether organization members can create private GitHub Pages sites.
 	MembersCanCreatePrivatePages *bool `json:"members_can_create_private_pages,omitempty"`
 
+	// WebCommitSignoffRequired toggles whether contributors are required to sign off on
+	// commits made through GitHub's web interface.
+	WebCommitSignoffRequired *bool `json:"web_commit_signoff_required,omitempty"`
+	// SecretScanningPushProtectionEnabledForNewRepos toggles whether secret scanning push
+	// protection is automatically enabled for new repositories.
+	SecretScanningPushProtectionEnabledForNewRepos *bool `json:"secret_scanning_push_protection_enabled_for_new_repositories,omitempty"`
+
 	// API URLs
 	URL              *string `json:"url,omitempty"`
 	EventsURL        *string `json:"events_url,omitempty"`
@@ -207,6 +213,9 @@ func (s *OrganizationsService) Get(ctx context.Context, org string) (*Organizati
 
 // GetByID fetches an organization.
 //
+// The id is the database ID of the organization, its ID field, and not its
+// node ID; use ParseNodeID to get the database ID from a node ID.
+//
 // Note: GetByID uses the undocumented GitHub API endpoint /organizations/:id.
 func (s *OrganizationsService) GetByID(ctx context.Context, id int64) (*Organization, *Response, error) {
 	u := fmt.Sprintf("organizations/%d", id)
@@ -194,13 +194,17 @@ func TestOrganizationsService_PingHook(t *testing.T) {
 
 	mux.HandleFunc("/orgs/o/hooks/1/pings", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
 	})
 
 	ctx := context.Background()
-	_, err := client.Organizations.PingHook(ctx, "o", 1)
+	resp, err := client.Organizations.PingHook(ctx, "o", 1)
 	if err != nil {
 		t.Errorf("Organizations.PingHook returned error: %v", err)
 	}
+	if got, want := resp.StatusCode, http.StatusNoContent; got != want {
+		t.Errorf("Organizations.PingHook returned status %v, want %v", got, want)
+	}
 
 	const methodName = "PingHook"
 	testBadOptions(t, methodName, func() (err error) {
@@ -0,0 +1,72 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MemberRepositoryPermissionsError is returned by
+// OrganizationsService.GetMemberRepositoryPermissions when the permission
+// could not be determined for some of the repositories.
+type MemberRepositoryPermissionsError struct {
+	// Errors maps repository names to the error encountered for them.
+	Errors map[string]error
+}
+
+func (e *MemberRepositoryPermissionsError) Error() string {
+	repos := make([]string, 0, len(e.Errors))
+	for repo := range e.Errors {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+
+	msgs := make([]string, len(repos))
+	for i, repo := range repos {
+		msgs[i] = fmt.Sprintf("%v: %v", repo, e.Errors[repo])
+	}
+	return fmt.Sprintf("failed to get permissions for %d repositories: %v", len(repos), strings.Join(msgs, "; "))
+}
+
+// GetMemberRepositoryPermissions gets the permission level user has on each
+// of the given repositories of org, keyed by repository name. It calls
+// RepositoriesService.GetPermissionLevel for each repository, with a bounded
+// number of requests in flight.
+//
+// If some lookups fail, the permissions that could be determined are returned
+// together with a *MemberRepositoryPermissionsError. Once GitHub reports that
+// the rate limit is exceeded, no further requests are made and the remaining
+// repositories fail with the same rate limit error.
+func (s *OrganizationsService) GetMemberRepositoryPermissions(ctx context.Context, org, user string, repos []string) (map[string]*RepositoryPermissionLevel, error) {
+	var (
+		mu          sync.Mutex
+		permissions = make(map[string]*RepositoryPermissionLevel)
+	)
+
+	failed := runBounded(ctx, len(repos), defaultConcurrentRequests, func(i int) error {
+		p, _, err := s.client.Repositories.GetPermissionLevel(ctx, org, repos[i], user)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		permissions[repos[i]] = p
+		mu.Unlock()
+		return nil
+	})
+	errs := make(map[string]error)
+	for i, err := range failed {
+		errs[repos[i]] = err
+	}
+
+	if len(errs) > 0 {
+		return permissions, &MemberRepositoryPermissionsError{Errors: errs}
+	}
+	return permissions, nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_GetMemberRepositoryPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var (
+		mu                sync.Mutex
+		inFlight, maxSeen int
+	)
+	repos := make([]string, 12)
+	for i := range repos {
+		repos[i] = fmt.Sprintf("r%d", i)
+		mux.HandleFunc(fmt.Sprintf("/repos/o/r%d/collaborators/u/permission", i), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+
+			mu.Lock()
+			inFlight++
+			if inFlight > maxSeen {
+				maxSeen = inFlight
+			}
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+
+			fmt.Fprint(w, `{"permission":"write","user":{"login":"u"}}`)
+		})
+	}
+
+	ctx := context.Background()
+	permissions, err := client.Organizations.GetMemberRepositoryPermissions(ctx, "o", "u", repos)
+	if err != nil {
+		t.Errorf("Organizations.GetMemberRepositoryPermissions returned error: %v", err)
+	}
+
+	want := make(map[string]*RepositoryPermissionLevel)
+	for _, repo := range repos {
+		want[repo] = &RepositoryPermissionLevel{Permission: String("write"), User: &User{Login: String("u")}}
+	}
+	if !cmp.Equal(permissions, want) {
+		t.Errorf("Organizations.GetMemberRepositoryPermissions returned %+v, want %+v", permissions, want)
+	}
+	if maxSeen > defaultConcurrentRequests {
+		t.Errorf("Organizations.GetMemberRepositoryPermissions made %d concurrent requests, want at most %d", maxSeen, defaultConcurrentRequests)
+	}
+}
+
+func TestOrganizationsService_GetMemberRepositoryPermissions_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/a/collaborators/u/permission", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"permission":"admin"}`)
+	})
+	mux.HandleFunc("/repos/o/b/collaborators/u/permission", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/c/collaborators/u/permission", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Server Error"}`, http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	permissions, err := client.Organizations.GetMemberRepositoryPermissions(ctx, "o", "u", []string{"a", "b", "c"})
+
+	want := map[string]*RepositoryPermissionLevel{"a": {Permission: String("admin")}}
+	if !cmp.Equal(permissions, want) {
+		t.Errorf("Organizations.GetMemberRepositoryPermissions returned %+v, want %+v", permissions, want)
+	}
+
+	e, ok := err.(*MemberRepositoryPermissionsError)
+	if !ok {
+		t.Fatalf("Organizations.GetMemberRepositoryPermissions returned error %v, want *MemberRepositoryPermissionsError", err)
+	}
+	if len(e.Errors) != 2 || e.Errors["b"] == nil || e.Errors["c"] == nil {
+		t.Errorf("MemberRepositoryPermissionsError.Errors = %v, want errors for b and c", e.Errors)
+	}
+	if msg := e.Error(); !strings.Contains(msg, "b: ") || !strings.Contains(msg, "c: ") {
+		t.Errorf("MemberRepositoryPermissionsError.Error() = %q, want it to mention b and c", msg)
+	}
+}
+
+func TestOrganizationsService_GetMemberRepositoryPermissions_rateLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	reset := time.Now().Add(time.Hour).Unix()
+	repos := make([]string, 12)
+	for i := range repos {
+		repos[i] = fmt.Sprintf("r%d", i)
+		mux.HandleFunc(fmt.Sprintf("/repos/o/r%d/collaborators/u/permission", i), func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			calls++
+			mu.Unlock()
+
+			w.Header().Set(headerRateLimit, "60")
+			w.Header().Set(headerRateRemaining, "0")
+			w.Header().Set(headerRateReset, fmt.Sprint(reset))
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"API rate limit exceeded for xxx.xxx.xxx.xxx."}`)
+		})
+	}
+
+	ctx := context.Background()
+	_, err := client.Organizations.GetMemberRepositoryPermissions(ctx, "o", "u", repos)
+
+	e, ok := err.(*MemberRepositoryPermissionsError)
+	if !ok {
+		t.Fatalf("Organizations.GetMemberRepositoryPermissions returned error %v, want *MemberRepositoryPermissionsError", err)
+	}
+	if got, want := len(e.Errors), len(repos); got != want {
+		t.Errorf("MemberRepositoryPermissionsError has %d errors, want %d", got, want)
+	}
+	for repo, err := range e.Errors {
+		if _, ok := err.(*RateLimitError); !ok {
+			t.Errorf("MemberRepositoryPermissionsError.Errors[%q] = %v, want *RateLimitError", repo, err)
+		}
+	}
+	if calls > defaultConcurrentRequests {
+		t.Errorf("Organizations.GetMemberRepositoryPermissions made %d requests after hitting the rate limit, want at most %d", calls, defaultConcurrentRequests)
+	}
+}
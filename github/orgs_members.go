@@ -8,6 +8,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 )
 
 // Membership represents the status of a user's membership in an organization or team.
@@ -64,13 +66,46 @@ type ListMembersOptions struct {
 	// Default is "all".
 	Role string `url:"role,omitempty"`
 
+	// If true, populate User.RoleName of every returned member. When Role
+	// is admin or member the role is known from the filter; otherwise the
+	// membership of each member is fetched with an additional request, so
+	// a page of N members costs N+1 requests against the rate limit.
+	IncludeRole bool `url:"-"`
+
 	ListOptions
 }
 
+// MemberRolesError is returned by OrganizationsService.ListMembers when
+// IncludeRole is set and the role of some of the members could not be
+// fetched. The members are still returned, without RoleName for those.
+type MemberRolesError struct {
+	// Errors maps member logins to the error encountered for them.
+	Errors map[string]error
+}
+
+func (e *MemberRolesError) Error() string {
+	logins := make([]string, 0, len(e.Errors))
+	for login := range e.Errors {
+		logins = append(logins, login)
+	}
+	sort.Strings(logins)
+
+	msgs := make([]string, len(logins))
+	for i, login := range logins {
+		msgs[i] = fmt.Sprintf("%v: %v", login, e.Errors[login])
+	}
+	return fmt.Sprintf("failed to get roles for %d members: %v", len(logins), strings.Join(msgs, "; "))
+}
+
 // ListMembers lists the members for an organization. If the authenticated
 // user is an owner of the organization, this will return both concealed and
 // public members, otherwise it will only return public members.
 //
+// When Role is admin or member, User.RoleName of every returned member is
+// set accordingly. When Filter is 2fa_disabled, User.TwoFactorAuthentication
+// is set to false. If IncludeRole is set and some role lookups fail, the
+// members are returned together with a *MemberRolesError.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#list-organization-members
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#list-public-organization-members
 func (s *OrganizationsService) ListMembers(ctx context.Context, org string, opts *ListMembersOptions) ([]*User, *Response, error) {
@@ -96,6 +131,36 @@ func (s *OrganizationsService) ListMembers(ctx context.Context, org string, opts
 		return nil, resp, err
 	}
 
+	if opts == nil {
+		return members, resp, nil
+	}
+
+	var roleErrs map[string]error
+	for _, m := range members {
+		if opts.Filter == "2fa_disabled" && m.TwoFactorAuthentication == nil {
+			m.TwoFactorAuthentication = Bool(false)
+		}
+
+		switch {
+		case m.RoleName != nil:
+		case opts.Role == "admin" || opts.Role == "member":
+			m.RoleName = String(opts.Role)
+		case opts.IncludeRole:
+			membership, _, err := s.GetOrgMembership(ctx, m.GetLogin(), org)
+			if err != nil {
+				if roleErrs == nil {
+					roleErrs = make(map[string]error)
+				}
+				roleErrs[m.GetLogin()] = err
+				continue
+			}
+			m.RoleName = membership.Role
+		}
+	}
+
+	if roleErrs != nil {
+		return members, resp, &MemberRolesError{Errors: roleErrs}
+	}
 	return members, resp, nil
 }
 
@@ -261,6 +326,39 @@ func (s *OrganizationsService) EditOrgMembership(ctx context.Context, user, org
 	return m, resp, nil
 }
 
+// MembershipRole represents the role a user holds within an organization.
+type MembershipRole string
+
+// This is the set of roles that can be assigned to an organization member.
+const (
+	MembershipRoleAdmin  MembershipRole = "admin"
+	MembershipRoleMember MembershipRole = "member"
+)
+
+// SetOrgMembershipRole sets the role of user in the specified organization.
+// If the user is not yet a member, they will be sent an invitation.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#set-organization-membership-for-a-user
+func (s *OrganizationsService) SetOrgMembershipRole(ctx context.Context, user, org string, role MembershipRole) (*Membership, *Response, error) {
+	if role != MembershipRoleAdmin && role != MembershipRoleMember {
+		return nil, nil, fmt.Errorf("invalid membership role %q", role)
+	}
+
+	u := fmt.Sprintf("orgs/%v/memberships/%v", org, user)
+	req, err := s.client.NewRequest("PUT", u, &Membership{Role: String(string(role))})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(Membership)
+	resp, err := s.client.Do(ctx, req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, nil
+}
+
 // RemoveOrgMembership removes user from the specified organization. If the
 // user has been invited to the organization, this will cancel their invitation.
 //
@@ -342,6 +440,9 @@ func (s *OrganizationsService) CreateOrgInvitation(ctx context.Context, org stri
 // ListOrgInvitationTeams lists all teams associated with an invitation. In order to see invitations in an organization,
 // the authenticated user must be an organization owner.
 //
+// invitationID is the decimal Invitation.ID of the invitation, such as returned by
+// ListPendingOrgInvitations or CreateOrgInvitation. The teams are paginated with opts.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#list-organization-invitation-teams
 func (s *OrganizationsService) ListOrgInvitationTeams(ctx context.Context, org, invitationID string, opts *ListOptions) ([]*Team, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/invitations/%v/teams", org, invitationID)
@@ -42,7 +42,7 @@ func TestOrganizationsService_ListMembers(t *testing.T) {
 		t.Errorf("Organizations.ListMembers returned error: %v", err)
 	}
 
-	want := []*User{{ID: Int64(1)}}
+	want := []*User{{ID: Int64(1), RoleName: String("admin"), TwoFactorAuthentication: Bool(false)}}
 	if !cmp.Equal(members, want) {
 		t.Errorf("Organizations.ListMembers returned %+v, want %+v", members, want)
 	}
@@ -62,6 +62,83 @@ func TestOrganizationsService_ListMembers(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_ListMembers_includeRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"filter": "2fa_disabled"})
+		fmt.Fprint(w, `[{"login":"a"},{"login":"b"}]`)
+	})
+	mux.HandleFunc("/orgs/o/memberships/a", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"role":"admin"}`)
+	})
+	mux.HandleFunc("/orgs/o/memberships/b", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"role":"member"}`)
+	})
+
+	opt := &ListMembersOptions{Filter: "2fa_disabled", IncludeRole: true}
+	ctx := context.Background()
+	members, _, err := client.Organizations.ListMembers(ctx, "o", opt)
+	if err != nil {
+		t.Errorf("Organizations.ListMembers returned error: %v", err)
+	}
+
+	want := []*User{
+		{Login: String("a"), RoleName: String("admin"), TwoFactorAuthentication: Bool(false)},
+		{Login: String("b"), RoleName: String("member"), TwoFactorAuthentication: Bool(false)},
+	}
+	if !cmp.Equal(members, want) {
+		t.Errorf("Organizations.ListMembers returned %+v, want %+v", members, want)
+	}
+}
+
+func TestOrganizationsService_ListMembers_includeRoleError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"login":"a"},{"login":"b"}]`)
+	})
+	mux.HandleFunc("/orgs/o/memberships/a", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/orgs/o/memberships/b", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"role":"member"}`)
+	})
+
+	opt := &ListMembersOptions{IncludeRole: true}
+	ctx := context.Background()
+	members, _, err := client.Organizations.ListMembers(ctx, "o", opt)
+	rolesErr, ok := err.(*MemberRolesError)
+	if !ok {
+		t.Fatalf("Organizations.ListMembers returned error %v, want *MemberRolesError", err)
+	}
+	if _, ok := rolesErr.Errors["a"].(*ErrorResponse); !ok || len(rolesErr.Errors) != 1 {
+		t.Errorf("MemberRolesError.Errors = %v, want a single *ErrorResponse for a", rolesErr.Errors)
+	}
+
+	want := []*User{
+		{Login: String("a")},
+		{Login: String("b"), RoleName: String("member")},
+	}
+	if !cmp.Equal(members, want) {
+		t.Errorf("Organizations.ListMembers returned %+v, want %+v", members, want)
+	}
+}
+
+func TestMemberRolesError_Error(t *testing.T) {
+	err := &MemberRolesError{Errors: map[string]error{"b": fmt.Errorf("y"), "a": fmt.Errorf("x")}}
+	want := "failed to get roles for 2 members: a: x; b: y"
+	if got := err.Error(); got != want {
+		t.Errorf("MemberRolesError.Error() = %q, want %q", got, want)
+	}
+}
+
 func TestOrganizationsService_ListMembers_invalidOrg(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -511,6 +588,60 @@ func TestOrganizationsService_EditOrgMembership_SpecifiedUser(t *testing.T) {
 	}
 }
 
+func TestOrganizationsService_SetOrgMembershipRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/memberships/u", func(w http.ResponseWriter, r *http.Request) {
+		v := new(Membership)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		want := &Membership{Role: String("admin")}
+		if !cmp.Equal(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"url":"u","role":"admin"}`)
+	})
+
+	ctx := context.Background()
+	membership, _, err := client.Organizations.SetOrgMembershipRole(ctx, "u", "o", MembershipRoleAdmin)
+	if err != nil {
+		t.Errorf("Organizations.SetOrgMembershipRole returned error: %v", err)
+	}
+
+	want := &Membership{URL: String("u"), Role: String("admin")}
+	if !cmp.Equal(membership, want) {
+		t.Errorf("Organizations.SetOrgMembershipRole returned %+v, want %+v", membership, want)
+	}
+
+	const methodName = "SetOrgMembershipRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.SetOrgMembershipRole(ctx, "\n", "\n", MembershipRoleAdmin)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.SetOrgMembershipRole(ctx, "u", "o", MembershipRoleMember)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_SetOrgMembershipRole_invalidRole(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	_, _, err := client.Organizations.SetOrgMembershipRole(ctx, "u", "o", MembershipRole("owner"))
+	if err == nil {
+		t.Error("Organizations.SetOrgMembershipRole returned nil error, want error")
+	}
+}
+
 func TestOrganizationsService_RemoveOrgMembership(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -746,6 +877,44 @@ func TestOrganizationsService_ListOrgInvitationTeams(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_ListOrgInvitationTeams_multiplePages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/invitations/22/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "", "1":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/invitations/22/teams?page=2>; rel="next", <https://api.github.com/orgs/o/invitations/22/teams?page=2>; rel="last"`)
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("unexpected page %v", page)
+		}
+	})
+
+	ctx := context.Background()
+	opt := &ListOptions{}
+	var teams []*Team
+	for {
+		page, resp, err := client.Organizations.ListOrgInvitationTeams(ctx, "o", "22", opt)
+		if err != nil {
+			t.Fatalf("Organizations.ListOrgInvitationTeams returned error: %v", err)
+		}
+		teams = append(teams, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	want := []*Team{{ID: Int64(1)}, {ID: Int64(2)}, {ID: Int64(3)}}
+	if !cmp.Equal(teams, want) {
+		t.Errorf("Organizations.ListOrgInvitationTeams returned %+v, want %+v", teams, want)
+	}
+}
+
 func TestOrganizationsService_ListFailedOrgInvitations(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,186 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// PersonalAccessTokenPermissions represents the permissions granted to a
+// fine-grained personal access token, mapping each permission, such as
+// "contents", to its access level, such as "read" or "write".
+type PersonalAccessTokenPermissions struct {
+	Org   map[string]string `json:"organization,omitempty"`
+	Repo  map[string]string `json:"repository,omitempty"`
+	Other map[string]string `json:"other,omitempty"`
+}
+
+// PersonalAccessTokenRequest represents a request of a fine-grained personal
+// access token to access the resources of an organization.
+type PersonalAccessTokenRequest struct {
+	ID     *int64  `json:"id,omitempty"`
+	Reason *string `json:"reason,omitempty"`
+	// Owner is the user who requested access for the token.
+	Owner *User `json:"owner,omitempty"`
+	// Possible values for RepositorySelection are: none, all, subset. The
+	// repositories of a subset are listed at RepositoriesURL.
+	RepositorySelection *string                         `json:"repository_selection,omitempty"`
+	RepositoriesURL     *string                         `json:"repositories_url,omitempty"`
+	Permissions         *PersonalAccessTokenPermissions `json:"permissions,omitempty"`
+	CreatedAt           *Timestamp                      `json:"created_at,omitempty"`
+	TokenExpired        *bool                           `json:"token_expired,omitempty"`
+	TokenExpiresAt      *Timestamp                      `json:"token_expires_at,omitempty"`
+	TokenLastUsedAt     *Timestamp                      `json:"token_last_used_at,omitempty"`
+}
+
+// PersonalAccessToken represents a fine-grained personal access token that
+// has access to the resources of an organization.
+type PersonalAccessToken struct {
+	ID    *int64 `json:"id,omitempty"`
+	Owner *User  `json:"owner,omitempty"`
+	// Possible values for RepositorySelection are: none, all, subset. The
+	// repositories of a subset are listed at RepositoriesURL.
+	RepositorySelection *string                         `json:"repository_selection,omitempty"`
+	RepositoriesURL     *string                         `json:"repositories_url,omitempty"`
+	Permissions         *PersonalAccessTokenPermissions `json:"permissions,omitempty"`
+	AccessGrantedAt     *Timestamp                      `json:"access_granted_at,omitempty"`
+	TokenExpired        *bool                           `json:"token_expired,omitempty"`
+	TokenExpiresAt      *Timestamp                      `json:"token_expires_at,omitempty"`
+	TokenLastUsedAt     *Timestamp                      `json:"token_last_used_at,omitempty"`
+}
+
+// ListFineGrainedPATOptions specifies optional parameters to the
+// OrganizationsService.ListFineGrainedPATRequests and
+// OrganizationsService.ListFineGrainedPATsWithAccess methods.
+type ListFineGrainedPATOptions struct {
+	// Sort specifies how to sort the results. The only possible value is
+	// created_at, which is the default.
+	Sort string `url:"sort,omitempty"`
+
+	// Direction in which to sort the results. Possible values are: asc,
+	// desc. Default is "desc".
+	Direction string `url:"direction,omitempty"`
+
+	// Owner filters the results to the tokens of the given users.
+	Owner []string `url:"owner,omitempty,brackets"`
+
+	// Repository filters the results to the tokens with access to the given
+	// repository.
+	Repository string `url:"repository,omitempty"`
+
+	// Permission filters the results to the tokens with the given permission.
+	Permission string `url:"permission,omitempty"`
+
+	// LastUsedBefore and LastUsedAfter filter the results by the time the
+	// token was last used, in ISO 8601 format.
+	LastUsedBefore string `url:"last_used_before,omitempty"`
+	LastUsedAfter  string `url:"last_used_after,omitempty"`
+
+	ListOptions
+}
+
+// ReviewPersonalAccessTokenRequestOptions specifies the parameters to the
+// OrganizationsService.ReviewFineGrainedPATRequest method.
+type ReviewPersonalAccessTokenRequestOptions struct {
+	// Action is the review of the request. Possible values are: approve, deny.
+	Action string `json:"action"`
+	// Reason optionally explains the review to the requester.
+	Reason *string `json:"reason,omitempty"`
+}
+
+// UpdateFineGrainedPATAccessOptions specifies the parameters to the
+// OrganizationsService.UpdateFineGrainedPATAccess method.
+type UpdateFineGrainedPATAccessOptions struct {
+	// Action is the update to the access of the token. The only possible
+	// value is revoke.
+	Action string `json:"action"`
+}
+
+// ListFineGrainedPATRequests lists the requests of fine-grained personal
+// access tokens to access the resources of an organization that are pending
+// review. The authenticated user must be an owner of the organization, or
+// the request must be made by a GitHub App.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs#list-requests-to-access-organization-resources-with-fine-grained-personal-access-tokens
+func (s *OrganizationsService) ListFineGrainedPATRequests(ctx context.Context, org string, opts *ListFineGrainedPATOptions) ([]*PersonalAccessTokenRequest, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-token-requests", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var requests []*PersonalAccessTokenRequest
+	resp, err := s.client.Do(ctx, req, &requests)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return requests, resp, nil
+}
+
+// ReviewFineGrainedPATRequest approves or denies a request of a fine-grained
+// personal access token to access the resources of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs#review-a-request-to-access-organization-resources-with-a-fine-grained-personal-access-token
+func (s *OrganizationsService) ReviewFineGrainedPATRequest(ctx context.Context, org string, requestID int64, opts *ReviewPersonalAccessTokenRequestOptions) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-token-requests/%v", org, requestID)
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListFineGrainedPATsWithAccess lists the fine-grained personal access tokens
+// that have access to the resources of an organization. The authenticated
+// user must be an owner of the organization, or the request must be made by
+// a GitHub App.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs#list-fine-grained-personal-access-tokens-with-access-to-organization-resources
+func (s *OrganizationsService) ListFineGrainedPATsWithAccess(ctx context.Context, org string, opts *ListFineGrainedPATOptions) ([]*PersonalAccessToken, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-tokens", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tokens []*PersonalAccessToken
+	resp, err := s.client.Do(ctx, req, &tokens)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tokens, resp, nil
+}
+
+// UpdateFineGrainedPATAccess updates the access of a fine-grained personal
+// access token to the resources of an organization, which currently means
+// revoking it.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs#update-the-access-a-fine-grained-personal-access-token-has-to-organization-resources
+func (s *OrganizationsService) UpdateFineGrainedPATAccess(ctx context.Context, org string, patID int64, opts *UpdateFineGrainedPATAccessOptions) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-tokens/%v", org, patID)
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
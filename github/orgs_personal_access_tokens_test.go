@@ -0,0 +1,186 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_ListFineGrainedPATRequests(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-token-requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"owner[]": "u", "direction": "asc", "page": "2"})
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"reason": "r",
+			"owner": {"login": "u"},
+			"repository_selection": "subset",
+			"repositories_url": "https://api.github.com/organizations/1/personal-access-token-requests/1/repositories",
+			"permissions": {
+				"organization": {"members": "read"},
+				"repository": {"contents": "write"}
+			},
+			"created_at": "2021-01-01T00:00:00Z",
+			"token_expired": false,
+			"token_expires_at": "2021-02-01T00:00:00Z"
+		}]`)
+	})
+
+	opts := &ListFineGrainedPATOptions{Owner: []string{"u"}, Direction: "asc", ListOptions: ListOptions{Page: 2}}
+	ctx := context.Background()
+	requests, _, err := client.Organizations.ListFineGrainedPATRequests(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListFineGrainedPATRequests returned error: %v", err)
+	}
+
+	want := []*PersonalAccessTokenRequest{{
+		ID:                  Int64(1),
+		Reason:              String("r"),
+		Owner:               &User{Login: String("u")},
+		RepositorySelection: String("subset"),
+		RepositoriesURL:     String("https://api.github.com/organizations/1/personal-access-token-requests/1/repositories"),
+		Permissions: &PersonalAccessTokenPermissions{
+			Org:  map[string]string{"members": "read"},
+			Repo: map[string]string{"contents": "write"},
+		},
+		CreatedAt:      &Timestamp{time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		TokenExpired:   Bool(false),
+		TokenExpiresAt: &Timestamp{time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC)},
+	}}
+	if !cmp.Equal(requests, want) {
+		t.Errorf("Organizations.ListFineGrainedPATRequests returned %+v, want %+v", requests, want)
+	}
+
+	const methodName = "ListFineGrainedPATRequests"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListFineGrainedPATRequests(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListFineGrainedPATRequests(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_ReviewFineGrainedPATRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-token-requests/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"action":"approve","reason":"ok"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opts := &ReviewPersonalAccessTokenRequestOptions{Action: "approve", Reason: String("ok")}
+	ctx := context.Background()
+	_, err := client.Organizations.ReviewFineGrainedPATRequest(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Organizations.ReviewFineGrainedPATRequest returned error: %v", err)
+	}
+
+	const methodName = "ReviewFineGrainedPATRequest"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.ReviewFineGrainedPATRequest(ctx, "\n", 1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.ReviewFineGrainedPATRequest(ctx, "o", 1, opts)
+	})
+}
+
+func TestOrganizationsService_ListFineGrainedPATsWithAccess(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"repository": "r"})
+		fmt.Fprint(w, `[{
+			"id": 2,
+			"owner": {"login": "u"},
+			"repository_selection": "all",
+			"permissions": {"other": {"gpg_keys": "read"}},
+			"access_granted_at": "2021-01-01T00:00:00Z",
+			"token_last_used_at": "2021-01-02T00:00:00Z"
+		}]`)
+	})
+
+	opts := &ListFineGrainedPATOptions{Repository: "r"}
+	ctx := context.Background()
+	tokens, _, err := client.Organizations.ListFineGrainedPATsWithAccess(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListFineGrainedPATsWithAccess returned error: %v", err)
+	}
+
+	want := []*PersonalAccessToken{{
+		ID:                  Int64(2),
+		Owner:               &User{Login: String("u")},
+		RepositorySelection: String("all"),
+		Permissions:         &PersonalAccessTokenPermissions{Other: map[string]string{"gpg_keys": "read"}},
+		AccessGrantedAt:     &Timestamp{time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		TokenLastUsedAt:     &Timestamp{time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC)},
+	}}
+	if !cmp.Equal(tokens, want) {
+		t.Errorf("Organizations.ListFineGrainedPATsWithAccess returned %+v, want %+v", tokens, want)
+	}
+
+	const methodName = "ListFineGrainedPATsWithAccess"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListFineGrainedPATsWithAccess(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListFineGrainedPATsWithAccess(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_UpdateFineGrainedPATAccess(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-tokens/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"action":"revoke"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opts := &UpdateFineGrainedPATAccessOptions{Action: "revoke"}
+	ctx := context.Background()
+	_, err := client.Organizations.UpdateFineGrainedPATAccess(ctx, "o", 2, opts)
+	if err != nil {
+		t.Errorf("Organizations.UpdateFineGrainedPATAccess returned error: %v", err)
+	}
+
+	const methodName = "UpdateFineGrainedPATAccess"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.UpdateFineGrainedPATAccess(ctx, "\n", 2, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.UpdateFineGrainedPATAccess(ctx, "o", 2, opts)
+	})
+}
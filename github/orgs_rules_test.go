@@ -0,0 +1,330 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_GetAllOrganizationRulesets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":26110,"name":"test ruleset","target":"branch","source_type":"Organization","source":"o","enforcement":"active"}]`)
+	})
+
+	ctx := context.Background()
+	rulesets, _, err := client.Organizations.GetAllOrganizationRulesets(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.GetAllOrganizationRulesets returned error: %v", err)
+	}
+
+	want := []*Ruleset{{
+		ID:          Int64(26110),
+		Name:        String("test ruleset"),
+		Target:      String("branch"),
+		SourceType:  String("Organization"),
+		Source:      String("o"),
+		Enforcement: String("active"),
+	}}
+	if !cmp.Equal(rulesets, want) {
+		t.Errorf("Organizations.GetAllOrganizationRulesets returned %+v, want %+v", rulesets, want)
+	}
+
+	const methodName = "GetAllOrganizationRulesets"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetAllOrganizationRulesets(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetAllOrganizationRulesets(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_CreateOrganizationRuleset_repositoryProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Ruleset{
+		Name:        String("ruleset"),
+		Target:      String("branch"),
+		Enforcement: String("active"),
+		Conditions: &RulesetConditions{
+			RefName: &RulesetRefConditionParameters{
+				Include: []string{"~DEFAULT_BRANCH"},
+				Exclude: []string{},
+			},
+			RepositoryProperty: &RulesetRepositoryPropertyConditionParameters{
+				Include: []*RulesetRepositoryPropertyTargetParameters{
+					{Name: "environment", Values: []string{"production"}, Source: String("custom")},
+				},
+				Exclude: []*RulesetRepositoryPropertyTargetParameters{},
+			},
+		},
+	}
+
+	mux.HandleFunc("/orgs/o/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"ruleset","target":"branch","enforcement":"active","conditions":{"ref_name":{"include":["~DEFAULT_BRANCH"],"exclude":[]},"repository_property":{"include":[{"name":"environment","property_values":["production"],"source":"custom"}],"exclude":[]}}}`+"\n")
+		fmt.Fprint(w, `{
+			"id": 21,
+			"name": "ruleset",
+			"target": "branch",
+			"source_type": "Organization",
+			"source": "o",
+			"enforcement": "active",
+			"conditions": {
+				"ref_name": {"include": ["~DEFAULT_BRANCH"], "exclude": []},
+				"repository_property": {
+					"include": [{"name": "environment", "property_values": ["production"], "source": "custom"}],
+					"exclude": []
+				}
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	ruleset, _, err := client.Organizations.CreateOrganizationRuleset(ctx, "o", input)
+	if err != nil {
+		t.Errorf("Organizations.CreateOrganizationRuleset returned error: %v", err)
+	}
+
+	want := &Ruleset{
+		ID:          Int64(21),
+		Name:        String("ruleset"),
+		Target:      String("branch"),
+		SourceType:  String("Organization"),
+		Source:      String("o"),
+		Enforcement: String("active"),
+		Conditions:  input.Conditions,
+	}
+	if !cmp.Equal(ruleset, want) {
+		t.Errorf("Organizations.CreateOrganizationRuleset returned %+v, want %+v", ruleset, want)
+	}
+
+	const methodName = "CreateOrganizationRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.CreateOrganizationRuleset(ctx, "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.CreateOrganizationRuleset(ctx, "o", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetOrganizationRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/rulesets/26110", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":26110,"name":"test ruleset","conditions":{"repository_id":{"repository_ids":[1,2]}}}`)
+	})
+
+	ctx := context.Background()
+	ruleset, _, err := client.Organizations.GetOrganizationRuleset(ctx, "o", 26110)
+	if err != nil {
+		t.Errorf("Organizations.GetOrganizationRuleset returned error: %v", err)
+	}
+
+	want := &Ruleset{
+		ID:   Int64(26110),
+		Name: String("test ruleset"),
+		Conditions: &RulesetConditions{
+			RepositoryID: &RulesetRepositoryIDsConditionParameters{RepositoryIDs: []int64{1, 2}},
+		},
+	}
+	if !cmp.Equal(ruleset, want) {
+		t.Errorf("Organizations.GetOrganizationRuleset returned %+v, want %+v", ruleset, want)
+	}
+
+	const methodName = "GetOrganizationRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetOrganizationRuleset(ctx, "\n", 26110)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetOrganizationRuleset(ctx, "o", 26110)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_UpdateOrganizationRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Ruleset{Name: String("renamed"), Enforcement: String("evaluate")}
+
+	mux.HandleFunc("/orgs/o/rulesets/26110", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"name":"renamed","enforcement":"evaluate"}`+"\n")
+		fmt.Fprint(w, `{"id":26110,"name":"renamed","enforcement":"evaluate"}`)
+	})
+
+	ctx := context.Background()
+	ruleset, _, err := client.Organizations.UpdateOrganizationRuleset(ctx, "o", 26110, input)
+	if err != nil {
+		t.Errorf("Organizations.UpdateOrganizationRuleset returned error: %v", err)
+	}
+
+	want := &Ruleset{ID: Int64(26110), Name: String("renamed"), Enforcement: String("evaluate")}
+	if !cmp.Equal(ruleset, want) {
+		t.Errorf("Organizations.UpdateOrganizationRuleset returned %+v, want %+v", ruleset, want)
+	}
+
+	const methodName = "UpdateOrganizationRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.UpdateOrganizationRuleset(ctx, "\n", 26110, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.UpdateOrganizationRuleset(ctx, "o", 26110, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_DeleteOrganizationRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/rulesets/26110", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.DeleteOrganizationRuleset(ctx, "o", 26110); err != nil {
+		t.Errorf("Organizations.DeleteOrganizationRuleset returned error: %v", err)
+	}
+
+	const methodName = "DeleteOrganizationRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.DeleteOrganizationRuleset(ctx, "\n", 26110)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.DeleteOrganizationRuleset(ctx, "o", 26110)
+	})
+}
+
+func TestOrganizationsService_GetOrganizationRulesetHistory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/rulesets/42/history", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `[{"version_id":3,"actor":{"id":1,"type":"User"},"updated_at":"2021-01-02T00:00:00Z"}]`)
+	})
+
+	opt := &ListOptions{PerPage: 1}
+	ctx := context.Background()
+	versions, _, err := client.Organizations.GetOrganizationRulesetHistory(ctx, "o", 42, opt)
+	if err != nil {
+		t.Errorf("Organizations.GetOrganizationRulesetHistory returned error: %v", err)
+	}
+
+	want := []*RulesetVersion{{
+		VersionID: Int64(3),
+		Actor:     &RulesetVersionActor{ID: Int64(1), Type: String("User")},
+		UpdatedAt: &Timestamp{time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC)},
+	}}
+	if !cmp.Equal(versions, want) {
+		t.Errorf("Organizations.GetOrganizationRulesetHistory returned %+v, want %+v", versions, want)
+	}
+
+	const methodName = "GetOrganizationRulesetHistory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetOrganizationRulesetHistory(ctx, "\n", 42, opt)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetOrganizationRulesetHistory(ctx, "o", 42, opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetOrganizationRulesetVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/rulesets/42/history/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"version_id": 3,
+			"actor": {"id": 1, "type": "User"},
+			"updated_at": "2021-01-02T00:00:00Z",
+			"state": {"id": 42, "name": "all", "source_type": "Organization", "source": "o", "enforcement": "evaluate"}
+		}`)
+	})
+
+	ctx := context.Background()
+	version, _, err := client.Organizations.GetOrganizationRulesetVersion(ctx, "o", 42, 3)
+	if err != nil {
+		t.Errorf("Organizations.GetOrganizationRulesetVersion returned error: %v", err)
+	}
+
+	want := &RulesetVersionWithState{
+		VersionID: Int64(3),
+		Actor:     &RulesetVersionActor{ID: Int64(1), Type: String("User")},
+		UpdatedAt: &Timestamp{time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		State: &Ruleset{
+			ID:          Int64(42),
+			Name:        String("all"),
+			SourceType:  String("Organization"),
+			Source:      String("o"),
+			Enforcement: String("evaluate"),
+		},
+	}
+	if !cmp.Equal(version, want) {
+		t.Errorf("Organizations.GetOrganizationRulesetVersion returned %+v, want %+v", version, want)
+	}
+
+	const methodName = "GetOrganizationRulesetVersion"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetOrganizationRulesetVersion(ctx, "\n", 42, 3)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetOrganizationRulesetVersion(ctx, "o", 42, 3)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
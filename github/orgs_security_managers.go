@@ -0,0 +1,67 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListSecurityManagerTeams lists all teams that have been granted the
+// security manager role in an organization.
+//
+// If the security manager role is not available to the organization, GitHub
+// responds with 404 Not Found (or 403 Forbidden), which is returned as an
+// *ErrorResponse.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs#list-security-manager-teams
+func (s *OrganizationsService) ListSecurityManagerTeams(ctx context.Context, org string) ([]*Team, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/security-managers", org)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var teams []*Team
+	resp, err := s.client.Do(ctx, req, &teams)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return teams, resp, nil
+}
+
+// AddSecurityManagerTeam grants a team the security manager role in an
+// organization, giving its members read access to security alerts across
+// all of the organization's repositories.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs#add-a-security-manager-team
+func (s *OrganizationsService) AddSecurityManagerTeam(ctx context.Context, org, team string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/security-managers/teams/%v", org, team)
+
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveSecurityManagerTeam removes the security manager role from a team in
+// an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs#remove-a-security-manager-team
+func (s *OrganizationsService) RemoveSecurityManagerTeam(ctx context.Context, org, team string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/security-managers/teams/%v", org, team)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrganizationsService_ListSecurityManagerTeams(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/security-managers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"slug":"security"}]`)
+	})
+
+	ctx := context.Background()
+	teams, _, err := client.Organizations.ListSecurityManagerTeams(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.ListSecurityManagerTeams returned error: %v", err)
+	}
+
+	want := []*Team{{ID: Int64(1), Slug: String("security")}}
+	if !cmp.Equal(teams, want) {
+		t.Errorf("Organizations.ListSecurityManagerTeams returned %+v, want %+v", teams, want)
+	}
+
+	const methodName = "ListSecurityManagerTeams"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListSecurityManagerTeams(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListSecurityManagerTeams(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_ListSecurityManagerTeams_notEnabled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/security-managers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	teams, resp, err := client.Organizations.ListSecurityManagerTeams(ctx, "o")
+	if teams != nil {
+		t.Errorf("Organizations.ListSecurityManagerTeams returned %+v, want nil", teams)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Organizations.ListSecurityManagerTeams returned error %v, want *ErrorResponse", err)
+	}
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Errorf("Organizations.ListSecurityManagerTeams returned status %v, want %v", got, want)
+	}
+}
+
+func TestOrganizationsService_AddSecurityManagerTeam(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/security-managers/teams/t", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.AddSecurityManagerTeam(ctx, "o", "t")
+	if err != nil {
+		t.Errorf("Organizations.AddSecurityManagerTeam returned error: %v", err)
+	}
+
+	const methodName = "AddSecurityManagerTeam"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.AddSecurityManagerTeam(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.AddSecurityManagerTeam(ctx, "o", "t")
+	})
+}
+
+func TestOrganizationsService_RemoveSecurityManagerTeam(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/security-managers/teams/t", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.RemoveSecurityManagerTeam(ctx, "o", "t")
+	if err != nil {
+		t.Errorf("Organizations.RemoveSecurityManagerTeam returned error: %v", err)
+	}
+
+	const methodName = "RemoveSecurityManagerTeam"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.RemoveSecurityManagerTeam(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.RemoveSecurityManagerTeam(ctx, "o", "t")
+	})
+}
@@ -39,6 +39,8 @@ func TestOrganization_Marshal(t *testing.T) {
 		MembersCanCreatePages:                Bool(true),
 		MembersCanCreatePublicPages:          Bool(false),
 		MembersCanCreatePrivatePages:         Bool(true),
+		WebCommitSignoffRequired:             Bool(true),
+		SecretScanningPushProtectionEnabledForNewRepos: Bool(false),
 	}
 	want := `
 		{
@@ -61,7 +63,9 @@ func TestOrganization_Marshal(t *testing.T) {
 			"members_allowed_repository_creation_type": "all",
 			"members_can_create_pages": true,
 			"members_can_create_public_pages": false,
-			"members_can_create_private_pages": true
+			"members_can_create_private_pages": true,
+			"web_commit_signoff_required": true,
+			"secret_scanning_push_protection_enabled_for_new_repositories": false
 		}
 	`
 	testJSONMarshal(t, o, want)
@@ -306,6 +310,51 @@ func TestOrganizationsService_Edit(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_Edit_repositorySettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Organization{
+		DefaultRepoPermission:                          String("none"),
+		MembersCanCreateRepos:                          Bool(false),
+		MembersCanCreatePrivateRepos:                   Bool(false),
+		WebCommitSignoffRequired:                       Bool(true),
+		SecretScanningPushProtectionEnabledForNewRepos: Bool(true),
+	}
+
+	mux.HandleFunc("/orgs/o", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"default_repository_permission":"none","members_can_create_repositories":false,"members_can_create_private_repositories":false,"web_commit_signoff_required":true,"secret_scanning_push_protection_enabled_for_new_repositories":true}`+"\n")
+
+		fmt.Fprint(w, `{
+			"id": 1,
+			"default_repository_permission": "none",
+			"members_can_create_repositories": false,
+			"members_can_create_private_repositories": false,
+			"web_commit_signoff_required": true,
+			"secret_scanning_push_protection_enabled_for_new_repositories": true
+		}`)
+	})
+
+	ctx := context.Background()
+	org, _, err := client.Organizations.Edit(ctx, "o", input)
+	if err != nil {
+		t.Errorf("Organizations.Edit returned error: %v", err)
+	}
+
+	want := &Organization{
+		ID:                           Int64(1),
+		DefaultRepoPermission:        String("none"),
+		MembersCanCreateRepos:        Bool(false),
+		MembersCanCreatePrivateRepos: Bool(false),
+		WebCommitSignoffRequired:     Bool(true),
+		SecretScanningPushProtectionEnabledForNewRepos: Bool(true),
+	}
+	if !cmp.Equal(org, want) {
+		t.Errorf("Organizations.Edit returned %+v, want %+v", org, want)
+	}
+}
+
 func TestOrganizationsService_Edit_invalidOrg(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -5,6 +5,8 @@
 
 package github
 
+import "encoding/json"
+
 // Package represents a GitHub package.
 type Package struct {
 	ID             *int64           `json:"id,omitempty"`
@@ -24,10 +26,14 @@ func (p Package) String() string {
 
 // PackageVersion represents a GitHub package version.
 type PackageVersion struct {
-	ID                  *int64          `json:"id,omitempty"`
-	Version             *string         `json:"version,omitempty"`
-	Summary             *string         `json:"summary,omitempty"`
+	ID      *int64  `json:"id,omitempty"`
+	Version *string `json:"version,omitempty"`
+	Summary *string `json:"summary,omitempty"`
+	// Body is the body of the version when GitHub reports it as a string.
+	// Some packages, such as container images, report an object instead,
+	// which is then held raw in BodyRaw.
 	Body                *string         `json:"body,omitempty"`
+	BodyRaw             json.RawMessage `json:"-"`
 	BodyHTML            *string         `json:"body_html,omitempty"`
 	Release             *PackageRelease `json:"release,omitempty"`
 	Manifest            *string         `json:"manifest,omitempty"`
@@ -48,6 +54,25 @@ func (pv PackageVersion) String() string {
 	return Stringify(pv)
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface, accepting both
+// the string and the object form of the body.
+func (pv *PackageVersion) UnmarshalJSON(data []byte) error {
+	type packageVersion PackageVersion
+	aux := &struct {
+		*packageVersion
+		Body *stringOrObject `json:"body,omitempty"`
+	}{packageVersion: (*packageVersion)(pv)}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	pv.Body, pv.BodyRaw = nil, nil
+	if aux.Body != nil {
+		pv.Body, pv.BodyRaw = aux.Body.text, aux.Body.object
+	}
+	return nil
+}
+
 // PackageRelease represents a GitHub package version release.
 type PackageRelease struct {
 	URL             *string    `json:"url,omitempty"`
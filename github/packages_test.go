@@ -5,7 +5,12 @@
 
 package github
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
 
 func TestPackageRegistry_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PackageRegistry{}, "{}")
@@ -174,6 +179,26 @@ func TestPackageRelease_Marshal(t *testing.T) {
 	testJSONMarshal(t, o, want)
 }
 
+func TestPackageVersion_UnmarshalBody(t *testing.T) {
+	var pv PackageVersion
+	if err := json.Unmarshal([]byte(`{"id":1,"body":"text"}`), &pv); err != nil {
+		t.Errorf("json.Unmarshal returned error: %v", err)
+	}
+	want := PackageVersion{ID: Int64(1), Body: String("text")}
+	if !cmp.Equal(pv, want) {
+		t.Errorf("json.Unmarshal of a string body = %+v, want %+v", pv, want)
+	}
+
+	pv = PackageVersion{}
+	if err := json.Unmarshal([]byte(`{"id":1,"body":{"repository":{"id":1}}}`), &pv); err != nil {
+		t.Errorf("json.Unmarshal returned error: %v", err)
+	}
+	want = PackageVersion{ID: Int64(1), BodyRaw: json.RawMessage(`{"repository":{"id":1}}`)}
+	if !cmp.Equal(pv, want) {
+		t.Errorf("json.Unmarshal of an object body = %+v, want %+v", pv, want)
+	}
+}
+
 func TestPackageVersion_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PackageVersion{}, "{}")
 
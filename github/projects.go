@@ -255,6 +255,19 @@ func (s *ProjectsService) DeleteProjectColumn(ctx context.Context, columnID int6
 	return s.client.Do(ctx, req, nil)
 }
 
+// Positions of a column within a GitHub Project, for use in
+// ProjectColumnMoveOptions.
+const (
+	ProjectColumnPositionFirst = "first"
+	ProjectColumnPositionLast  = "last"
+)
+
+// ProjectColumnPositionAfter returns the position of a column placed right
+// after the column with the given ID, for use in ProjectColumnMoveOptions.
+func ProjectColumnPositionAfter(columnID int64) string {
+	return fmt.Sprintf("after:%v", columnID)
+}
+
 // ProjectColumnMoveOptions specifies the parameters to the
 // ProjectsService.MoveProjectColumn method.
 type ProjectColumnMoveOptions struct {
@@ -439,6 +452,27 @@ func (s *ProjectsService) DeleteProjectCard(ctx context.Context, cardID int64) (
 	return s.client.Do(ctx, req, nil)
 }
 
+// ArchiveProjectCard archives a card of a GitHub Project, or restores a
+// previously archived card when archived is false.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/projects/#update-an-existing-project-card
+func (s *ProjectsService) ArchiveProjectCard(ctx context.Context, cardID int64, archived bool) (*ProjectCard, *Response, error) {
+	return s.UpdateProjectCard(ctx, cardID, &ProjectCardOptions{Archived: &archived})
+}
+
+// Positions of a card within a column of a GitHub Project, for use in
+// ProjectCardMoveOptions.
+const (
+	ProjectCardPositionTop    = "top"
+	ProjectCardPositionBottom = "bottom"
+)
+
+// ProjectCardPositionAfter returns the position of a card placed right after
+// the card with the given ID, for use in ProjectCardMoveOptions.
+func ProjectCardPositionAfter(cardID int64) string {
+	return fmt.Sprintf("after:%v", cardID)
+}
+
 // ProjectCardMoveOptions specifies the parameters to the
 // ProjectsService.MoveProjectCard method.
 type ProjectCardMoveOptions struct {
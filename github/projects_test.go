@@ -597,6 +597,43 @@ func TestProjectsService_UpdateProjectCard(t *testing.T) {
 	})
 }
 
+func TestProjectsService_ArchiveProjectCard(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/columns/cards/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Accept", mediaTypeProjectsPreview)
+		testBody(t, r, `{"archived":false}`+"\n")
+		fmt.Fprint(w, `{"id":1,"archived":false}`)
+	})
+
+	ctx := context.Background()
+	card, _, err := client.Projects.ArchiveProjectCard(ctx, 1, false)
+	if err != nil {
+		t.Errorf("Projects.ArchiveProjectCard returned error: %v", err)
+	}
+
+	want := &ProjectCard{ID: Int64(1), Archived: Bool(false)}
+	if !cmp.Equal(card, want) {
+		t.Errorf("Projects.ArchiveProjectCard returned %+v, want %+v", card, want)
+	}
+
+	const methodName = "ArchiveProjectCard"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.ArchiveProjectCard(ctx, -1, true)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.ArchiveProjectCard(ctx, 1, true)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestProjectsService_DeleteProjectCard(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -657,6 +694,34 @@ func TestProjectsService_MoveProjectCard(t *testing.T) {
 	})
 }
 
+func TestProjectsService_MoveProjectCard_topOfAnotherColumn(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/columns/cards/1/moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypeProjectsPreview)
+		testBody(t, r, `{"position":"top","column_id":2}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{}`)
+	})
+
+	input := &ProjectCardMoveOptions{Position: ProjectCardPositionTop, ColumnID: 2}
+	ctx := context.Background()
+	if _, err := client.Projects.MoveProjectCard(ctx, 1, input); err != nil {
+		t.Errorf("Projects.MoveProjectCard returned error: %v", err)
+	}
+}
+
+func TestProjectPositionAfter(t *testing.T) {
+	if got, want := ProjectCardPositionAfter(12345), "after:12345"; got != want {
+		t.Errorf("ProjectCardPositionAfter returned %q, want %q", got, want)
+	}
+	if got, want := ProjectColumnPositionAfter(12345), "after:12345"; got != want {
+		t.Errorf("ProjectColumnPositionAfter returned %q, want %q", got, want)
+	}
+}
+
 func TestProjectsService_AddProjectCollaborator(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
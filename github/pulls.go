@@ -8,7 +8,9 @@ package github
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"time"
 )
 
@@ -89,6 +91,49 @@ func (p PullRequest) String() string {
 	return Stringify(p)
 }
 
+// MergeableState represents the value of PullRequest.MergeableState.
+//
+// GitHub computes the mergeable state of a pull request asynchronously, so it
+// may be reported as MergeableStateUnknown shortly after the pull request or
+// its base branch has changed.
+type MergeableState string
+
+// This is the set of mergeable states reported by the GitHub API.
+const (
+	MergeableStateBehind   MergeableState = "behind"
+	MergeableStateBlocked  MergeableState = "blocked"
+	MergeableStateClean    MergeableState = "clean"
+	MergeableStateDirty    MergeableState = "dirty"
+	MergeableStateDraft    MergeableState = "draft"
+	MergeableStateHasHooks MergeableState = "has_hooks"
+	MergeableStateUnknown  MergeableState = "unknown"
+	MergeableStateUnstable MergeableState = "unstable"
+)
+
+// IsMergeable reports whether GitHub considers the pull request mergeable,
+// i.e. Mergeable is true and MergeableState is one of "clean", "has_hooks"
+// or "unstable".
+func (p *PullRequest) IsMergeable() bool {
+	if !p.GetMergeable() {
+		return false
+	}
+	switch MergeableState(p.GetMergeableState()) {
+	case MergeableStateClean, MergeableStateHasHooks, MergeableStateUnstable:
+		return true
+	}
+	return false
+}
+
+// NeedsRebase reports whether the head branch of the pull request must be
+// updated before it can be merged, i.e. MergeableState is "behind" or "dirty".
+func (p *PullRequest) NeedsRebase() bool {
+	switch MergeableState(p.GetMergeableState()) {
+	case MergeableStateBehind, MergeableStateDirty:
+		return true
+	}
+	return false
+}
+
 // PRLink represents a single link object from GitHub pull request _links.
 type PRLink struct {
 	HRef *string `json:"href,omitempty"`
@@ -212,7 +257,49 @@ func (s *PullRequestsService) Get(ctx context.Context, owner string, repo string
 	return pull, resp, nil
 }
 
+// mergeablePollInterval is the delay between two requests made by
+// GetUntilMergeable.
+var mergeablePollInterval = 2 * time.Second
+
+// GetUntilMergeable fetches a single pull request, polling until its
+// MergeableState is no longer "unknown" or the timeout expires. If the timeout
+// expires, the last fetched pull request is returned along with an error.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#get-a-pull-request
+func (s *PullRequestsService) GetUntilMergeable(ctx context.Context, owner string, repo string, number int, timeout time.Duration) (*PullRequest, *Response, error) {
+	if ctx == nil {
+		return nil, nil, errNonNilContext
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		pull, resp, err := s.Get(ctx, owner, repo, number)
+		if err != nil {
+			return nil, resp, err
+		}
+		if MergeableState(pull.GetMergeableState()) != MergeableStateUnknown {
+			return pull, resp, nil
+		}
+
+		wait := mergeablePollInterval
+		if remaining := time.Until(deadline); remaining <= 0 {
+			return pull, resp, errors.New("timed out waiting for pull request mergeable state")
+		} else if remaining < wait {
+			wait = remaining
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return pull, resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
 // GetRaw gets a single pull request in raw (diff or patch) format.
+// GitHub refuses to render diffs that are too large, in which case an
+// *ErrorResponse is returned and the raw string is empty.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#get-a-pull-request
 func (s *PullRequestsService) GetRaw(ctx context.Context, owner string, repo string, number int, opts RawOptions) (string, *Response, error) {
@@ -410,6 +497,27 @@ func (s *PullRequestsService) ListFiles(ctx context.Context, owner string, repo
 	return commitFiles, resp, nil
 }
 
+// ListAllFiles lists all the files in a pull request, following pagination
+// until the last page has been fetched. The returned Response is the one of
+// the last request made.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#list-pull-requests-files
+func (s *PullRequestsService) ListAllFiles(ctx context.Context, owner string, repo string, number int) ([]*CommitFile, *Response, error) {
+	opts := &ListOptions{PerPage: 100}
+	var allFiles []*CommitFile
+	for {
+		commitFiles, resp, err := s.ListFiles(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		allFiles = append(allFiles, commitFiles...)
+		if resp.NextPage == 0 {
+			return allFiles, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // IsMerged checks if a pull request has been merged.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#check-if-a-pull-request-has-been-merged
@@ -435,7 +543,10 @@ type PullRequestMergeResult struct {
 // PullRequestOptions lets you define how a pull request will be merged.
 type PullRequestOptions struct {
 	CommitTitle string // Title for the automatic commit message. (Optional.)
-	SHA         string // SHA that pull request head must match to allow merge. (Optional.)
+
+	// SHA that pull request head must match to allow merge. If the head has
+	// moved, Merge returns a *PullRequestHeadChangedError. (Optional.)
+	SHA string
 
 	// The merge method to use. Possible values include: "merge", "squash", and "rebase" with the default being merge. (Optional.)
 	MergeMethod string
@@ -444,6 +555,58 @@ type PullRequestOptions struct {
 	DontDefaultIfBlank bool
 }
 
+// MergeMethod is a method GitHub can use to merge a pull request.
+type MergeMethod string
+
+// These are the merge methods accepted in PullRequestOptions.MergeMethod.
+const (
+	MergeMethodMerge  MergeMethod = "merge"
+	MergeMethodSquash MergeMethod = "squash"
+	MergeMethodRebase MergeMethod = "rebase"
+)
+
+// IsValid reports whether m is one of the merge methods supported by GitHub.
+func (m MergeMethod) IsValid() bool {
+	switch m {
+	case MergeMethodMerge, MergeMethodSquash, MergeMethodRebase:
+		return true
+	}
+	return false
+}
+
+// PullRequestNotMergeableError occurs when GitHub responds to a merge with
+// 405 Method Not Allowed because the pull request is not mergeable.
+type PullRequestNotMergeableError ErrorResponse
+
+func (r *PullRequestNotMergeableError) Error() string { return (*ErrorResponse)(r).Error() }
+
+// As lets errors.As match a *PullRequestNotMergeableError as the
+// *ErrorResponse that GitHub's 405 Method Not Allowed response was decoded into.
+func (r *PullRequestNotMergeableError) As(target interface{}) bool {
+	e, ok := target.(**ErrorResponse)
+	if ok {
+		*e = (*ErrorResponse)(r)
+	}
+	return ok
+}
+
+// PullRequestHeadChangedError occurs when GitHub responds to a merge with
+// 409 Conflict because the head of the pull request no longer matches the
+// SHA given in PullRequestOptions.
+type PullRequestHeadChangedError ErrorResponse
+
+func (r *PullRequestHeadChangedError) Error() string { return (*ErrorResponse)(r).Error() }
+
+// As lets errors.As match a *PullRequestHeadChangedError as the
+// *ErrorResponse that GitHub's 409 Conflict response was decoded into.
+func (r *PullRequestHeadChangedError) As(target interface{}) bool {
+	e, ok := target.(**ErrorResponse)
+	if ok {
+		*e = (*ErrorResponse)(r)
+	}
+	return ok
+}
+
 type pullRequestMergeRequest struct {
 	CommitMessage *string `json:"commit_message,omitempty"`
 	CommitTitle   string  `json:"commit_title,omitempty"`
@@ -453,6 +616,8 @@ type pullRequestMergeRequest struct {
 
 // Merge a pull request.
 // commitMessage is an extra detail to append to automatic commit message.
+// A pull request that cannot be merged results in a *PullRequestNotMergeableError,
+// and a head that no longer matches options.SHA in a *PullRequestHeadChangedError.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#merge-a-pull-request
 func (s *PullRequestsService) Merge(ctx context.Context, owner string, repo string, number int, commitMessage string, options *PullRequestOptions) (*PullRequestMergeResult, *Response, error) {
@@ -463,6 +628,9 @@ func (s *PullRequestsService) Merge(ctx context.Context, owner string, repo stri
 		pullRequestBody.CommitMessage = &commitMessage
 	}
 	if options != nil {
+		if options.MergeMethod != "" && !MergeMethod(options.MergeMethod).IsValid() {
+			return nil, nil, fmt.Errorf("invalid merge method %q", options.MergeMethod)
+		}
 		pullRequestBody.CommitTitle = options.CommitTitle
 		pullRequestBody.MergeMethod = options.MergeMethod
 		pullRequestBody.SHA = options.SHA
@@ -478,6 +646,14 @@ func (s *PullRequestsService) Merge(ctx context.Context, owner string, repo stri
 	mergeResult := new(PullRequestMergeResult)
 	resp, err := s.client.Do(ctx, req, mergeResult)
 	if err != nil {
+		if e, ok := err.(*ErrorResponse); ok {
+			switch e.Response.StatusCode {
+			case http.StatusMethodNotAllowed:
+				err = (*PullRequestNotMergeableError)(e)
+			case http.StatusConflict:
+				err = (*PullRequestHeadChangedError)(e)
+			}
+		}
 		return nil, resp, err
 	}
 
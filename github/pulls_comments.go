@@ -98,6 +98,32 @@ func (s *PullRequestsService) ListComments(ctx context.Context, owner, repo stri
 	return comments, resp, nil
 }
 
+// ListReviewCommentsForRepo lists the review comments on all pull requests in
+// a repository, following pagination until the last page has been fetched.
+// opts.Page is used as the starting page, and opts itself is not modified.
+// The returned Response is the one of the last request made.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#list-review-comments-in-a-repository
+func (s *PullRequestsService) ListReviewCommentsForRepo(ctx context.Context, owner, repo string, opts *PullRequestListCommentsOptions) ([]*PullRequestComment, *Response, error) {
+	o := &PullRequestListCommentsOptions{}
+	if opts != nil {
+		*o = *opts
+	}
+
+	var allComments []*PullRequestComment
+	for {
+		comments, resp, err := s.ListComments(ctx, owner, repo, 0, o)
+		if err != nil {
+			return nil, resp, err
+		}
+		allComments = append(allComments, comments...)
+		if resp.NextPage == 0 {
+			return allComments, resp, nil
+		}
+		o.Page = resp.NextPage
+	}
+}
+
 // GetComment fetches the specified pull request comment.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#get-a-review-comment-for-a-pull-request
@@ -169,6 +195,32 @@ func (s *PullRequestsService) CreateCommentInReplyTo(ctx context.Context, owner,
 	return c, resp, nil
 }
 
+// CreateReviewCommentReply creates a reply to a top-level review comment on a
+// pull request. Replies to replies are not supported by GitHub. The new
+// comment's InReplyTo is set to replyToCommentID.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#create-a-reply-for-a-review-comment
+func (s *PullRequestsService) CreateReviewCommentReply(ctx context.Context, owner, repo string, number int, body string, replyToCommentID int64) (*PullRequestComment, *Response, error) {
+	comment := &struct {
+		Body string `json:"body"`
+	}{
+		Body: body,
+	}
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/comments/%v/replies", owner, repo, number, replyToCommentID)
+	req, err := s.client.NewRequest("POST", u, comment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := new(PullRequestComment)
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, nil
+}
+
 // EditComment updates a pull request comment.
 // A non-nil comment.Body must be provided. Other comment fields should be left nil.
 //
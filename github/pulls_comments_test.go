@@ -205,6 +205,68 @@ func TestPullRequestsService_ListComments_specificPull(t *testing.T) {
 	}
 }
 
+func TestPullRequestsService_ListReviewCommentsForRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{
+				"sort":      "created",
+				"direction": "asc",
+				"since":     "2002-02-10T15:30:00Z",
+			})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/pulls/comments?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			testFormValues(t, r, values{
+				"sort":      "created",
+				"direction": "asc",
+				"since":     "2002-02-10T15:30:00Z",
+				"page":      "2",
+			})
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	opt := &PullRequestListCommentsOptions{
+		Sort:      "created",
+		Direction: "asc",
+		Since:     time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC),
+	}
+	ctx := context.Background()
+	comments, _, err := client.PullRequests.ListReviewCommentsForRepo(ctx, "o", "r", opt)
+	if err != nil {
+		t.Errorf("PullRequests.ListReviewCommentsForRepo returned error: %v", err)
+	}
+
+	want := []*PullRequestComment{{ID: Int64(1)}, {ID: Int64(2)}, {ID: Int64(3)}}
+	if !cmp.Equal(comments, want) {
+		t.Errorf("PullRequests.ListReviewCommentsForRepo returned %+v, want %+v", comments, want)
+	}
+	if opt.Page != 0 {
+		t.Errorf("PullRequests.ListReviewCommentsForRepo modified opts.Page to %v", opt.Page)
+	}
+
+	const methodName = "ListReviewCommentsForRepo"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.PullRequests.ListReviewCommentsForRepo(ctx, "\n", "\n", opt)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.ListReviewCommentsForRepo(ctx, "o", "r", opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestPullRequestsService_ListComments_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -360,6 +422,42 @@ func TestPullRequestsService_CreateCommentInReplyTo(t *testing.T) {
 	})
 }
 
+func TestPullRequestsService_CreateReviewCommentReply(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/comments/2/replies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"b"}`+"\n")
+		fmt.Fprint(w, `{"id":3,"body":"b","in_reply_to_id":2}`)
+	})
+
+	ctx := context.Background()
+	comment, _, err := client.PullRequests.CreateReviewCommentReply(ctx, "o", "r", 1, "b", 2)
+	if err != nil {
+		t.Errorf("PullRequests.CreateReviewCommentReply returned error: %v", err)
+	}
+
+	want := &PullRequestComment{ID: Int64(3), Body: String("b"), InReplyTo: Int64(2)}
+	if !cmp.Equal(comment, want) {
+		t.Errorf("PullRequests.CreateReviewCommentReply returned %+v, want %+v", comment, want)
+	}
+
+	const methodName = "CreateReviewCommentReply"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.PullRequests.CreateReviewCommentReply(ctx, "\n", "\n", -1, "\n", -2)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.CreateReviewCommentReply(ctx, "o", "r", 1, "b", 2)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestPullRequestsService_EditComment(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -7,7 +7,10 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 )
 
 // ReviewersRequest specifies users and teams for a pull request review request.
@@ -23,10 +26,35 @@ type Reviewers struct {
 	Teams []*Team `json:"teams,omitempty"`
 }
 
+// NotCollaboratorError occurs when a review is requested from a user or team
+// that is not a collaborator on the repository.
+type NotCollaboratorError ErrorResponse
+
+func (r *NotCollaboratorError) Error() string { return (*ErrorResponse)(r).Error() }
+
+// As lets errors.As match a *NotCollaboratorError as the *ErrorResponse that
+// GitHub's 422 Unprocessable Entity response was decoded into.
+func (r *NotCollaboratorError) As(target interface{}) bool {
+	e, ok := target.(**ErrorResponse)
+	if ok {
+		*e = (*ErrorResponse)(r)
+	}
+	return ok
+}
+
+// errNoReviewers is returned when a ReviewersRequest names no users or teams.
+var errNoReviewers = errors.New("at least one of Reviewers or TeamReviewers must be provided")
+
 // RequestReviewers creates a review request for the provided reviewers for the specified pull request.
+// At least one user or team must be provided. If any of them is not a
+// collaborator on the repository, a *NotCollaboratorError is returned.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#request-reviewers-for-a-pull-request
 func (s *PullRequestsService) RequestReviewers(ctx context.Context, owner, repo string, number int, reviewers ReviewersRequest) (*PullRequest, *Response, error) {
+	if len(reviewers.Reviewers) == 0 && len(reviewers.TeamReviewers) == 0 {
+		return nil, nil, errNoReviewers
+	}
+
 	u := fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number)
 	req, err := s.client.NewRequest("POST", u, &reviewers)
 	if err != nil {
@@ -36,6 +64,10 @@ func (s *PullRequestsService) RequestReviewers(ctx context.Context, owner, repo
 	r := new(PullRequest)
 	resp, err := s.client.Do(ctx, req, r)
 	if err != nil {
+		if e, ok := err.(*ErrorResponse); ok && e.Response.StatusCode == http.StatusUnprocessableEntity &&
+			strings.Contains(e.Message, "not a collaborator") {
+			err = (*NotCollaboratorError)(e)
+		}
 		return nil, resp, err
 	}
 
@@ -67,9 +99,14 @@ func (s *PullRequestsService) ListReviewers(ctx context.Context, owner, repo str
 }
 
 // RemoveReviewers removes the review request for the provided reviewers for the specified pull request.
+// At least one user or team must be provided.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#remove-requested-reviewers-from-a-pull-request
 func (s *PullRequestsService) RemoveReviewers(ctx context.Context, owner, repo string, number int, reviewers ReviewersRequest) (*Response, error) {
+	if len(reviewers.Reviewers) == 0 && len(reviewers.TeamReviewers) == 0 {
+		return nil, errNoReviewers
+	}
+
 	u := fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number)
 	req, err := s.client.NewRequest("DELETE", u, &reviewers)
 	if err != nil {
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -149,6 +150,65 @@ func TestRequestReviewers(t *testing.T) {
 	})
 }
 
+func TestRequestReviewers_teams(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"team_reviewers":["justice-league"]}`+"\n")
+		fmt.Fprint(w, `{"number":1,"requested_teams":[{"slug":"justice-league"}]}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.PullRequests.RequestReviewers(ctx, "o", "r", 1, ReviewersRequest{TeamReviewers: []string{"justice-league"}})
+	if err != nil {
+		t.Errorf("PullRequests.RequestReviewers returned error: %v", err)
+	}
+	want := &PullRequest{Number: Int(1), RequestedTeams: []*Team{{Slug: String("justice-league")}}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("PullRequests.RequestReviewers returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRequestReviewers_notCollaborator(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Reviews may only be requested from collaborators. One or more of the users or teams you specified is not a collaborator of the o/r repository."}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.PullRequests.RequestReviewers(ctx, "o", "r", 1, ReviewersRequest{Reviewers: []string{"stranger"}})
+	var notCollaborator *NotCollaboratorError
+	if !errors.As(err, &notCollaborator) {
+		t.Errorf("PullRequests.RequestReviewers returned error %#v, want *NotCollaboratorError", err)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("errors.As(%#v, *ErrorResponse) = false, want true", err)
+	}
+	if got, want := errResp.Response.StatusCode, http.StatusUnprocessableEntity; got != want {
+		t.Errorf("ErrorResponse.Response.StatusCode = %v, want %v", got, want)
+	}
+}
+
+func TestRequestReviewers_noReviewers(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, _, err := client.PullRequests.RequestReviewers(ctx, "o", "r", 1, ReviewersRequest{}); err == nil {
+		t.Error("PullRequests.RequestReviewers returned nil error, want error")
+	}
+	if _, err := client.PullRequests.RemoveReviewers(ctx, "o", "r", 1, ReviewersRequest{}); err == nil {
+		t.Error("PullRequests.RemoveReviewers returned nil error, want error")
+	}
+}
+
 func TestRemoveReviewers(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
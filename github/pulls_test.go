@@ -8,11 +8,13 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -150,6 +152,114 @@ func TestPullRequestsService_Get(t *testing.T) {
 	})
 }
 
+func TestPullRequestsService_GetUntilMergeable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(d time.Duration) { mergeablePollInterval = d }(mergeablePollInterval)
+	mergeablePollInterval = time.Millisecond
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls < 3 {
+			fmt.Fprint(w, `{"number":1,"mergeable_state":"unknown"}`)
+			return
+		}
+		fmt.Fprint(w, `{"number":1,"mergeable":true,"mergeable_state":"clean"}`)
+	})
+
+	ctx := context.Background()
+	pull, _, err := client.PullRequests.GetUntilMergeable(ctx, "o", "r", 1, time.Minute)
+	if err != nil {
+		t.Errorf("PullRequests.GetUntilMergeable returned error: %v", err)
+	}
+
+	want := &PullRequest{Number: Int(1), Mergeable: Bool(true), MergeableState: String("clean")}
+	if !cmp.Equal(pull, want) {
+		t.Errorf("PullRequests.GetUntilMergeable returned %+v, want %+v", pull, want)
+	}
+	if calls != 3 {
+		t.Errorf("PullRequests.GetUntilMergeable made %v requests, want 3", calls)
+	}
+	if !pull.IsMergeable() {
+		t.Errorf("PullRequest.IsMergeable returned false, want true")
+	}
+
+	const methodName = "GetUntilMergeable"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.PullRequests.GetUntilMergeable(ctx, "\n", "\n", -1, time.Minute)
+		return err
+	})
+}
+
+func TestPullRequestsService_GetUntilMergeable_timeout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(d time.Duration) { mergeablePollInterval = d }(mergeablePollInterval)
+	mergeablePollInterval = time.Millisecond
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":1,"mergeable_state":"unknown"}`)
+	})
+
+	ctx := context.Background()
+	pull, _, err := client.PullRequests.GetUntilMergeable(ctx, "o", "r", 1, 10*time.Millisecond)
+	if err == nil {
+		t.Error("PullRequests.GetUntilMergeable returned nil error, want timeout error")
+	}
+
+	want := &PullRequest{Number: Int(1), MergeableState: String("unknown")}
+	if !cmp.Equal(pull, want) {
+		t.Errorf("PullRequests.GetUntilMergeable returned %+v, want %+v", pull, want)
+	}
+}
+
+func TestPullRequest_IsMergeable(t *testing.T) {
+	tests := []struct {
+		mergeable *bool
+		state     string
+		want      bool
+	}{
+		{Bool(true), "clean", true},
+		{Bool(true), "unstable", true},
+		{Bool(true), "has_hooks", true},
+		{Bool(true), "blocked", false},
+		{Bool(false), "dirty", false},
+		{nil, "unknown", false},
+	}
+
+	for _, tt := range tests {
+		p := &PullRequest{Mergeable: tt.mergeable, MergeableState: String(tt.state)}
+		if got := p.IsMergeable(); got != tt.want {
+			t.Errorf("IsMergeable for state %q returned %v, want %v", tt.state, got, tt.want)
+		}
+	}
+}
+
+func TestPullRequest_NeedsRebase(t *testing.T) {
+	tests := []struct {
+		state string
+		want  bool
+	}{
+		{"behind", true},
+		{"dirty", true},
+		{"clean", false},
+		{"unknown", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		p := &PullRequest{MergeableState: String(tt.state)}
+		if got := p.NeedsRebase(); got != tt.want {
+			t.Errorf("NeedsRebase for state %q returned %v, want %v", tt.state, got, tt.want)
+		}
+	}
+}
+
 func TestPullRequestsService_GetRaw_diff(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -224,6 +334,30 @@ func TestPullRequestsService_GetRaw_invalid(t *testing.T) {
 	}
 }
 
+func TestPullRequestsService_GetRaw_tooLarge(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3Diff)
+		w.WriteHeader(http.StatusNotAcceptable)
+		fmt.Fprint(w, `{"message":"Sorry, the diff exceeded the maximum number of lines (20000)"}`)
+	})
+
+	ctx := context.Background()
+	got, resp, err := client.PullRequests.GetRaw(ctx, "o", "r", 1, RawOptions{Diff})
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Fatalf("PullRequests.GetRaw returned error %v, want *ErrorResponse", err)
+	}
+	if got != "" {
+		t.Errorf("PullRequests.GetRaw returned %q, want empty", got)
+	}
+	if resp.StatusCode != http.StatusNotAcceptable {
+		t.Errorf("PullRequests.GetRaw returned status %v, want %v", resp.StatusCode, http.StatusNotAcceptable)
+	}
+}
+
 func TestPullRequestsService_Get_links(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -677,6 +811,55 @@ func TestPullRequestsService_ListFiles(t *testing.T) {
 	})
 }
 
+func TestPullRequestsService_ListAllFiles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/files", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/pulls/1/files?page=2&per_page=100>; rel="next"`)
+			fmt.Fprint(w, `[{"filename":"a.txt"},{"filename":"b.txt"}]`)
+		case "2":
+			testFormValues(t, r, values{"page": "2", "per_page": "100"})
+			fmt.Fprint(w, `[{"filename":"c.txt"}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	files, _, err := client.PullRequests.ListAllFiles(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.ListAllFiles returned error: %v", err)
+	}
+
+	want := []*CommitFile{
+		{Filename: String("a.txt")},
+		{Filename: String("b.txt")},
+		{Filename: String("c.txt")},
+	}
+	if !cmp.Equal(files, want) {
+		t.Errorf("PullRequests.ListAllFiles returned %+v, want %+v", files, want)
+	}
+
+	const methodName = "ListAllFiles"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.PullRequests.ListAllFiles(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.ListAllFiles(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestPullRequestsService_IsMerged(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -757,6 +940,92 @@ func TestPullRequestsService_Merge(t *testing.T) {
 	})
 }
 
+func TestPullRequestsService_Merge_squash(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"merge_method":"squash","sha":"s"}`+"\n")
+		fmt.Fprint(w, `{"sha":"m","merged":true}`)
+	})
+
+	options := &PullRequestOptions{MergeMethod: string(MergeMethodSquash), SHA: "s"}
+	ctx := context.Background()
+	merge, _, err := client.PullRequests.Merge(ctx, "o", "r", 1, "", options)
+	if err != nil {
+		t.Errorf("PullRequests.Merge returned error: %v", err)
+	}
+
+	want := &PullRequestMergeResult{SHA: String("m"), Merged: Bool(true)}
+	if !cmp.Equal(merge, want) {
+		t.Errorf("PullRequests.Merge returned %+v, want %+v", merge, want)
+	}
+}
+
+func TestPullRequestsService_Merge_invalidMethod(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	options := &PullRequestOptions{MergeMethod: "fast-forward"}
+	if _, _, err := client.PullRequests.Merge(ctx, "o", "r", 1, "", options); err == nil {
+		t.Error("PullRequests.Merge returned no error for an invalid merge method")
+	}
+}
+
+func TestPullRequestsService_Merge_headChanged(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"Head branch was modified. Review and try the merge again."}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.PullRequests.Merge(ctx, "o", "r", 1, "", &PullRequestOptions{SHA: "s"})
+	if _, ok := err.(*PullRequestHeadChangedError); !ok {
+		t.Errorf("PullRequests.Merge returned error %#v, want *PullRequestHeadChangedError", err)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("errors.As(%#v, *ErrorResponse) = false, want true", err)
+	}
+	if got, want := errResp.Response.StatusCode, http.StatusConflict; got != want {
+		t.Errorf("ErrorResponse.Response.StatusCode = %v, want %v", got, want)
+	}
+}
+
+func TestPullRequestsService_Merge_notMergeable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		fmt.Fprint(w, `{"message":"Pull Request is not mergeable"}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.PullRequests.Merge(ctx, "o", "r", 1, "", nil)
+	e, ok := err.(*PullRequestNotMergeableError)
+	if !ok {
+		t.Fatalf("PullRequests.Merge returned error %#v, want *PullRequestNotMergeableError", err)
+	}
+	if got, want := e.Message, "Pull Request is not mergeable"; got != want {
+		t.Errorf("PullRequestNotMergeableError.Message = %q, want %q", got, want)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("errors.As(%#v, *ErrorResponse) = false, want true", err)
+	}
+	if got, want := errResp.Response.StatusCode, http.StatusMethodNotAllowed; got != want {
+		t.Errorf("ErrorResponse.Response.StatusCode = %v, want %v", got, want)
+	}
+}
+
 // Test that different merge options produce expected PUT requests. See issue https://github.com/google/go-github/issues/500.
 func TestPullRequestsService_Merge_options(t *testing.T) {
 	client, mux, _, teardown := setup()
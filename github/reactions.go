@@ -47,6 +47,31 @@ func (r Reaction) String() string {
 	return Stringify(r)
 }
 
+// ReactionContent represents the type of a reaction.
+type ReactionContent string
+
+// This is the set of reaction types supported by GitHub.
+const (
+	ReactionPlusOne  ReactionContent = "+1"
+	ReactionMinusOne ReactionContent = "-1"
+	ReactionLaugh    ReactionContent = "laugh"
+	ReactionConfused ReactionContent = "confused"
+	ReactionHeart    ReactionContent = "heart"
+	ReactionHooray   ReactionContent = "hooray"
+	ReactionRocket   ReactionContent = "rocket"
+	ReactionEyes     ReactionContent = "eyes"
+)
+
+// IsValid reports whether c is one of the reaction types supported by GitHub.
+func (c ReactionContent) IsValid() bool {
+	switch c {
+	case ReactionPlusOne, ReactionMinusOne, ReactionLaugh, ReactionConfused,
+		ReactionHeart, ReactionHooray, ReactionRocket, ReactionEyes:
+		return true
+	}
+	return false
+}
+
 // ListCommentReactionOptions specifies the optional parameters to the
 // ReactionsService.ListCommentReactions method.
 type ListCommentReactionOptions struct {
@@ -94,6 +119,10 @@ func (s *ReactionsService) ListCommentReactions(ctx context.Context, owner, repo
 func (s *ReactionsService) CreateCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/comments/%v/reactions", owner, repo, id)
 
+	if !ReactionContent(content).IsValid() {
+		return nil, nil, fmt.Errorf("invalid reaction content %q", content)
+	}
+
 	body := &Reaction{Content: String(content)}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
@@ -166,6 +195,10 @@ func (s *ReactionsService) ListIssueReactions(ctx context.Context, owner, repo s
 func (s *ReactionsService) CreateIssueReaction(ctx context.Context, owner, repo string, number int, content string) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues/%v/reactions", owner, repo, number)
 
+	if !ReactionContent(content).IsValid() {
+		return nil, nil, fmt.Errorf("invalid reaction content %q", content)
+	}
+
 	body := &Reaction{Content: String(content)}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
@@ -238,6 +271,10 @@ func (s *ReactionsService) ListIssueCommentReactions(ctx context.Context, owner,
 func (s *ReactionsService) CreateIssueCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues/comments/%v/reactions", owner, repo, id)
 
+	if !ReactionContent(content).IsValid() {
+		return nil, nil, fmt.Errorf("invalid reaction content %q", content)
+	}
+
 	body := &Reaction{Content: String(content)}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
@@ -310,6 +347,10 @@ func (s *ReactionsService) ListPullRequestCommentReactions(ctx context.Context,
 func (s *ReactionsService) CreatePullRequestCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/pulls/comments/%v/reactions", owner, repo, id)
 
+	if !ReactionContent(content).IsValid() {
+		return nil, nil, fmt.Errorf("invalid reaction content %q", content)
+	}
+
 	body := &Reaction{Content: String(content)}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
@@ -379,6 +420,10 @@ func (s *ReactionsService) ListTeamDiscussionReactions(ctx context.Context, team
 func (s *ReactionsService) CreateTeamDiscussionReaction(ctx context.Context, teamID int64, discussionNumber int, content string) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("teams/%v/discussions/%v/reactions", teamID, discussionNumber)
 
+	if !ReactionContent(content).IsValid() {
+		return nil, nil, fmt.Errorf("invalid reaction content %q", content)
+	}
+
 	body := &Reaction{Content: String(content)}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
@@ -446,6 +491,10 @@ func (s *ReactionsService) ListTeamDiscussionCommentReactions(ctx context.Contex
 func (s *ReactionsService) CreateTeamDiscussionCommentReaction(ctx context.Context, teamID int64, discussionNumber, commentNumber int, content string) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("teams/%v/discussions/%v/comments/%v/reactions", teamID, discussionNumber, commentNumber)
 
+	if !ReactionContent(content).IsValid() {
+		return nil, nil, fmt.Errorf("invalid reaction content %q", content)
+	}
+
 	body := &Reaction{Content: String(content)}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -127,7 +128,7 @@ func TestReactionsService_CreateCommentReaction(t *testing.T) {
 
 	const methodName = "CreateCommentReaction"
 	testBadOptions(t, methodName, func() (err error) {
-		_, _, err = client.Reactions.CreateCommentReaction(ctx, "\n", "\n", -1, "\n")
+		_, _, err = client.Reactions.CreateCommentReaction(ctx, "\n", "\n", -1, "+1")
 		return err
 	})
 
@@ -208,7 +209,7 @@ func TestReactionsService_CreateIssueReaction(t *testing.T) {
 
 	const methodName = "CreateIssueReaction"
 	testBadOptions(t, methodName, func() (err error) {
-		_, _, err = client.Reactions.CreateIssueReaction(ctx, "\n", "\n", -1, "\n")
+		_, _, err = client.Reactions.CreateIssueReaction(ctx, "\n", "\n", -1, "+1")
 		return err
 	})
 
@@ -289,7 +290,7 @@ func TestReactionsService_CreateIssueCommentReaction(t *testing.T) {
 
 	const methodName = "CreateIssueCommentReaction"
 	testBadOptions(t, methodName, func() (err error) {
-		_, _, err = client.Reactions.CreateIssueCommentReaction(ctx, "\n", "\n", -1, "\n")
+		_, _, err = client.Reactions.CreateIssueCommentReaction(ctx, "\n", "\n", -1, "+1")
 		return err
 	})
 
@@ -370,7 +371,7 @@ func TestReactionsService_CreatePullRequestCommentReaction(t *testing.T) {
 
 	const methodName = "CreatePullRequestCommentReaction"
 	testBadOptions(t, methodName, func() (err error) {
-		_, _, err = client.Reactions.CreatePullRequestCommentReaction(ctx, "\n", "\n", -1, "\n")
+		_, _, err = client.Reactions.CreatePullRequestCommentReaction(ctx, "\n", "\n", -1, "+1")
 		return err
 	})
 
@@ -451,7 +452,7 @@ func TestReactionsService_CreateTeamDiscussionReaction(t *testing.T) {
 
 	const methodName = "CreateTeamDiscussionReaction"
 	testBadOptions(t, methodName, func() (err error) {
-		_, _, err = client.Reactions.CreateTeamDiscussionReaction(ctx, -1, -2, "\n")
+		_, _, err = client.Reactions.CreateTeamDiscussionReaction(ctx, -1, -2, "+1")
 		return err
 	})
 
@@ -532,7 +533,7 @@ func TestReactionService_CreateTeamDiscussionCommentReaction(t *testing.T) {
 
 	const methodName = "CreateTeamDiscussionCommentReaction"
 	testBadOptions(t, methodName, func() (err error) {
-		_, _, err = client.Reactions.CreateTeamDiscussionCommentReaction(ctx, -1, -2, -3, "\n")
+		_, _, err = client.Reactions.CreateTeamDiscussionCommentReaction(ctx, -1, -2, -3, "+1")
 		return err
 	})
 
@@ -868,3 +869,88 @@ func TestReactionsService_DeleteTeamDiscussionCommentReactionByTeamIDAndOrgID(t
 		return client.Reactions.DeleteTeamDiscussionCommentReactionByOrgIDAndTeamID(ctx, 1, 2, 3, 4, 5)
 	})
 }
+
+func TestReactionContent_IsValid(t *testing.T) {
+	for _, c := range []ReactionContent{
+		ReactionPlusOne, ReactionMinusOne, ReactionLaugh, ReactionConfused,
+		ReactionHeart, ReactionHooray, ReactionRocket, ReactionEyes,
+	} {
+		if !c.IsValid() {
+			t.Errorf("ReactionContent(%q).IsValid = false, want true", c)
+		}
+	}
+
+	for _, c := range []ReactionContent{"", "thumbsup", "+2", "HEART"} {
+		if c.IsValid() {
+			t.Errorf("ReactionContent(%q).IsValid = true, want false", c)
+		}
+	}
+}
+
+func TestReactionsService_CreateReaction_validContent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1/reactions", func(w http.ResponseWriter, r *http.Request) {
+		v := new(Reaction)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id":1,"content":%q}`, v.GetContent())
+	})
+
+	ctx := context.Background()
+	for _, c := range []string{"+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"} {
+		got, _, err := client.Reactions.CreateIssueReaction(ctx, "o", "r", 1, c)
+		if err != nil {
+			t.Errorf("CreateIssueReaction(%q) returned error: %v", c, err)
+		}
+		if want := (&Reaction{ID: Int64(1), Content: String(c)}); !cmp.Equal(got, want) {
+			t.Errorf("CreateIssueReaction(%q) = %+v, want %+v", c, got, want)
+		}
+	}
+}
+
+func TestReactionsService_CreateReaction_invalidContent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %v", r.URL)
+	})
+
+	ctx := context.Background()
+	const content = "thumbsup"
+	create := map[string]func() error{
+		"CreateCommentReaction": func() error {
+			_, _, err := client.Reactions.CreateCommentReaction(ctx, "o", "r", 1, content)
+			return err
+		},
+		"CreateIssueReaction": func() error {
+			_, _, err := client.Reactions.CreateIssueReaction(ctx, "o", "r", 1, content)
+			return err
+		},
+		"CreateIssueCommentReaction": func() error {
+			_, _, err := client.Reactions.CreateIssueCommentReaction(ctx, "o", "r", 1, content)
+			return err
+		},
+		"CreatePullRequestCommentReaction": func() error {
+			_, _, err := client.Reactions.CreatePullRequestCommentReaction(ctx, "o", "r", 1, content)
+			return err
+		},
+		"CreateTeamDiscussionReaction": func() error {
+			_, _, err := client.Reactions.CreateTeamDiscussionReaction(ctx, 1, 2, content)
+			return err
+		},
+		"CreateTeamDiscussionCommentReaction": func() error {
+			_, _, err := client.Reactions.CreateTeamDiscussionCommentReaction(ctx, 1, 2, 3, content)
+			return err
+		},
+	}
+	for name, f := range create {
+		if err := f(); err == nil {
+			t.Errorf("%v returned nil error for content %q, want error", name, content)
+		}
+	}
+}
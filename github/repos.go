@@ -221,7 +221,7 @@ func (s *RepositoriesService) List(ctx context.Context, user string, opts *Repos
 // RepositoriesService.ListByOrg method.
 type RepositoryListByOrgOptions struct {
 	// Type of repositories to list. Possible values are: all, public, private,
-	// forks, sources, member. Default is "all".
+	// forks, sources, member, internal. Default is "all".
 	Type string `url:"type,omitempty"`
 
 	// How to sort the repository list. Can be one of created, updated, pushed,
@@ -235,10 +235,80 @@ type RepositoryListByOrgOptions struct {
 	ListOptions
 }
 
-// ListByOrg lists the repositories for an organization.
+// RepositoryListType represents the value of RepositoryListByOrgOptions.Type.
+type RepositoryListType string
+
+// These are the values accepted by RepositoryListByOrgOptions.Type.
+const (
+	RepositoryListTypeAll      RepositoryListType = "all"
+	RepositoryListTypePublic   RepositoryListType = "public"
+	RepositoryListTypePrivate  RepositoryListType = "private"
+	RepositoryListTypeForks    RepositoryListType = "forks"
+	RepositoryListTypeSources  RepositoryListType = "sources"
+	RepositoryListTypeMember   RepositoryListType = "member"
+	RepositoryListTypeInternal RepositoryListType = "internal"
+)
+
+// IsValid reports whether t is one of the values accepted by
+// RepositoryListByOrgOptions.Type.
+func (t RepositoryListType) IsValid() bool {
+	switch t {
+	case RepositoryListTypeAll, RepositoryListTypePublic, RepositoryListTypePrivate,
+		RepositoryListTypeForks, RepositoryListTypeSources, RepositoryListTypeMember,
+		RepositoryListTypeInternal:
+		return true
+	}
+	return false
+}
+
+// RepositorySort represents the value of RepositoryListByOrgOptions.Sort.
+type RepositorySort string
+
+// These are the values accepted by RepositoryListByOrgOptions.Sort.
+const (
+	RepositorySortCreated  RepositorySort = "created"
+	RepositorySortUpdated  RepositorySort = "updated"
+	RepositorySortPushed   RepositorySort = "pushed"
+	RepositorySortFullName RepositorySort = "full_name"
+)
+
+// IsValid reports whether s is one of the values accepted by
+// RepositoryListByOrgOptions.Sort.
+func (s RepositorySort) IsValid() bool {
+	switch s {
+	case RepositorySortCreated, RepositorySortUpdated, RepositorySortPushed, RepositorySortFullName:
+		return true
+	}
+	return false
+}
+
+// SortDirection represents the value of RepositoryListByOrgOptions.Direction.
+type SortDirection string
+
+// These are the values accepted by RepositoryListByOrgOptions.Direction.
+const (
+	SortDirectionAsc  SortDirection = "asc"
+	SortDirectionDesc SortDirection = "desc"
+)
+
+// IsValid reports whether d is one of the values accepted by
+// RepositoryListByOrgOptions.Direction.
+func (d SortDirection) IsValid() bool {
+	return d == SortDirectionAsc || d == SortDirectionDesc
+}
+
+// ListByOrg lists the repositories for an organization. An error is returned
+// without making a request if opts.Type is not one of the RepositoryListType
+// values.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-organization-repositories
 func (s *RepositoriesService) ListByOrg(ctx context.Context, org string, opts *RepositoryListByOrgOptions) ([]*Repository, *Response, error) {
+	if opts != nil {
+		if opts.Type != "" && !RepositoryListType(opts.Type).IsValid() {
+			return nil, nil, fmt.Errorf("invalid repository type %q", opts.Type)
+		}
+	}
+
 	u := fmt.Sprintf("orgs/%v/repos", org)
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -466,6 +536,9 @@ func (s *RepositoriesService) GetCodeOfConduct(ctx context.Context, owner, repo
 
 // GetByID fetches a repository.
 //
+// The id is the database ID of the repository, its ID field, and not its node
+// ID; use ParseNodeID to get the database ID from a node ID.
+//
 // Note: GetByID uses the undocumented GitHub API endpoint /repositories/:id.
 func (s *RepositoriesService) GetByID(ctx context.Context, id int64) (*Repository, *Response, error) {
 	u := fmt.Sprintf("repositories/%d", id)
@@ -483,12 +556,64 @@ func (s *RepositoriesService) GetByID(ctx context.Context, id int64) (*Repositor
 	return repository, resp, nil
 }
 
+// editRepoRequest is a subset of Repository and is used internally
+// by Edit to pass only the known settable fields for the endpoint.
+type editRepoRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Homepage    *string `json:"homepage,omitempty"`
+
+	Private      *bool   `json:"private,omitempty"`
+	Visibility   *string `json:"visibility,omitempty"`
+	HasIssues    *bool   `json:"has_issues,omitempty"`
+	HasProjects  *bool   `json:"has_projects,omitempty"`
+	HasWiki      *bool   `json:"has_wiki,omitempty"`
+	HasDownloads *bool   `json:"has_downloads,omitempty"`
+	IsTemplate   *bool   `json:"is_template,omitempty"`
+
+	DefaultBranch       *string `json:"default_branch,omitempty"`
+	AllowSquashMerge    *bool   `json:"allow_squash_merge,omitempty"`
+	AllowMergeCommit    *bool   `json:"allow_merge_commit,omitempty"`
+	AllowRebaseMerge    *bool   `json:"allow_rebase_merge,omitempty"`
+	DeleteBranchOnMerge *bool   `json:"delete_branch_on_merge,omitempty"`
+	Archived            *bool   `json:"archived,omitempty"`
+}
+
 // Edit updates a repository.
 //
+// Note that only the settable subset of the repository fields is sent;
+// read-only fields such as ID, FullName or the various URLs are ignored.
+// Fields left nil are not sent and keep their current value, while fields
+// set explicitly are sent even when they hold a zero value, so that for
+// example Private: Bool(false) makes the repository public.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#update-a-repository
 func (s *RepositoriesService) Edit(ctx context.Context, owner, repo string, repository *Repository) (*Repository, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v", owner, repo)
-	req, err := s.client.NewRequest("PATCH", u, repository)
+
+	var repoReq *editRepoRequest
+	if repository != nil {
+		repoReq = &editRepoRequest{
+			Name:                repository.Name,
+			Description:         repository.Description,
+			Homepage:            repository.Homepage,
+			Private:             repository.Private,
+			Visibility:          repository.Visibility,
+			HasIssues:           repository.HasIssues,
+			HasProjects:         repository.HasProjects,
+			HasWiki:             repository.HasWiki,
+			HasDownloads:        repository.HasDownloads,
+			IsTemplate:          repository.IsTemplate,
+			DefaultBranch:       repository.DefaultBranch,
+			AllowSquashMerge:    repository.AllowSquashMerge,
+			AllowMergeCommit:    repository.AllowMergeCommit,
+			AllowRebaseMerge:    repository.AllowRebaseMerge,
+			DeleteBranchOnMerge: repository.DeleteBranchOnMerge,
+			Archived:            repository.Archived,
+		}
+	}
+
+	req, err := s.client.NewRequest("PATCH", u, repoReq)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -517,7 +642,12 @@ func (s *RepositoriesService) Delete(ctx context.Context, owner, repo string) (*
 	return s.client.Do(ctx, req, nil)
 }
 
-// Contributor represents a repository contributor
+// Contributor represents a repository contributor.
+//
+// Contributors are GitHub users, with Login and the other user fields set,
+// unless ListContributorsOptions.Anon is set and a contributor's commits
+// aren't associated with a GitHub user. Such anonymous contributors have Type
+// "Anonymous", and only Name, Email and Contributions set, see IsAnonymous.
 type Contributor struct {
 	Login             *string `json:"login,omitempty"`
 	ID                *int64  `json:"id,omitempty"`
@@ -542,10 +672,17 @@ type Contributor struct {
 	Email             *string `json:"email,omitempty"`
 }
 
+// IsAnonymous reports whether c is an anonymous contributor, identified only
+// by the name and email of its commits rather than by a GitHub user.
+func (c *Contributor) IsAnonymous() bool {
+	return c.GetType() == "Anonymous"
+}
+
 // ListContributorsOptions specifies the optional parameters to the
 // RepositoriesService.ListContributors method.
 type ListContributorsOptions struct {
-	// Include anonymous contributors in results or not
+	// Include anonymous contributors in results or not. Set to "true" or "1"
+	// to include them.
 	Anon string `url:"anon,omitempty"`
 
 	ListOptions
@@ -639,6 +776,34 @@ func (s *RepositoriesService) DisableAutomatedSecurityFixes(ctx context.Context,
 	return s.client.Do(ctx, req, nil)
 }
 
+// EnablePrivateReporting enables private vulnerability reporting for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos#enable-private-vulnerability-reporting-for-a-repository
+func (s *RepositoriesService) EnablePrivateReporting(ctx context.Context, owner, repository string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repository)
+
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DisablePrivateReporting disables private vulnerability reporting for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos#disable-private-vulnerability-reporting-for-a-repository
+func (s *RepositoriesService) DisablePrivateReporting(ctx context.Context, owner, repository string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repository)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // ListContributors lists contributors for a repository.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-repository-contributors
@@ -788,12 +953,30 @@ type RequiredStatusChecks struct {
 	// The list of status checks to require in order to merge into this
 	// branch. (Required; use []string{} instead of nil for empty list.)
 	Contexts []string `json:"contexts"`
+	// The list of status checks to require in order to merge into this
+	// branch, optionally scoped to the GitHub App expected to set them.
+	// If both Contexts and Checks are set, GitHub uses Checks.
+	Checks []*RequiredStatusCheck `json:"checks,omitempty"`
+}
+
+// RequiredStatusCheck represents a status check context that must pass,
+// optionally restricted to the GitHub App expected to set it.
+type RequiredStatusCheck struct {
+	// The name of the required check.
+	Context string `json:"context"`
+	// The ID of the GitHub App that must provide this check.
+	// Omit this field to automatically select the GitHub App
+	// that has recently provided this check,
+	// or any app if it was not set by a GitHub App.
+	// Pass -1 to explicitly allow any app to set the status.
+	AppID *int64 `json:"app_id,omitempty"`
 }
 
 // RequiredStatusChecksRequest represents a request to edit a protected branch's status checks.
 type RequiredStatusChecksRequest struct {
-	Strict   *bool    `json:"strict,omitempty"`
-	Contexts []string `json:"contexts,omitempty"`
+	Strict   *bool                  `json:"strict,omitempty"`
+	Contexts []string               `json:"contexts,omitempty"`
+	Checks   []*RequiredStatusCheck `json:"checks,omitempty"`
 }
 
 // PullRequestReviewsEnforcement represents the pull request reviews enforcement of a protected branch.
@@ -0,0 +1,78 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListRepositoryActivityOptions specifies the optional parameters to the
+// RepositoriesService.ListActivities method.
+type ListRepositoryActivityOptions struct {
+	// Direction in which to sort activities. Possible values are: asc, desc.
+	// Default is "desc".
+	Direction string `url:"direction,omitempty"`
+
+	// Ref filters activities by the Git reference, e.g. "refs/heads/main" or
+	// just "main".
+	Ref string `url:"ref,omitempty"`
+
+	// Actor filters activities by the login of the user who performed them.
+	Actor string `url:"actor,omitempty"`
+
+	// TimePeriod filters activities by the time period in which they
+	// occurred. Possible values are: day, week, month, quarter, year.
+	TimePeriod string `url:"time_period,omitempty"`
+
+	// ActivityType filters activities by type. Possible values are: push,
+	// force_push, branch_creation, branch_deletion, pr_merge,
+	// merge_queue_merge.
+	ActivityType string `url:"activity_type,omitempty"`
+
+	ListCursorOptions
+}
+
+// RepositoryActivity represents a change to a ref of a repository.
+type RepositoryActivity struct {
+	ID     *int64  `json:"id,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
+	// Before and After are the SHAs the ref pointed to before and after the activity.
+	Before    *string    `json:"before,omitempty"`
+	After     *string    `json:"after,omitempty"`
+	Ref       *string    `json:"ref,omitempty"`
+	Timestamp *Timestamp `json:"timestamp,omitempty"`
+	// Possible values for ActivityType are: push, force_push, branch_creation,
+	// branch_deletion, pr_merge, merge_queue_merge.
+	ActivityType *string `json:"activity_type,omitempty"`
+	Actor        *User   `json:"actor,omitempty"`
+}
+
+// ListActivities lists the activities of a repository, such as pushes,
+// force pushes and branch deletions. Results are paginated with cursors;
+// set opts.After to Response.After to fetch the next page.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos#list-repository-activities
+func (s *RepositoriesService) ListActivities(ctx context.Context, owner, repo string, opts *ListRepositoryActivityOptions) ([]*RepositoryActivity, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/activity", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var activities []*RepositoryActivity
+	resp, err := s.client.Do(ctx, req, &activities)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return activities, resp, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepositoriesService_ListActivities(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/activity", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"activity_type": "force_push",
+			"actor":         "octocat",
+			"per_page":      "1",
+			"after":         "c1",
+		})
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/activity?activity_type=force_push&actor=octocat&per_page=1&after=c2>; rel="next"`)
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"node_id": "n",
+			"before": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"after": "827efc6d56897b048c772eb4087f854f46256132",
+			"ref": "refs/heads/main",
+			"timestamp": `+referenceTimeStr+`,
+			"activity_type": "force_push",
+			"actor": {"login": "octocat", "id": 1}
+		}]`)
+	})
+
+	opts := &ListRepositoryActivityOptions{
+		ActivityType:      "force_push",
+		Actor:             "octocat",
+		ListCursorOptions: ListCursorOptions{PerPage: 1, After: "c1"},
+	}
+	ctx := context.Background()
+	activities, resp, err := client.Repositories.ListActivities(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Repositories.ListActivities returned error: %v", err)
+	}
+
+	want := []*RepositoryActivity{{
+		ID:           Int64(1),
+		NodeID:       String("n"),
+		Before:       String("6dcb09b5b57875f334f61aebed695e2e4193db5e"),
+		After:        String("827efc6d56897b048c772eb4087f854f46256132"),
+		Ref:          String("refs/heads/main"),
+		Timestamp:    &Timestamp{referenceTime},
+		ActivityType: String("force_push"),
+		Actor:        &User{Login: String("octocat"), ID: Int64(1)},
+	}}
+	if !cmp.Equal(activities, want) {
+		t.Errorf("Repositories.ListActivities returned %+v, want %+v", activities, want)
+	}
+	if got, want := resp.After, "c2"; got != want {
+		t.Errorf("Repositories.ListActivities returned resp.After %q, want %q", got, want)
+	}
+
+	const methodName = "ListActivities"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListActivities(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.ListActivities(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 )
@@ -28,12 +29,17 @@ type RepositoryComment struct {
 	// User-initialized fields
 	Path     *string `json:"path,omitempty"`
 	Position *int    `json:"position,omitempty"`
+	Line     *int    `json:"line,omitempty"`
 }
 
 func (r RepositoryComment) String() string {
 	return Stringify(r)
 }
 
+// errCommentPosition is returned when a line comment sets Path without
+// Position or Line, or sets Position or Line without Path.
+var errCommentPosition = errors.New("path must be set together with position or line for line comments")
+
 // ListComments lists all the comments for the repository.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-commit-comments-for-a-repository
@@ -89,10 +95,15 @@ func (s *RepositoriesService) ListCommitComments(ctx context.Context, owner, rep
 }
 
 // CreateComment creates a comment for the given commit.
+// To comment on a line, set Path along with Position or Line.
 // Note: GitHub allows for comments to be created for non-existing files and positions.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#create-a-commit-comment
 func (s *RepositoriesService) CreateComment(ctx context.Context, owner, repo, sha string, comment *RepositoryComment) (*RepositoryComment, *Response, error) {
+	if comment != nil && (comment.Path == nil) != (comment.Position == nil && comment.Line == nil) {
+		return nil, nil, errCommentPosition
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/commits/%v/comments", owner, repo, sha)
 	req, err := s.client.NewRequest("POST", u, comment)
 	if err != nil {
@@ -70,7 +70,7 @@ func TestRepositoriesService_ListCommitComments(t *testing.T) {
 		testMethod(t, r, "GET")
 		testHeader(t, r, "Accept", mediaTypeReactionsPreview)
 		testFormValues(t, r, values{"page": "2"})
-		fmt.Fprint(w, `[{"id":1}, {"id":2}]`)
+		fmt.Fprint(w, `[{"id":1,"commit_id":"s","path":"main.go","position":3,"line":7,"reactions":{"+1":1}}, {"id":2,"commit_id":"s"}]`)
 	})
 
 	opt := &ListOptions{Page: 2}
@@ -80,7 +80,17 @@ func TestRepositoriesService_ListCommitComments(t *testing.T) {
 		t.Errorf("Repositories.ListCommitComments returned error: %v", err)
 	}
 
-	want := []*RepositoryComment{{ID: Int64(1)}, {ID: Int64(2)}}
+	want := []*RepositoryComment{
+		{
+			ID:        Int64(1),
+			CommitID:  String("s"),
+			Path:      String("main.go"),
+			Position:  Int(3),
+			Line:      Int(7),
+			Reactions: &Reactions{PlusOne: Int(1)},
+		},
+		{ID: Int64(2), CommitID: String("s")},
+	}
 	if !cmp.Equal(comments, want) {
 		t.Errorf("Repositories.ListCommitComments returned %+v, want %+v", comments, want)
 	}
@@ -153,6 +163,85 @@ func TestRepositoriesService_CreateComment(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_CreateComment_line(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &RepositoryComment{
+		Body:     String("b"),
+		Path:     String("main.go"),
+		Position: Int(3),
+	}
+
+	mux.HandleFunc("/repos/o/r/commits/s/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"b","path":"main.go","position":3}`+"\n")
+		fmt.Fprint(w, `{"id":1,"commit_id":"s","path":"main.go","position":3,"line":7}`)
+	})
+
+	ctx := context.Background()
+	comment, _, err := client.Repositories.CreateComment(ctx, "o", "r", "s", input)
+	if err != nil {
+		t.Errorf("Repositories.CreateComment returned error: %v", err)
+	}
+
+	want := &RepositoryComment{
+		ID:       Int64(1),
+		CommitID: String("s"),
+		Path:     String("main.go"),
+		Position: Int(3),
+		Line:     Int(7),
+	}
+	if !cmp.Equal(comment, want) {
+		t.Errorf("Repositories.CreateComment returned %+v, want %+v", comment, want)
+	}
+}
+
+func TestRepositoriesService_CreateComment_pathAndLine(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &RepositoryComment{
+		Body: String("b"),
+		Path: String("main.go"),
+		Line: Int(7),
+	}
+
+	mux.HandleFunc("/repos/o/r/commits/s/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"b","path":"main.go","line":7}`+"\n")
+		fmt.Fprint(w, `{"id":1,"path":"main.go","line":7}`)
+	})
+
+	ctx := context.Background()
+	comment, _, err := client.Repositories.CreateComment(ctx, "o", "r", "s", input)
+	if err != nil {
+		t.Errorf("Repositories.CreateComment returned error: %v", err)
+	}
+
+	want := &RepositoryComment{ID: Int64(1), Path: String("main.go"), Line: Int(7)}
+	if !cmp.Equal(comment, want) {
+		t.Errorf("Repositories.CreateComment returned %+v, want %+v", comment, want)
+	}
+}
+
+func TestRepositoriesService_CreateComment_positionWithoutPath(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	for _, input := range []*RepositoryComment{
+		{Body: String("b"), Position: Int(3)},
+		{Body: String("b"), Line: Int(7)},
+		{Body: String("b"), Path: String("main.go")},
+	} {
+		_, _, err := client.Repositories.CreateComment(ctx, "o", "r", "s", input)
+		if err != errCommentPosition {
+			t.Errorf("Repositories.CreateComment(%v) returned error %v, want %v", input, err, errCommentPosition)
+		}
+	}
+}
+
 func TestRepositoriesService_CreateComment_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -340,6 +429,7 @@ func TestRepositoryComment_Marshal(t *testing.T) {
 		Body:      String("body"),
 		Path:      String("path"),
 		Position:  Int(1),
+		Line:      Int(2),
 	}
 
 	want := `{
@@ -384,7 +474,8 @@ func TestRepositoryComment_Marshal(t *testing.T) {
 		"updated_at": ` + referenceTimeStr + `,
 		"body": "body",
 		"path": "path",
-		"position": 1
+		"position": 1,
+		"line": 2
 	}`
 
 	testJSONMarshal(t, r, want)
@@ -9,7 +9,11 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -67,13 +71,77 @@ func (c CommitFile) String() string {
 	return Stringify(c)
 }
 
+// DiffHunk represents a single hunk of a unified diff, as found in
+// CommitFile.Patch.
+type DiffHunk struct {
+	OldStart int // first line of the hunk in the original file
+	OldLines int // number of lines of the hunk in the original file
+	NewStart int // first line of the hunk in the new file
+	NewLines int // number of lines of the hunk in the new file
+
+	// Section is the optional text following the hunk range, usually the
+	// enclosing function or section heading.
+	Section string
+
+	// Lines contains the lines of the hunk, each prefixed by ' ', '+', '-'
+	// or '\'.
+	Lines []string
+}
+
+var hunkHeaderRE = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@ ?(.*)$`)
+
+// ParsePatch parses the Patch field of the file into structured hunks.
+// It returns nil if the file has no patch, which is the case for binary
+// files or files whose diff is too large.
+func (c *CommitFile) ParsePatch() ([]DiffHunk, error) {
+	patch := c.GetPatch()
+	if patch == "" {
+		return nil, nil
+	}
+
+	var hunks []DiffHunk
+	for i, line := range strings.Split(strings.TrimSuffix(patch, "\n"), "\n") {
+		if strings.HasPrefix(line, "@@") {
+			m := hunkHeaderRE.FindStringSubmatch(line)
+			if m == nil {
+				return nil, fmt.Errorf("invalid hunk header on line %v: %q", i+1, line)
+			}
+			hunks = append(hunks, DiffHunk{
+				OldStart: atoiOr(m[1], 0),
+				OldLines: atoiOr(m[2], 1),
+				NewStart: atoiOr(m[3], 0),
+				NewLines: atoiOr(m[4], 1),
+				Section:  m[5],
+			})
+			continue
+		}
+		if len(hunks) == 0 {
+			return nil, fmt.Errorf("unexpected line %v outside of a hunk: %q", i+1, line)
+		}
+		h := &hunks[len(hunks)-1]
+		h.Lines = append(h.Lines, line)
+	}
+
+	return hunks, nil
+}
+
+// atoiOr returns the integer value of s, or def if s is empty.
+// s must only contain digits.
+func atoiOr(s string, def int) int {
+	if s == "" {
+		return def
+	}
+	v, _ := strconv.Atoi(s)
+	return v
+}
+
 // CommitsComparison is the result of comparing two commits.
 // See CompareCommits() for details.
 type CommitsComparison struct {
 	BaseCommit      *RepositoryCommit `json:"base_commit,omitempty"`
 	MergeBaseCommit *RepositoryCommit `json:"merge_base_commit,omitempty"`
 
-	// Head can be 'behind' or 'ahead'
+	// Status is the status of head relative to base, see ComparisonStatus.
 	Status       *string `json:"status,omitempty"`
 	AheadBy      *int    `json:"ahead_by,omitempty"`
 	BehindBy     *int    `json:"behind_by,omitempty"`
@@ -94,6 +162,50 @@ func (c CommitsComparison) String() string {
 	return Stringify(c)
 }
 
+// TotalStats returns the sum of the additions and deletions of the files in
+// the comparison. The total counts the changes of each file, or its additions
+// and deletions if GitHub doesn't report its changes. Note that the sum only
+// covers the files in c; GitHub lists at most 300 files per page of a
+// comparison.
+func (c *CommitsComparison) TotalStats() *CommitStats {
+	var additions, deletions, total int
+	for _, f := range c.Files {
+		additions += f.GetAdditions()
+		deletions += f.GetDeletions()
+		if f.Changes != nil {
+			total += f.GetChanges()
+		} else {
+			total += f.GetAdditions() + f.GetDeletions()
+		}
+	}
+	return &CommitStats{
+		Additions: Int(additions),
+		Deletions: Int(deletions),
+		Total:     Int(total),
+	}
+}
+
+// ComparisonStatus represents the value of CommitsComparison.Status.
+type ComparisonStatus string
+
+// This is the set of comparison statuses reported by the GitHub API.
+const (
+	ComparisonStatusAhead     ComparisonStatus = "ahead"
+	ComparisonStatusBehind    ComparisonStatus = "behind"
+	ComparisonStatusDiverged  ComparisonStatus = "diverged"
+	ComparisonStatusIdentical ComparisonStatus = "identical"
+)
+
+// IsValid reports whether s is one of the comparison statuses reported by
+// the GitHub API.
+func (s ComparisonStatus) IsValid() bool {
+	switch s {
+	case ComparisonStatusAhead, ComparisonStatusBehind, ComparisonStatusDiverged, ComparisonStatusIdentical:
+		return true
+	}
+	return false
+}
+
 // CommitsListOptions specifies the optional parameters to the
 // RepositoriesService.ListCommits method.
 type CommitsListOptions struct {
@@ -106,10 +218,14 @@ type CommitsListOptions struct {
 	// Author of by which to filter Commits.
 	Author string `url:"author,omitempty"`
 
+	// Committer by which to filter Commits.
+	Committer string `url:"committer,omitempty"`
+
 	// Since when should Commits be included in the response.
 	Since time.Time `url:"since,omitempty"`
 
-	// Until when should Commits be included in the response.
+	// Until when should Commits be included in the response. If both Since
+	// and Until are set, Until must not be before Since.
 	Until time.Time `url:"until,omitempty"`
 
 	ListOptions
@@ -126,6 +242,10 @@ type BranchCommit struct {
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-commits
 func (s *RepositoriesService) ListCommits(ctx context.Context, owner, repo string, opts *CommitsListOptions) ([]*RepositoryCommit, *Response, error) {
+	if opts != nil && !opts.Since.IsZero() && !opts.Until.IsZero() && opts.Until.Before(opts.Since) {
+		return nil, nil, fmt.Errorf("until (%v) must not be before since (%v)", opts.Until.Format(time.RFC3339), opts.Since.Format(time.RFC3339))
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/commits", owner, repo)
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -225,8 +345,124 @@ func (s *RepositoriesService) GetCommitSHA1(ctx context.Context, owner, repo, re
 	return buf.String(), resp, nil
 }
 
+// RefNotFoundError occurs when RepositoriesService.ResolveRef is given a ref
+// that does not name a commit in the repository.
+type RefNotFoundError struct {
+	Ref string // The ref that could not be resolved.
+	Err error  // The underlying error, if any.
+}
+
+func (e *RefNotFoundError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("ref %q not found: %v", e.Ref, e.Err)
+	}
+	return fmt.Sprintf("ref %q not found", e.Ref)
+}
+
+// Unwrap returns the underlying error.
+func (e *RefNotFoundError) Unwrap() error { return e.Err }
+
+// ResolveRef resolves ref to the full SHA-1 of the commit it names. ref may be
+// a branch name, a tag name, a full or abbreviated SHA, or HEAD, optionally
+// followed by "~N" and "^N" ancestry suffixes as understood by git, e.g.
+// "HEAD~2" or "main^2". Each ancestry step costs one additional request.
+//
+// If ref does not name a commit, a *RefNotFoundError is returned.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-commit
+func (s *RepositoriesService) ResolveRef(ctx context.Context, owner, repo, ref string) (string, *Response, error) {
+	base, steps, err := parseRefAncestry(ref)
+	if err != nil {
+		return "", nil, err
+	}
+
+	sha, resp, err := s.GetCommitSHA1(ctx, owner, repo, base, "")
+	if err != nil {
+		return "", resp, refNotFound(ref, err)
+	}
+
+	for _, parent := range steps {
+		if parent == 0 {
+			continue
+		}
+
+		commit, cresp, err := s.GetCommit(ctx, owner, repo, sha, nil)
+		if err != nil {
+			return "", cresp, refNotFound(ref, err)
+		}
+		resp = cresp
+
+		if parent > len(commit.Parents) {
+			return "", resp, &RefNotFoundError{Ref: ref}
+		}
+		sha = commit.Parents[parent-1].GetSHA()
+	}
+
+	return sha, resp, nil
+}
+
+// refNotFound wraps err in a *RefNotFoundError if it reports that ref
+// does not exist, and returns it unchanged otherwise.
+func refNotFound(ref string, err error) error {
+	if e, ok := err.(*ErrorResponse); ok && e.Response != nil {
+		switch e.Response.StatusCode {
+		case http.StatusNotFound, http.StatusUnprocessableEntity:
+			return &RefNotFoundError{Ref: ref, Err: err}
+		}
+	}
+	return err
+}
+
+// parseRefAncestry splits a ref such as "HEAD~2^2" into its base ref and the
+// sequence of parents to follow from it, where 1 is the first parent.
+func parseRefAncestry(ref string) (base string, steps []int, err error) {
+	i := strings.IndexAny(ref, "~^")
+	if i < 0 {
+		return ref, nil, nil
+	}
+	base, rest := ref[:i], ref[i:]
+	if base == "" {
+		return "", nil, fmt.Errorf("invalid ref expression %q", ref)
+	}
+
+	for rest != "" {
+		op := rest[0]
+		rest = rest[1:]
+
+		j := 0
+		for j < len(rest) && rest[j] >= '0' && rest[j] <= '9' {
+			j++
+		}
+		n := 1
+		if j > 0 {
+			if n, err = strconv.Atoi(rest[:j]); err != nil {
+				return "", nil, fmt.Errorf("invalid ref expression %q", ref)
+			}
+		}
+		rest = rest[j:]
+
+		switch op {
+		case '~':
+			for ; n > 0; n-- {
+				steps = append(steps, 1)
+			}
+		case '^':
+			steps = append(steps, n)
+		default:
+			return "", nil, fmt.Errorf("invalid ref expression %q", ref)
+		}
+	}
+
+	return base, steps, nil
+}
+
 // CompareCommits compares a range of commits with each other.
 //
+// Large comparisons are paginated with opts. Each page holds a part of
+// Commits and Files, and Response.NextPage is set while more remain. GitHub
+// truncates Files for very large diffs, in which case CompareCommitsRaw can
+// be used to get the complete diff.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#compare-two-commits
 func (s *RepositoriesService) CompareCommits(ctx context.Context, owner, repo string, base, head string, opts *ListOptions) (*CommitsComparison, *Response, error) {
 	escapedBase := url.QueryEscape(base)
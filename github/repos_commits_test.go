@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -69,6 +70,46 @@ func TestRepositoriesService_ListCommits(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListCommits_authorPathSinceUntil(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		want := "author=a&committer=c&path=p&since=2013-08-01T00%3A00%3A00Z&until=2013-09-03T00%3A00%3A00Z"
+		if got := r.URL.RawQuery; got != want {
+			t.Errorf("Request query = %v, want %v", got, want)
+		}
+		fmt.Fprintf(w, `[{"sha": "s"}]`)
+	})
+
+	opt := &CommitsListOptions{
+		Path:      "p",
+		Author:    "a",
+		Committer: "c",
+		Since:     time.Date(2013, time.August, 1, 0, 0, 0, 0, time.UTC),
+		Until:     time.Date(2013, time.September, 3, 0, 0, 0, 0, time.UTC),
+	}
+	ctx := context.Background()
+	if _, _, err := client.Repositories.ListCommits(ctx, "o", "r", opt); err != nil {
+		t.Errorf("Repositories.ListCommits returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_ListCommits_untilBeforeSince(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	opt := &CommitsListOptions{
+		Since: time.Date(2013, time.September, 3, 0, 0, 0, 0, time.UTC),
+		Until: time.Date(2013, time.August, 1, 0, 0, 0, 0, time.UTC),
+	}
+	ctx := context.Background()
+	if _, _, err := client.Repositories.ListCommits(ctx, "o", "r", opt); err == nil {
+		t.Error("Repositories.ListCommits returned nil error, want error")
+	}
+}
+
 func TestRepositoriesService_GetCommit(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -290,6 +331,106 @@ func TestRepositoriesService_GetCommitSHA1(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ResolveRef(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	const (
+		sha1     = "0123456789abcdef0123456789abcdef01234567"
+		parent   = "1123456789abcdef0123456789abcdef01234567"
+		ancestor = "2123456789abcdef0123456789abcdef01234567"
+	)
+
+	commitSHA := func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3SHA)
+		fmt.Fprint(w, sha1)
+	}
+	mux.HandleFunc("/repos/o/r/commits/main", commitSHA)
+	mux.HandleFunc("/repos/o/r/commits/0123456", commitSHA)
+	mux.HandleFunc("/repos/o/r/commits/HEAD", commitSHA)
+	mux.HandleFunc("/repos/o/r/commits/"+sha1, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"sha":%q,"parents":[{"sha":%q}]}`, sha1, parent)
+	})
+	mux.HandleFunc("/repos/o/r/commits/"+parent, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"sha":%q,"parents":[{"sha":%q}]}`, parent, ancestor)
+	})
+
+	tests := []struct {
+		ref  string
+		want string
+	}{
+		{ref: "main", want: sha1},
+		{ref: "0123456", want: sha1},
+		{ref: "HEAD~2", want: ancestor},
+		{ref: "main^", want: parent},
+		{ref: "main~1^1", want: ancestor},
+		{ref: "main^0", want: sha1},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		got, _, err := client.Repositories.ResolveRef(ctx, "o", "r", tt.ref)
+		if err != nil {
+			t.Errorf("Repositories.ResolveRef(%q) returned error: %v", tt.ref, err)
+		}
+		if got != tt.want {
+			t.Errorf("Repositories.ResolveRef(%q) = %v, want %v", tt.ref, got, tt.want)
+		}
+	}
+
+	const methodName = "ResolveRef"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ResolveRef(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.ResolveRef(ctx, "o", "r", "main")
+		if got != "" {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want empty", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_ResolveRef_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	const sha1 = "0123456789abcdef0123456789abcdef01234567"
+
+	mux.HandleFunc("/repos/o/r/commits/nope", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"No commit found for SHA: nope"}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/root", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, sha1)
+	})
+	mux.HandleFunc("/repos/o/r/commits/"+sha1, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"sha":%q,"parents":[]}`, sha1)
+	})
+
+	ctx := context.Background()
+	for _, ref := range []string{"nope", "root~1"} {
+		_, _, err := client.Repositories.ResolveRef(ctx, "o", "r", ref)
+		var nf *RefNotFoundError
+		if !errors.As(err, &nf) {
+			t.Fatalf("Repositories.ResolveRef(%q) returned error %v, want *RefNotFoundError", ref, err)
+		}
+		if nf.Ref != ref {
+			t.Errorf("RefNotFoundError.Ref = %q, want %q", nf.Ref, ref)
+		}
+	}
+
+	if _, _, err := client.Repositories.ResolveRef(ctx, "o", "r", "~1"); err == nil {
+		t.Error("Repositories.ResolveRef returned nil error for an invalid ref expression")
+	}
+}
+
 func TestRepositoriesService_NonAlphabetCharacter_GetCommitSHA1(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -505,6 +646,114 @@ func TestRepositoriesService_CompareCommits(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_CompareCommits_paginatedFiles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "", "1":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/compare/b...h?page=2>; rel="next", <https://api.github.com/repos/o/r/compare/b...h?page=2>; rel="last"`)
+			fmt.Fprint(w, `{"status":"diverged","ahead_by":2,"behind_by":1,"files":[{"filename":"a","status":"added"},{"filename":"b","status":"modified"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"status":"diverged","ahead_by":2,"behind_by":1,"files":[{"filename":"c","status":"removed"}]}`)
+		default:
+			t.Errorf("Unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{PerPage: 2}
+	var files []*CommitFile
+	for {
+		comp, resp, err := client.Repositories.CompareCommits(ctx, "o", "r", "b", "h", opts)
+		if err != nil {
+			t.Fatalf("Repositories.CompareCommits returned error: %v", err)
+		}
+		if got := ComparisonStatus(comp.GetStatus()); got != ComparisonStatusDiverged {
+			t.Errorf("Repositories.CompareCommits returned status %q, want %q", got, ComparisonStatusDiverged)
+		}
+		files = append(files, comp.Files...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	want := []*CommitFile{
+		{Filename: String("a"), Status: String("added")},
+		{Filename: String("b"), Status: String("modified")},
+		{Filename: String("c"), Status: String("removed")},
+	}
+	if !cmp.Equal(files, want) {
+		t.Errorf("Repositories.CompareCommits returned files %+v, want %+v", files, want)
+	}
+}
+
+func TestCommitsComparison_TotalStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"files":[
+			{"filename":"a","additions":10,"deletions":2,"changes":12},
+			{"filename":"b","additions":0,"deletions":5,"changes":5},
+			{"filename":"c","additions":3,"deletions":1,"changes":4}
+		]}`)
+	})
+
+	ctx := context.Background()
+	comp, _, err := client.Repositories.CompareCommits(ctx, "o", "r", "b", "h", nil)
+	if err != nil {
+		t.Fatalf("Repositories.CompareCommits returned error: %v", err)
+	}
+
+	wantFiles := []*CommitFile{
+		{Filename: String("a"), Additions: Int(10), Deletions: Int(2), Changes: Int(12)},
+		{Filename: String("b"), Additions: Int(0), Deletions: Int(5), Changes: Int(5)},
+		{Filename: String("c"), Additions: Int(3), Deletions: Int(1), Changes: Int(4)},
+	}
+	if !cmp.Equal(comp.Files, wantFiles) {
+		t.Errorf("Repositories.CompareCommits returned files %+v, want %+v", comp.Files, wantFiles)
+	}
+
+	want := &CommitStats{Additions: Int(13), Deletions: Int(8), Total: Int(21)}
+	if got := comp.TotalStats(); !cmp.Equal(got, want) {
+		t.Errorf("CommitsComparison.TotalStats returned %+v, want %+v", got, want)
+	}
+}
+
+func TestCommitsComparison_TotalStats_missingChanges(t *testing.T) {
+	comp := &CommitsComparison{Files: []*CommitFile{
+		{Additions: Int(1), Deletions: Int(2)},
+		{Additions: Int(3), Changes: Int(3)},
+		{},
+	}}
+
+	want := &CommitStats{Additions: Int(4), Deletions: Int(2), Total: Int(6)}
+	if got := comp.TotalStats(); !cmp.Equal(got, want) {
+		t.Errorf("CommitsComparison.TotalStats returned %+v, want %+v", got, want)
+	}
+
+	want = &CommitStats{Additions: Int(0), Deletions: Int(0), Total: Int(0)}
+	if got := new(CommitsComparison).TotalStats(); !cmp.Equal(got, want) {
+		t.Errorf("CommitsComparison.TotalStats returned %+v for no files, want %+v", got, want)
+	}
+}
+
+func TestComparisonStatus_IsValid(t *testing.T) {
+	for _, s := range []ComparisonStatus{ComparisonStatusAhead, ComparisonStatusBehind, ComparisonStatusDiverged, ComparisonStatusIdentical} {
+		if !s.IsValid() {
+			t.Errorf("ComparisonStatus(%q).IsValid() = false, want true", s)
+		}
+	}
+	if ComparisonStatus("s").IsValid() {
+		t.Error(`ComparisonStatus("s").IsValid() = true, want false`)
+	}
+}
+
 func TestRepositoriesService_CompareCommitsRaw_diff(t *testing.T) {
 	testCases := []struct {
 		base string
@@ -967,3 +1216,54 @@ func TestRepositoryCommit_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, r, want)
 }
+
+func TestCommitFile_ParsePatch(t *testing.T) {
+	f := &CommitFile{
+		Patch: String("@@ -1,3 +1,4 @@ func main() {\n a\n-b\n+c\n+d\n e\n@@ -10 +11 @@\n-x\n+y\n\\ No newline at end of file"),
+	}
+
+	hunks, err := f.ParsePatch()
+	if err != nil {
+		t.Fatalf("CommitFile.ParsePatch returned error: %v", err)
+	}
+
+	want := []DiffHunk{
+		{
+			OldStart: 1,
+			OldLines: 3,
+			NewStart: 1,
+			NewLines: 4,
+			Section:  "func main() {",
+			Lines:    []string{" a", "-b", "+c", "+d", " e"},
+		},
+		{
+			OldStart: 10,
+			OldLines: 1,
+			NewStart: 11,
+			NewLines: 1,
+			Lines:    []string{"-x", "+y", "\\ No newline at end of file"},
+		},
+	}
+	if !cmp.Equal(hunks, want) {
+		t.Errorf("CommitFile.ParsePatch returned %+v, want %+v", hunks, want)
+	}
+}
+
+func TestCommitFile_ParsePatch_empty(t *testing.T) {
+	hunks, err := (&CommitFile{}).ParsePatch()
+	if err != nil {
+		t.Errorf("CommitFile.ParsePatch returned error: %v", err)
+	}
+	if hunks != nil {
+		t.Errorf("CommitFile.ParsePatch returned %+v, want nil", hunks)
+	}
+}
+
+func TestCommitFile_ParsePatch_invalid(t *testing.T) {
+	for _, patch := range []string{"+a", "@@ -a +b @@"} {
+		f := &CommitFile{Patch: String(patch)}
+		if _, err := f.ParsePatch(); err == nil {
+			t.Errorf("CommitFile.ParsePatch(%q) returned nil error, want error", patch)
+		}
+	}
+}
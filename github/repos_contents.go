@@ -263,6 +263,82 @@ func (s *RepositoriesService) DeleteFile(ctx context.Context, owner, repo, path
 	return deleteResponse, resp, nil
 }
 
+// MoveFileResult holds the commits made by MoveFile.
+type MoveFileResult struct {
+	// Create is the result of creating the file at the destination path.
+	Create *RepositoryContentResponse
+	// Delete is the result of deleting the file at the source path.
+	Delete *RepositoryContentResponse
+}
+
+// MoveFileError is returned by MoveFile when one of its steps fails.
+// Step is one of "get", "create" or "delete". If Step is "delete", the
+// destination file has already been created.
+type MoveFileError struct {
+	Step string
+	Err  error
+}
+
+func (e *MoveFileError) Error() string {
+	return fmt.Sprintf("move file: %v step failed: %v", e.Step, e.Err)
+}
+
+// Unwrap returns the error of the failed step.
+func (e *MoveFileError) Unwrap() error { return e.Err }
+
+// MoveFile moves a file within a repository by reading the file at from,
+// creating a file with the same content at to, and deleting the file at from,
+// all on opts.Branch (or the default branch if unset). opts.Message is used
+// for both commits and opts.SHA is ignored.
+//
+// The move is not atomic: on failure a *MoveFileError reports the step that
+// failed, and the returned MoveFileResult holds the commits made so far.
+func (s *RepositoriesService) MoveFile(ctx context.Context, owner, repo, from, to string, opts *RepositoryContentFileOptions) (*MoveFileResult, *Response, error) {
+	if opts == nil || opts.Message == nil {
+		return nil, nil, errors.New("opts.Message must be provided")
+	}
+
+	getOpts := &RepositoryContentGetOptions{Ref: opts.GetBranch()}
+	file, _, resp, err := s.GetContents(ctx, owner, repo, from, getOpts)
+	if err == nil && file == nil {
+		err = fmt.Errorf("%v is not a file", from)
+	}
+	if err != nil {
+		return nil, resp, &MoveFileError{Step: "get", Err: err}
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return nil, resp, &MoveFileError{Step: "get", Err: err}
+	}
+
+	result := new(MoveFileResult)
+	createOpts := &RepositoryContentFileOptions{
+		Message:   opts.Message,
+		Content:   []byte(content),
+		Branch:    opts.Branch,
+		Author:    opts.Author,
+		Committer: opts.Committer,
+	}
+	result.Create, resp, err = s.CreateFile(ctx, owner, repo, to, createOpts)
+	if err != nil {
+		return result, resp, &MoveFileError{Step: "create", Err: err}
+	}
+
+	deleteOpts := &RepositoryContentFileOptions{
+		Message:   opts.Message,
+		SHA:       file.SHA,
+		Branch:    opts.Branch,
+		Author:    opts.Author,
+		Committer: opts.Committer,
+	}
+	result.Delete, resp, err = s.DeleteFile(ctx, owner, repo, from, deleteOpts)
+	if err != nil {
+		return result, resp, &MoveFileError{Step: "delete", Err: err}
+	}
+
+	return result, resp, nil
+}
+
 // ArchiveFormat is used to define the archive type when calling GetArchiveLink.
 type ArchiveFormat string
 
@@ -295,6 +371,39 @@ func (s *RepositoriesService) GetArchiveLink(ctx context.Context, owner, repo st
 	return parsedURL, newResponse(resp), err
 }
 
+// DownloadContentsTarball downloads a gzipped tar archive of the repository
+// at ref and streams it into w. If ref is empty, the repository's default
+// branch is used. The redirect to the archive location is followed
+// automatically.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/contents/#download-a-repository-archive-tar
+func (s *RepositoriesService) DownloadContentsTarball(ctx context.Context, owner, repo, ref string, w io.Writer) (*Response, error) {
+	return s.downloadArchive(ctx, owner, repo, Tarball, ref, w)
+}
+
+// DownloadContentsZipball downloads a zip archive of the repository at ref
+// and streams it into w. If ref is empty, the repository's default branch is
+// used. The redirect to the archive location is followed automatically.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/contents/#download-a-repository-archive-zip
+func (s *RepositoriesService) DownloadContentsZipball(ctx context.Context, owner, repo, ref string, w io.Writer) (*Response, error) {
+	return s.downloadArchive(ctx, owner, repo, Zipball, ref, w)
+}
+
+func (s *RepositoriesService) downloadArchive(ctx context.Context, owner, repo string, archiveFormat ArchiveFormat, ref string, w io.Writer) (*Response, error) {
+	u := fmt.Sprintf("repos/%s/%s/%s", owner, repo, archiveFormat)
+	if ref != "" {
+		u += fmt.Sprintf("/%s", ref)
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, w)
+}
+
 func (s *RepositoriesService) getArchiveLinkFromURL(ctx context.Context, u string, followRedirects bool) (*http.Response, error) {
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
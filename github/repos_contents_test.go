@@ -6,6 +6,7 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -667,6 +668,122 @@ func TestRepositoriesService_DeleteFile(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_MoveFile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/a.txt", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testFormValues(t, r, values{"ref": "b"})
+			fmt.Fprint(w, `{"type":"file","encoding":"base64","content":"aGVsbG8=","sha":"s1","path":"a.txt"}`)
+		case "DELETE":
+			testBody(t, r, `{"message":"m","sha":"s1","branch":"b"}`+"\n")
+			fmt.Fprint(w, `{"commit":{"sha":"c2"}}`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/contents/dir/b.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"message":"m","content":"aGVsbG8=","branch":"b"}`+"\n")
+		fmt.Fprint(w, `{"content":{"path":"dir/b.txt","sha":"s1"},"commit":{"sha":"c1"}}`)
+	})
+
+	opts := &RepositoryContentFileOptions{Message: String("m"), Branch: String("b")}
+	ctx := context.Background()
+	result, _, err := client.Repositories.MoveFile(ctx, "o", "r", "a.txt", "dir/b.txt", opts)
+	if err != nil {
+		t.Fatalf("Repositories.MoveFile returned error: %v", err)
+	}
+
+	want := &MoveFileResult{
+		Create: &RepositoryContentResponse{
+			Content: &RepositoryContent{Path: String("dir/b.txt"), SHA: String("s1")},
+			Commit:  Commit{SHA: String("c1")},
+		},
+		Delete: &RepositoryContentResponse{Commit: Commit{SHA: String("c2")}},
+	}
+	if !cmp.Equal(result, want) {
+		t.Errorf("Repositories.MoveFile returned %+v, want %+v", result, want)
+	}
+}
+
+func TestRepositoriesService_MoveFile_deleteFails(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/a.txt", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"type":"file","encoding":"base64","content":"aGVsbG8=","sha":"s1"}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"message":"a.txt does not match s1"}`)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/contents/b.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		fmt.Fprint(w, `{"commit":{"sha":"c1"}}`)
+	})
+
+	opts := &RepositoryContentFileOptions{Message: String("m")}
+	ctx := context.Background()
+	result, resp, err := client.Repositories.MoveFile(ctx, "o", "r", "a.txt", "b.txt", opts)
+	var moveErr *MoveFileError
+	if !errors.As(err, &moveErr) {
+		t.Fatalf("Repositories.MoveFile returned error %v, want *MoveFileError", err)
+	}
+	if moveErr.Step != "delete" {
+		t.Errorf("MoveFileError.Step = %q, want %q", moveErr.Step, "delete")
+	}
+	if _, ok := moveErr.Err.(*ErrorResponse); !ok {
+		t.Errorf("MoveFileError.Err = %#v, want *ErrorResponse", moveErr.Err)
+	}
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("Repositories.MoveFile returned status %v, want %v", resp.StatusCode, http.StatusConflict)
+	}
+
+	want := &MoveFileResult{Create: &RepositoryContentResponse{Commit: Commit{SHA: String("c1")}}}
+	if !cmp.Equal(result, want) {
+		t.Errorf("Repositories.MoveFile returned %+v, want %+v", result, want)
+	}
+}
+
+func TestRepositoriesService_MoveFile_getFails(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/a.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r/contents/b.txt", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Repositories.MoveFile created the destination after failing to read the source")
+	})
+
+	opts := &RepositoryContentFileOptions{Message: String("m")}
+	ctx := context.Background()
+	result, _, err := client.Repositories.MoveFile(ctx, "o", "r", "a.txt", "b.txt", opts)
+	var moveErr *MoveFileError
+	if !errors.As(err, &moveErr) || moveErr.Step != "get" {
+		t.Errorf("Repositories.MoveFile returned error %v, want *MoveFileError for the get step", err)
+	}
+	if result != nil {
+		t.Errorf("Repositories.MoveFile returned %+v, want nil", result)
+	}
+}
+
+func TestRepositoriesService_MoveFile_noMessage(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.MoveFile(ctx, "o", "r", "a.txt", "b.txt", nil); err == nil {
+		t.Error("Repositories.MoveFile returned no error without a commit message")
+	}
+}
+
 func TestRepositoriesService_GetArchiveLink(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -744,6 +861,86 @@ func TestRepositoriesService_GetArchiveLink_StatusMovedPermanently_followRedirec
 	}
 }
 
+func TestRepositoriesService_DownloadContentsTarball(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/tarball/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, serverURL+baseURLPath+"/codeload/o/r/legacy.tar.gz/main", http.StatusFound)
+	})
+	mux.HandleFunc("/codeload/o/r/legacy.tar.gz/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "application/x-gzip")
+		fmt.Fprint(w, "tarball bytes")
+	})
+
+	var buf bytes.Buffer
+	ctx := context.Background()
+	_, err := client.Repositories.DownloadContentsTarball(ctx, "o", "r", "main", &buf)
+	if err != nil {
+		t.Errorf("Repositories.DownloadContentsTarball returned error: %v", err)
+	}
+	if got, want := buf.String(), "tarball bytes"; got != want {
+		t.Errorf("Repositories.DownloadContentsTarball wrote %q, want %q", got, want)
+	}
+
+	const methodName = "DownloadContentsTarball"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.DownloadContentsTarball(ctx, "\n", "\n", "\n", &buf)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.DownloadContentsTarball(ctx, "o", "r", "main", &buf)
+	})
+}
+
+func TestRepositoriesService_DownloadContentsZipball(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/zipball", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, serverURL+baseURLPath+"/codeload/o/r/legacy.zip", http.StatusFound)
+	})
+	mux.HandleFunc("/codeload/o/r/legacy.zip", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "zipball bytes")
+	})
+
+	var buf bytes.Buffer
+	ctx := context.Background()
+	_, err := client.Repositories.DownloadContentsZipball(ctx, "o", "r", "", &buf)
+	if err != nil {
+		t.Errorf("Repositories.DownloadContentsZipball returned error: %v", err)
+	}
+	if got, want := buf.String(), "zipball bytes"; got != want {
+		t.Errorf("Repositories.DownloadContentsZipball wrote %q, want %q", got, want)
+	}
+}
+
+func TestRepositoriesService_DownloadContentsTarball_canceledContext(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/tarball/main", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "tarball bytes")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var buf bytes.Buffer
+	_, err := client.Repositories.DownloadContentsTarball(ctx, "o", "r", "main", &buf)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Repositories.DownloadContentsTarball returned error %v, want %v", err, context.Canceled)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Repositories.DownloadContentsTarball wrote %q, want nothing", buf.String())
+	}
+}
+
 func TestRepositoriesService_GetContents_NoTrailingSlashInDirectoryApiPath(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
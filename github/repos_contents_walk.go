@@ -0,0 +1,100 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"sync"
+)
+
+// directoryListing is the result of listing a directory for WalkContents.
+type directoryListing struct {
+	entries []*RepositoryContent
+	err     error
+}
+
+// WalkContents walks the file tree of a repository rooted at path, at the
+// given ref, calling fn for each file and directory in the tree, excluding
+// path itself. An empty ref means the default branch of the repository.
+//
+// The tree is walked depth-first, visiting the entries of each directory in
+// the order GitHub lists them, and fn is called for a directory before its
+// entries. fn is never called concurrently. A directory is only listed once
+// fn has been called for it, together with up to
+// defaultConcurrentRequests-1 of its following sibling directories, which
+// are listed concurrently ahead of time.
+// If path is a file, fn is only called for that file.
+//
+// Walking stops at the first error returned by fn or encountered while
+// listing a directory, or when ctx is done, and that error is returned.
+func (s *RepositoriesService) WalkContents(ctx context.Context, owner, repo, path, ref string, fn func(*RepositoryContent) error) error {
+	opts := &RepositoryContentGetOptions{Ref: ref}
+	file, dir, _, err := s.GetContents(ctx, owner, repo, path, opts)
+	if err != nil {
+		return err
+	}
+	if file != nil {
+		return fn(file)
+	}
+
+	var walk func(entries []*RepositoryContent) error
+	walk = func(entries []*RepositoryContent) error {
+		listings := make(map[int]*directoryListing)
+		for i, entry := range entries {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(entry); err != nil {
+				return err
+			}
+			if entry.GetType() != "dir" {
+				continue
+			}
+
+			l, ok := listings[i]
+			if !ok {
+				s.listDirectories(ctx, owner, repo, entries, i, opts, listings)
+				l = listings[i]
+			}
+			delete(listings, i)
+			if l.err != nil {
+				return l.err
+			}
+			if err := walk(l.entries); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return walk(dir)
+}
+
+// listDirectories lists the next defaultConcurrentRequests directories
+// among entries, starting at index start, concurrently. The listings are
+// added to listings, keyed by their index in entries.
+func (s *RepositoriesService) listDirectories(ctx context.Context, owner, repo string, entries []*RepositoryContent, start int, opts *RepositoryContentGetOptions, listings map[int]*directoryListing) {
+	var dirs []int
+	for i := start; i < len(entries) && len(dirs) < defaultConcurrentRequests; i++ {
+		if entries[i].GetType() == "dir" {
+			dirs = append(dirs, i)
+		}
+	}
+
+	var mu sync.Mutex
+	errs := runBounded(ctx, len(dirs), len(dirs), func(j int) error {
+		_, dir, _, err := s.GetContents(ctx, owner, repo, entries[dirs[j]].GetPath(), opts)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		listings[dirs[j]] = &directoryListing{entries: dir}
+		mu.Unlock()
+		return nil
+	})
+	for j, err := range errs {
+		listings[dirs[j]] = &directoryListing{err: err}
+	}
+}
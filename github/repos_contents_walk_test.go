@@ -0,0 +1,173 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// setupContentsTree registers handlers serving the following tree at ref
+// "main":
+//
+//	root/a
+//	root/b/x
+//	root/b/y/z
+//	root/c
+func setupContentsTree(t *testing.T, mux *http.ServeMux) {
+	t.Helper()
+
+	dirs := map[string]string{
+		"root":     `[{"type":"file","path":"root/a"},{"type":"dir","path":"root/b"},{"type":"file","path":"root/c"}]`,
+		"root/b":   `[{"type":"file","path":"root/b/x"},{"type":"dir","path":"root/b/y"}]`,
+		"root/b/y": `[{"type":"file","path":"root/b/y/z"}]`,
+	}
+	for path, listing := range dirs {
+		listing := listing
+		mux.HandleFunc("/repos/o/r/contents/"+path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			testFormValues(t, r, values{"ref": "main"})
+			fmt.Fprint(w, listing)
+		})
+	}
+	mux.HandleFunc("/repos/o/r/contents/root/a", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"type":"file","path":"root/a"}`)
+	})
+}
+
+func TestRepositoriesService_WalkContents(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	setupContentsTree(t, mux)
+
+	var visited []string
+	ctx := context.Background()
+	err := client.Repositories.WalkContents(ctx, "o", "r", "root", "main", func(c *RepositoryContent) error {
+		visited = append(visited, c.GetPath())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Repositories.WalkContents returned error: %v", err)
+	}
+
+	want := []string{"root/a", "root/b", "root/b/x", "root/b/y", "root/b/y/z", "root/c"}
+	if !cmp.Equal(visited, want) {
+		t.Errorf("Repositories.WalkContents visited %v, want %v", visited, want)
+	}
+}
+
+func TestRepositoriesService_WalkContents_file(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	setupContentsTree(t, mux)
+
+	var visited []string
+	ctx := context.Background()
+	err := client.Repositories.WalkContents(ctx, "o", "r", "root/a", "main", func(c *RepositoryContent) error {
+		visited = append(visited, c.GetPath())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Repositories.WalkContents returned error: %v", err)
+	}
+
+	if want := []string{"root/a"}; !cmp.Equal(visited, want) {
+		t.Errorf("Repositories.WalkContents visited %v, want %v", visited, want)
+	}
+}
+
+func TestRepositoriesService_WalkContents_stop(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	setupContentsTree(t, mux)
+
+	errStop := errors.New("stop")
+	var visited []string
+	ctx := context.Background()
+	err := client.Repositories.WalkContents(ctx, "o", "r", "root", "main", func(c *RepositoryContent) error {
+		visited = append(visited, c.GetPath())
+		if c.GetPath() == "root/b/x" {
+			return errStop
+		}
+		return nil
+	})
+	if err != errStop {
+		t.Errorf("Repositories.WalkContents returned error %v, want %v", err, errStop)
+	}
+
+	want := []string{"root/a", "root/b", "root/b/x"}
+	if !cmp.Equal(visited, want) {
+		t.Errorf("Repositories.WalkContents visited %v, want %v", visited, want)
+	}
+}
+
+func TestRepositoriesService_WalkContents_stopAtDirectory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/root", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"type":"dir","path":"root/a"},{"type":"dir","path":"root/b"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/contents/root/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Repositories.WalkContents listed %v after fn stopped the walk", r.URL.Path)
+		fmt.Fprint(w, `[]`)
+	})
+
+	errStop := errors.New("stop")
+	ctx := context.Background()
+	err := client.Repositories.WalkContents(ctx, "o", "r", "root", "", func(c *RepositoryContent) error {
+		return errStop
+	})
+	if err != errStop {
+		t.Errorf("Repositories.WalkContents returned error %v, want %v", err, errStop)
+	}
+}
+
+func TestRepositoriesService_WalkContents_canceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	setupContentsTree(t, mux)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var visited int
+	err := client.Repositories.WalkContents(ctx, "o", "r", "root", "main", func(c *RepositoryContent) error {
+		visited++
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("Repositories.WalkContents returned error %v, want %v", err, context.Canceled)
+	}
+	if visited != 1 {
+		t.Errorf("Repositories.WalkContents visited %v entries after cancellation, want 1", visited)
+	}
+}
+
+func TestRepositoriesService_WalkContents_listError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/root", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"type":"dir","path":"root/b"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/contents/root/b", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	err := client.Repositories.WalkContents(ctx, "o", "r", "root", "", func(c *RepositoryContent) error {
+		return nil
+	})
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Repositories.WalkContents returned error %v, want *ErrorResponse", err)
+	}
+}
@@ -131,6 +131,12 @@ func (s *RepositoriesService) CreateDeployment(ctx context.Context, owner, repo
 
 // DeleteDeployment deletes an existing deployment for a repository.
 //
+// If the repository has only one deployment, it can be deleted regardless of
+// its status; otherwise only inactive deployments can be deleted. To
+// deactivate a deployment, create a deployment status with the "inactive"
+// state. GitHub responds with a 422 Unprocessable Entity error when the
+// deployment is still active.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#delete-a-deployment
 func (s *RepositoriesService) DeleteDeployment(ctx context.Context, owner, repo string, deploymentID int64) (*Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/deployments/%v", owner, repo, deploymentID)
@@ -163,8 +169,36 @@ type DeploymentStatus struct {
 	URL            *string    `json:"url,omitempty"`
 }
 
+// DeploymentState represents the state of a DeploymentStatus.
+type DeploymentState string
+
+// This is the set of states a deployment status can have.
+const (
+	DeploymentStateError      DeploymentState = "error"
+	DeploymentStateFailure    DeploymentState = "failure"
+	DeploymentStateInactive   DeploymentState = "inactive"
+	DeploymentStateInProgress DeploymentState = "in_progress"
+	DeploymentStatePending    DeploymentState = "pending"
+	DeploymentStateQueued     DeploymentState = "queued"
+	DeploymentStateSuccess    DeploymentState = "success"
+)
+
+// IsValid reports whether s is one of the deployment states known to the
+// GitHub API.
+func (s DeploymentState) IsValid() bool {
+	switch s {
+	case DeploymentStateError, DeploymentStateFailure, DeploymentStateInactive,
+		DeploymentStateInProgress, DeploymentStatePending, DeploymentStateQueued,
+		DeploymentStateSuccess:
+		return true
+	}
+	return false
+}
+
 // DeploymentStatusRequest represents a deployment request
 type DeploymentStatusRequest struct {
+	// State is the state of the status. It is required and must be one of
+	// the DeploymentState values.
 	State          *string `json:"state,omitempty"`
 	LogURL         *string `json:"log_url,omitempty"`
 	Description    *string `json:"description,omitempty"`
@@ -226,9 +260,15 @@ func (s *RepositoriesService) GetDeploymentStatus(ctx context.Context, owner, re
 }
 
 // CreateDeploymentStatus creates a new status for a deployment.
+// An error is returned without making a request if request.State is not
+// a valid DeploymentState.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#create-a-deployment-status
 func (s *RepositoriesService) CreateDeploymentStatus(ctx context.Context, owner, repo string, deployment int64, request *DeploymentStatusRequest) (*DeploymentStatus, *Response, error) {
+	if request == nil || !DeploymentState(request.GetState()).IsValid() {
+		return nil, nil, fmt.Errorf("invalid deployment state %q", request.GetState())
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/deployments/%v/statuses", owner, repo, deployment)
 
 	req, err := s.client.NewRequest("POST", u, request)
@@ -53,6 +53,39 @@ func TestRepositoriesService_ListDeployments(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListDeployments_environmentAndRef(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"sha":         "s",
+			"ref":         "refs/heads/main",
+			"task":        "deploy:migrations",
+			"environment": "production",
+		})
+		fmt.Fprint(w, `[{"id":1,"ref":"refs/heads/main","environment":"production"}]`)
+	})
+
+	opt := &DeploymentsListOptions{
+		SHA:         "s",
+		Ref:         "refs/heads/main",
+		Task:        "deploy:migrations",
+		Environment: "production",
+	}
+	ctx := context.Background()
+	deployments, _, err := client.Repositories.ListDeployments(ctx, "o", "r", opt)
+	if err != nil {
+		t.Errorf("Repositories.ListDeployments returned error: %v", err)
+	}
+
+	want := []*Deployment{{ID: Int64(1), Ref: String("refs/heads/main"), Environment: String("production")}}
+	if !cmp.Equal(deployments, want) {
+		t.Errorf("Repositories.ListDeployments returned %+v, want %+v", deployments, want)
+	}
+}
+
 func TestRepositoriesService_GetDeployment(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -172,6 +205,49 @@ func TestRepositoriesService_DeleteDeployment(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_DeleteDeployment_inactive(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	inactive := false
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"state":"inactive"}`+"\n")
+		inactive = true
+		fmt.Fprint(w, `{"id":2,"state":"inactive"}`)
+	})
+	mux.HandleFunc("/repos/o/r/deployments/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if !inactive {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message":"We cannot delete an active deployment unless it is the only deployment in the repository."}`)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Repositories.DeleteDeployment(ctx, "o", "r", 1)
+	if err == nil {
+		t.Error("Repositories.DeleteDeployment of an active deployment should return an error")
+	}
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Repositories.DeleteDeployment returned status %v, want %v", resp.StatusCode, http.StatusUnprocessableEntity)
+	}
+
+	if _, _, err := client.Repositories.CreateDeploymentStatus(ctx, "o", "r", 1, &DeploymentStatusRequest{State: String("inactive")}); err != nil {
+		t.Fatalf("Repositories.CreateDeploymentStatus returned error: %v", err)
+	}
+
+	resp, err = client.Repositories.DeleteDeployment(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.DeleteDeployment returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Repositories.DeleteDeployment returned status %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
 func TestRepositoriesService_ListDeploymentStatuses(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -294,6 +370,73 @@ func TestRepositoriesService_CreateDeploymentStatus(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_CreateDeploymentStatus_inProgress(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &DeploymentStatusRequest{
+		State:          String(string(DeploymentStateInProgress)),
+		Environment:    String("production"),
+		EnvironmentURL: String("https://example.com"),
+		LogURL:         String("https://example.com/logs/1"),
+	}
+
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		v := new(DeploymentStatusRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !cmp.Equal(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"state":"in_progress","environment":"production","environment_url":"https://example.com","log_url":"https://example.com/logs/1"}`)
+	})
+
+	ctx := context.Background()
+	deploymentStatus, _, err := client.Repositories.CreateDeploymentStatus(ctx, "o", "r", 1, input)
+	if err != nil {
+		t.Errorf("Repositories.CreateDeploymentStatus returned error: %v", err)
+	}
+
+	want := &DeploymentStatus{
+		State:          String("in_progress"),
+		Environment:    String("production"),
+		EnvironmentURL: String("https://example.com"),
+		LogURL:         String("https://example.com/logs/1"),
+	}
+	if !cmp.Equal(deploymentStatus, want) {
+		t.Errorf("Repositories.CreateDeploymentStatus returned %+v, want %+v", deploymentStatus, want)
+	}
+}
+
+func TestRepositoriesService_CreateDeploymentStatus_invalidState(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	for _, input := range []*DeploymentStatusRequest{nil, {}, {State: String("done")}} {
+		_, resp, err := client.Repositories.CreateDeploymentStatus(ctx, "o", "r", 1, input)
+		if err == nil {
+			t.Errorf("Repositories.CreateDeploymentStatus(%+v) returned nil error, want error", input)
+		}
+		if resp != nil {
+			t.Errorf("Repositories.CreateDeploymentStatus(%+v) returned response %+v, want nil", input, resp)
+		}
+	}
+}
+
+func TestDeploymentState_IsValid(t *testing.T) {
+	for _, s := range []DeploymentState{"error", "failure", "inactive", "in_progress", "pending", "queued", "success"} {
+		if !s.IsValid() {
+			t.Errorf("DeploymentState(%q).IsValid returned false, want true", s)
+		}
+	}
+	if DeploymentState("done").IsValid() {
+		t.Error("DeploymentState(\"done\").IsValid returned true, want false")
+	}
+}
+
 func TestDeploymentStatusRequest_Marshal(t *testing.T) {
 	testJSONMarshal(t, &DeploymentStatusRequest{}, "{}")
 
@@ -50,11 +50,12 @@ type EnvResponse struct {
 
 // ProtectionRule represents a single protection rule applied to the environment.
 type ProtectionRule struct {
-	ID        *int64              `json:"id,omitempty"`
-	NodeID    *string             `json:"node_id,omitempty"`
-	Type      *string             `json:"type,omitempty"`
-	WaitTimer *int                `json:"wait_timer,omitempty"`
-	Reviewers []*RequiredReviewer `json:"reviewers,omitempty"`
+	ID                *int64              `json:"id,omitempty"`
+	NodeID            *string             `json:"node_id,omitempty"`
+	PreventSelfReview *bool               `json:"prevent_self_review,omitempty"`
+	Type              *string             `json:"type,omitempty"`
+	WaitTimer         *int                `json:"wait_timer,omitempty"`
+	Reviewers         []*RequiredReviewer `json:"reviewers,omitempty"`
 }
 
 // RequiredReviewer represents a required reviewer.
@@ -156,12 +157,24 @@ type CreateUpdateEnvironment struct {
 	WaitTimer              *int            `json:"wait_timer"`
 	Reviewers              []*EnvReviewers `json:"reviewers"`
 	DeploymentBranchPolicy *BranchPolicy   `json:"deployment_branch_policy"`
+	// PreventSelfReview prevents the user who triggered a deployment from
+	// approving it as one of the required reviewers.
+	PreventSelfReview *bool `json:"prevent_self_review,omitempty"`
 }
 
+// maxWaitTimer is the longest wait timer, in minutes, that GitHub accepts
+// for an environment (30 days).
+const maxWaitTimer = 43200
+
 // CreateUpdateEnvironment create or update a new environment for a repository.
+// environment.WaitTimer, if set, must be between 0 and 43200 minutes.
 //
 // GitHub API docs: https://docs.github.com/en/rest/reference/repos#create-or-update-an-environment
 func (s *RepositoriesService) CreateUpdateEnvironment(ctx context.Context, owner, repo, name string, environment *CreateUpdateEnvironment) (*Environment, *Response, error) {
+	if wt := environment.GetWaitTimer(); wt < 0 || wt > maxWaitTimer {
+		return nil, nil, fmt.Errorf("wait timer %v is out of range: must be between 0 and %v minutes", wt, maxWaitTimer)
+	}
+
 	u := fmt.Sprintf("repos/%s/%s/environments/%s", owner, repo, name)
 
 	req, err := s.client.NewRequest("PUT", u, environment)
@@ -214,6 +214,50 @@ func TestRepositoriesService_CreateEnvironment(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_CreateEnvironment_preventSelfReview(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateUpdateEnvironment{
+		Reviewers:         []*EnvReviewers{{Type: String("User"), ID: Int64(1)}},
+		PreventSelfReview: Bool(true),
+	}
+
+	mux.HandleFunc("/repos/o/r/environments/e", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"wait_timer":0,"reviewers":[{"type":"User","id":1}],"deployment_branch_policy":null,"prevent_self_review":true}`+"\n")
+		fmt.Fprint(w, `{"id": 1, "name": "staging", "protection_rules": [{"id": 1, "type": "required_reviewers", "prevent_self_review": true}]}`)
+	})
+
+	ctx := context.Background()
+	env, _, err := client.Repositories.CreateUpdateEnvironment(ctx, "o", "r", "e", input)
+	if err != nil {
+		t.Errorf("Repositories.CreateUpdateEnvironment returned error: %v", err)
+	}
+
+	want := &Environment{ID: Int64(1), Name: String("staging"), ProtectionRules: []*ProtectionRule{{ID: Int64(1), Type: String("required_reviewers"), PreventSelfReview: Bool(true)}}}
+	if !cmp.Equal(env, want) {
+		t.Errorf("Repositories.CreateUpdateEnvironment returned %+v, want %+v", env, want)
+	}
+}
+
+func TestRepositoriesService_CreateEnvironment_waitTimerOutOfRange(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments/e", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Repositories.CreateUpdateEnvironment sent a request for an out-of-range wait timer")
+	})
+
+	ctx := context.Background()
+	for _, wt := range []int{-1, 43201} {
+		_, _, err := client.Repositories.CreateUpdateEnvironment(ctx, "o", "r", "e", &CreateUpdateEnvironment{WaitTimer: Int(wt)})
+		if err == nil {
+			t.Errorf("Repositories.CreateUpdateEnvironment(WaitTimer: %v) returned nil error, want error", wt)
+		}
+	}
+}
+
 func TestRepositoriesService_DeleteEnvironment(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -7,25 +7,55 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"encoding/json"
 )
 
+// ForkSort represents the order in which RepositoriesService.ListForks
+// returns forks.
+type ForkSort string
+
+// This is the set of sort orders supported when listing forks.
+const (
+	ForkSortNewest     ForkSort = "newest"
+	ForkSortOldest     ForkSort = "oldest"
+	ForkSortStargazers ForkSort = "stargazers"
+	ForkSortWatchers   ForkSort = "watchers"
+)
+
+// IsValid reports whether s is one of the fork sort orders known to the
+// GitHub API.
+func (s ForkSort) IsValid() bool {
+	switch s {
+	case ForkSortNewest, ForkSortOldest, ForkSortStargazers, ForkSortWatchers:
+		return true
+	}
+	return false
+}
+
 // RepositoryListForksOptions specifies the optional parameters to the
 // RepositoriesService.ListForks method.
 type RepositoryListForksOptions struct {
 	// How to sort the forks list. Possible values are: newest, oldest,
-	// watchers. Default is "newest".
+	// stargazers, watchers, see the ForkSort constants. Default is "newest".
 	Sort string `url:"sort,omitempty"`
 
 	ListOptions
 }
 
-// ListForks lists the forks of the specified repository.
+// ListForks lists the forks of the specified repository. An error is returned
+// without making a request if opts.Sort is set to an unknown sort order.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-forks
 func (s *RepositoriesService) ListForks(ctx context.Context, owner, repo string, opts *RepositoryListForksOptions) ([]*Repository, *Response, error) {
+	if opts != nil && opts.Sort != "" && !ForkSort(opts.Sort).IsValid() {
+		return nil, nil, fmt.Errorf("invalid fork sort %q", opts.Sort)
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/forks", owner, repo)
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -94,3 +124,74 @@ func (s *RepositoriesService) CreateFork(ctx context.Context, owner, repo string
 
 	return fork, resp, nil
 }
+
+// PollOptions specifies how often and how many times a resource is polled
+// while waiting for GitHub to finish an asynchronous operation.
+type PollOptions struct {
+	// Interval is the delay between two polling requests. Default is 1 second.
+	Interval time.Duration
+
+	// MaxAttempts is the maximum number of polling requests made before
+	// giving up. Default is 10.
+	MaxAttempts int
+}
+
+const (
+	defaultPollInterval    = time.Second
+	defaultPollMaxAttempts = 10
+)
+
+// CreateForkAndWait creates a fork of the specified repository, then polls
+// the fork until GitHub reports that it is ready, and returns it.
+//
+// If the fork is still not ready after pollOpts.MaxAttempts requests, the
+// pending fork is returned along with an error.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#create-a-fork
+func (s *RepositoriesService) CreateForkAndWait(ctx context.Context, owner, repo string, opts *RepositoryCreateForkOptions, pollOpts PollOptions) (*Repository, *Response, error) {
+	if ctx == nil {
+		return nil, nil, errNonNilContext
+	}
+
+	fork, resp, err := s.CreateFork(ctx, owner, repo, opts)
+	if err != nil {
+		if _, ok := err.(*AcceptedError); !ok {
+			return nil, resp, err
+		}
+	}
+
+	forkOwner, forkName := fork.GetOwner().GetLogin(), fork.GetName()
+	if forkOwner == "" || forkName == "" {
+		return fork, resp, errors.New("fork response is missing owner login or name")
+	}
+
+	interval := pollOpts.Interval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	maxAttempts := pollOpts.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultPollMaxAttempts
+	}
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fork, resp, ctx.Err()
+		case <-timer.C:
+		}
+
+		ready, getResp, err := s.Get(ctx, forkOwner, forkName)
+		if err == nil {
+			return ready, getResp, nil
+		}
+		if e, ok := err.(*ErrorResponse); !ok || e.Response.StatusCode != http.StatusNotFound {
+			return nil, getResp, err
+		}
+		resp = getResp
+	}
+
+	return fork, resp, fmt.Errorf("fork %v/%v not ready after %d attempts", forkOwner, forkName, maxAttempts)
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -58,6 +59,73 @@ func TestRepositoriesService_ListForks(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListForks_stargazersMultiPage(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/forks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.FormValue("sort"), "stargazers"; got != want {
+			t.Errorf("Request sort = %q, want %q", got, want)
+		}
+		switch r.FormValue("page") {
+		case "", "1":
+			w.Header().Set("Link", fmt.Sprintf(`<%s%s/repos/o/r/forks?sort=stargazers&page=2>; rel="next"`, serverURL, baseURLPath))
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("Unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	opt := &RepositoryListForksOptions{Sort: string(ForkSortStargazers)}
+	ctx := context.Background()
+	var all []*Repository
+	for {
+		repos, resp, err := client.Repositories.ListForks(ctx, "o", "r", opt)
+		if err != nil {
+			t.Fatalf("Repositories.ListForks returned error: %v", err)
+		}
+		all = append(all, repos...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	want := []*Repository{{ID: Int64(1)}, {ID: Int64(2)}, {ID: Int64(3)}}
+	if !cmp.Equal(all, want) {
+		t.Errorf("Repositories.ListForks returned %+v, want %+v", all, want)
+	}
+}
+
+func TestRepositoriesService_ListForks_invalidSort(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/forks", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Repositories.ListForks made a request with an invalid sort")
+	})
+
+	ctx := context.Background()
+	opt := &RepositoryListForksOptions{Sort: "stars"}
+	if _, _, err := client.Repositories.ListForks(ctx, "o", "r", opt); err == nil {
+		t.Error("Repositories.ListForks returned no error for an invalid sort")
+	}
+}
+
+func TestForkSort_IsValid(t *testing.T) {
+	for _, s := range []ForkSort{ForkSortNewest, ForkSortOldest, ForkSortStargazers, ForkSortWatchers} {
+		if !s.IsValid() {
+			t.Errorf("ForkSort(%q).IsValid() = false, want true", s)
+		}
+	}
+	if ForkSort("stars").IsValid() {
+		t.Error(`ForkSort("stars").IsValid() = true, want false`)
+	}
+}
+
 func TestRepositoriesService_ListForks_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -129,6 +197,95 @@ func TestRepositoriesService_CreateFork_deferred(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_CreateForkAndWait(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/forks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testFormValues(t, r, values{"organization": "u"})
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":1,"name":"r","owner":{"login":"u"}}`)
+	})
+
+	var polls int
+	mux.HandleFunc("/repos/u/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		polls++
+		if polls <= 2 {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"Not Found"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":1,"name":"r","owner":{"login":"u"},"fork":true}`)
+	})
+
+	opt := &RepositoryCreateForkOptions{Organization: "u"}
+	pollOpts := PollOptions{Interval: time.Millisecond, MaxAttempts: 5}
+	ctx := context.Background()
+	repo, _, err := client.Repositories.CreateForkAndWait(ctx, "o", "r", opt, pollOpts)
+	if err != nil {
+		t.Fatalf("Repositories.CreateForkAndWait returned error: %v", err)
+	}
+	if polls != 3 {
+		t.Errorf("Repositories.CreateForkAndWait polled %d times, want 3", polls)
+	}
+
+	want := &Repository{ID: Int64(1), Name: String("r"), Owner: &User{Login: String("u")}, Fork: Bool(true)}
+	if !cmp.Equal(repo, want) {
+		t.Errorf("Repositories.CreateForkAndWait returned %+v, want %+v", repo, want)
+	}
+}
+
+func TestRepositoriesService_CreateForkAndWait_maxAttempts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/forks", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":1,"name":"r","owner":{"login":"u"}}`)
+	})
+
+	var polls int
+	mux.HandleFunc("/repos/u/r", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	pollOpts := PollOptions{Interval: time.Millisecond, MaxAttempts: 2}
+	ctx := context.Background()
+	repo, _, err := client.Repositories.CreateForkAndWait(ctx, "o", "r", nil, pollOpts)
+	if err == nil {
+		t.Fatal("Repositories.CreateForkAndWait returned no error, want one")
+	}
+	if polls != 2 {
+		t.Errorf("Repositories.CreateForkAndWait polled %d times, want 2", polls)
+	}
+	if got, want := repo.GetID(), int64(1); got != want {
+		t.Errorf("Repositories.CreateForkAndWait returned repo ID %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_CreateForkAndWait_canceledContext(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/forks", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":1,"name":"r","owner":{"login":"u"}}`)
+	})
+	mux.HandleFunc("/repos/u/r", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Repositories.CreateForkAndWait polled after the context was canceled")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	_, _, err := client.Repositories.CreateForkAndWait(ctx, "o", "r", nil, PollOptions{Interval: time.Hour})
+	if err != context.Canceled {
+		t.Errorf("Repositories.CreateForkAndWait returned error %v, want %v", err, context.Canceled)
+	}
+}
+
 func TestRepositoriesService_CreateFork_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
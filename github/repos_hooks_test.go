@@ -244,13 +244,17 @@ func TestRepositoriesService_PingHook(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/hooks/1/pings", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
 	})
 
 	ctx := context.Background()
-	_, err := client.Repositories.PingHook(ctx, "o", "r", 1)
+	resp, err := client.Repositories.PingHook(ctx, "o", "r", 1)
 	if err != nil {
 		t.Errorf("Repositories.PingHook returned error: %v", err)
 	}
+	if got, want := resp.StatusCode, http.StatusNoContent; got != want {
+		t.Errorf("Repositories.PingHook returned status %v, want %v", got, want)
+	}
 
 	const methodName = "PingHook"
 	testBadOptions(t, methodName, func() (err error) {
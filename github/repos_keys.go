@@ -56,12 +56,19 @@ func (s *RepositoriesService) GetKey(ctx context.Context, owner string, repo str
 	return key, resp, nil
 }
 
-// CreateKey adds a deploy key for a repository.
+// CreateKey adds a deploy key for a repository. The key is rejected without
+// making a request if it is not a valid OpenSSH public key.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#create-a-deploy-key
 func (s *RepositoriesService) CreateKey(ctx context.Context, owner string, repo string, key *Key) (*Key, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/keys", owner, repo)
 
+	if key != nil {
+		if _, err := parseSSHKey(key.GetKey()); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	req, err := s.client.NewRequest("POST", u, key)
 	if err != nil {
 		return nil, nil, err
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -96,6 +97,41 @@ func TestRepositoriesService_GetKey(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetKey_readOnly(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/keys/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"key": "`+testSSHKey+`",
+			"read_only": true,
+			"verified": true,
+			"added_by": "octocat",
+			"last_used": "2021-01-02T00:00:00Z"
+		}`)
+	})
+
+	ctx := context.Background()
+	key, _, err := client.Repositories.GetKey(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.GetKey returned error: %v", err)
+	}
+
+	want := &Key{
+		ID:       Int64(1),
+		Key:      String(testSSHKey),
+		ReadOnly: Bool(true),
+		Verified: Bool(true),
+		AddedBy:  String("octocat"),
+		LastUsed: &Timestamp{time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC)},
+	}
+	if !cmp.Equal(key, want) {
+		t.Errorf("Repositories.GetKey returned %+v, want %+v", key, want)
+	}
+}
+
 func TestRepositoriesService_GetKey_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -109,7 +145,7 @@ func TestRepositoriesService_CreateKey(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &Key{Key: String("k"), Title: String("t")}
+	input := &Key{Key: String(testSSHKey), Title: String("t")}
 
 	mux.HandleFunc("/repos/o/r/keys", func(w http.ResponseWriter, r *http.Request) {
 		v := new(Key)
@@ -149,6 +185,21 @@ func TestRepositoriesService_CreateKey(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_CreateKey_malformedKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/keys", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Repositories.CreateKey sent a request for a malformed key")
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.CreateKey(ctx, "o", "r", &Key{Key: String("ssh-rsa not-a-key"), Title: String("t")})
+	if err == nil {
+		t.Error("Repositories.CreateKey returned nil error, want error")
+	}
+}
+
 func TestRepositoriesService_CreateKey_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
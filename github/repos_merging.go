@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
 )
 
 // RepositoryMergeRequest represents a request to merge a branch in a
@@ -18,8 +19,29 @@ type RepositoryMergeRequest struct {
 	CommitMessage *string `json:"commit_message,omitempty"`
 }
 
+// MergeConflictError occurs when GitHub responds to a merge with
+// 409 Conflict because the branches cannot be merged automatically.
+type MergeConflictError ErrorResponse
+
+func (r *MergeConflictError) Error() string { return (*ErrorResponse)(r).Error() }
+
+// As lets errors.As match a *MergeConflictError as the *ErrorResponse that
+// GitHub's 409 Conflict response was decoded into.
+func (r *MergeConflictError) As(target interface{}) bool {
+	e, ok := target.(**ErrorResponse)
+	if ok {
+		*e = (*ErrorResponse)(r)
+	}
+	return ok
+}
+
 // Merge a branch in the specified repository.
 //
+// If Base already contains Head there is nothing to merge; GitHub responds
+// with 204 No Content and Merge returns a nil commit and a nil error, so
+// callers must check the commit before using it. A merge conflict results
+// in a *MergeConflictError, which errors.As also matches as *ErrorResponse.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#merge-a-branch
 func (s *RepositoriesService) Merge(ctx context.Context, owner, repo string, request *RepositoryMergeRequest) (*RepositoryCommit, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/merges", owner, repo)
@@ -31,8 +53,54 @@ func (s *RepositoriesService) Merge(ctx context.Context, owner, repo string, req
 	commit := new(RepositoryCommit)
 	resp, err := s.client.Do(ctx, req, commit)
 	if err != nil {
+		if e, ok := err.(*ErrorResponse); ok && e.Response.StatusCode == http.StatusConflict {
+			err = (*MergeConflictError)(e)
+		}
 		return nil, resp, err
 	}
 
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, resp, nil
+	}
+
 	return commit, resp, nil
 }
+
+// RepoMergeUpstreamRequest represents a request to sync a branch of
+// a forked repository to keep it up-to-date with the upstream repository.
+type RepoMergeUpstreamRequest struct {
+	Branch *string `json:"branch,omitempty"`
+}
+
+// RepoMergeUpstreamResult represents the result of syncing a branch of
+// a forked repository with the upstream repository.
+type RepoMergeUpstreamResult struct {
+	Message *string `json:"message,omitempty"`
+	// Possible values for MergeType are: merge, fast-forward, none.
+	MergeType  *string `json:"merge_type,omitempty"`
+	BaseBranch *string `json:"base_branch,omitempty"`
+}
+
+// MergeUpstream syncs a branch of a forked repository to keep it up-to-date
+// with the upstream repository. A merge conflict results in a
+// *MergeConflictError.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos#sync-a-fork-branch-with-the-upstream-repository
+func (s *RepositoriesService) MergeUpstream(ctx context.Context, owner, repo string, request *RepoMergeUpstreamRequest) (*RepoMergeUpstreamResult, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/merge-upstream", owner, repo)
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(RepoMergeUpstreamResult)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		if e, ok := err.(*ErrorResponse); ok && e.Response.StatusCode == http.StatusConflict {
+			err = (*MergeConflictError)(e)
+		}
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -62,3 +63,113 @@ func TestRepositoriesService_Merge(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestRepositoriesService_Merge_nothingToMerge(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/merges", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	commit, resp, err := client.Repositories.Merge(ctx, "o", "r", &RepositoryMergeRequest{Base: String("b"), Head: String("h")})
+	if err != nil {
+		t.Errorf("Repositories.Merge returned error: %v", err)
+	}
+	if commit != nil {
+		t.Errorf("Repositories.Merge returned %+v, want nil", commit)
+	}
+	if got, want := resp.StatusCode, http.StatusNoContent; got != want {
+		t.Errorf("Repositories.Merge returned status %d, want %d", got, want)
+	}
+}
+
+func TestRepositoriesService_Merge_conflict(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/merges", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"Merge conflict"}`)
+	})
+
+	ctx := context.Background()
+	commit, _, err := client.Repositories.Merge(ctx, "o", "r", &RepositoryMergeRequest{Base: String("b"), Head: String("h")})
+	if commit != nil {
+		t.Errorf("Repositories.Merge returned %+v, want nil", commit)
+	}
+	if _, ok := err.(*MergeConflictError); !ok {
+		t.Errorf("Repositories.Merge returned error %v, want *MergeConflictError", err)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusConflict {
+		t.Errorf("Repositories.Merge returned error %v, want it to match a 409 *ErrorResponse", err)
+	}
+}
+
+func TestRepositoriesService_MergeUpstream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &RepoMergeUpstreamRequest{Branch: String("main")}
+
+	mux.HandleFunc("/repos/o/r/merge-upstream", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"branch":"main"}`+"\n")
+		fmt.Fprint(w, `{"message":"Successfully fetched and fast-forwarded from upstream u:main.","merge_type":"fast-forward","base_branch":"u:main"}`)
+	})
+
+	ctx := context.Background()
+	result, _, err := client.Repositories.MergeUpstream(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.MergeUpstream returned error: %v", err)
+	}
+
+	want := &RepoMergeUpstreamResult{
+		Message:    String("Successfully fetched and fast-forwarded from upstream u:main."),
+		MergeType:  String("fast-forward"),
+		BaseBranch: String("u:main"),
+	}
+	if !cmp.Equal(result, want) {
+		t.Errorf("Repositories.MergeUpstream returned %+v, want %+v", result, want)
+	}
+
+	const methodName = "MergeUpstream"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.MergeUpstream(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.MergeUpstream(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_MergeUpstream_conflict(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/merge-upstream", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"There are merge conflicts"}`)
+	})
+
+	ctx := context.Background()
+	result, _, err := client.Repositories.MergeUpstream(ctx, "o", "r", &RepoMergeUpstreamRequest{Branch: String("main")})
+	if result != nil {
+		t.Errorf("Repositories.MergeUpstream returned %+v, want nil", result)
+	}
+	if e, ok := err.(*MergeConflictError); !ok {
+		t.Errorf("Repositories.MergeUpstream returned error %v, want *MergeConflictError", err)
+	} else if got, want := e.Message, "There are merge conflicts"; got != want {
+		t.Errorf("MergeConflictError.Message = %q, want %q", got, want)
+	}
+}
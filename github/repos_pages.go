@@ -219,3 +219,69 @@ func (s *RepositoriesService) RequestPageBuild(ctx context.Context, owner, repo
 
 	return build, resp, nil
 }
+
+// CreatePagesDeploymentRequest represents a request to create a GitHub Pages
+// deployment from an artifact uploaded by a workflow run.
+type CreatePagesDeploymentRequest struct {
+	ArtifactID  *int64  `json:"artifact_id,omitempty"`
+	ArtifactURL *string `json:"artifact_url,omitempty"`
+	// Environment is the target deployment environment. Defaults to "github-pages".
+	Environment       *string `json:"environment,omitempty"`
+	PagesBuildVersion *string `json:"pages_build_version,omitempty"`
+	OIDCToken         *string `json:"oidc_token,omitempty"`
+}
+
+// PagesDeployment represents a GitHub Pages deployment.
+type PagesDeployment struct {
+	ID         *string `json:"id,omitempty"`
+	StatusURL  *string `json:"status_url,omitempty"`
+	PageURL    *string `json:"page_url,omitempty"`
+	PreviewURL *string `json:"preview_url,omitempty"`
+}
+
+// PagesDeploymentStatus represents the status of a GitHub Pages deployment.
+type PagesDeploymentStatus struct {
+	// Possible values for Status are: deployment_in_progress, syncing_files,
+	// finished_file_sync, updating_pages, purging_cdn, deployment_cancelled,
+	// deployment_failed, deployment_content_failed, deployment_attempt_error,
+	// deployment_lost, succeed.
+	Status *string `json:"status,omitempty"`
+}
+
+// CreatePagesDeployment creates a GitHub Pages deployment for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#create-a-github-pages-deployment
+func (s *RepositoriesService) CreatePagesDeployment(ctx context.Context, owner, repo string, request *CreatePagesDeploymentRequest) (*PagesDeployment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/pages/deployments", owner, repo)
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployment := new(PagesDeployment)
+	resp, err := s.client.Do(ctx, req, deployment)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return deployment, resp, nil
+}
+
+// GetPagesDeploymentStatus gets the status of a GitHub Pages deployment.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-the-status-of-a-github-pages-deployment
+func (s *RepositoriesService) GetPagesDeploymentStatus(ctx context.Context, owner, repo, deploymentID string) (*PagesDeploymentStatus, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/pages/deployments/%v", owner, repo, deploymentID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(PagesDeploymentStatus)
+	resp, err := s.client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
@@ -259,7 +259,7 @@ func TestRepositoriesService_GetLatestPagesBuild(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/pages/builds/latest", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		fmt.Fprint(w, `{"url":"u","status":"s","commit":"c"}`)
+		fmt.Fprint(w, `{"url":"u","status":"errored","error":{"message":"m"},"pusher":{"login":"l"},"commit":"c","duration":42}`)
 	})
 
 	ctx := context.Background()
@@ -268,7 +268,14 @@ func TestRepositoriesService_GetLatestPagesBuild(t *testing.T) {
 		t.Errorf("Repositories.GetLatestPagesBuild returned error: %v", err)
 	}
 
-	want := &PagesBuild{URL: String("u"), Status: String("s"), Commit: String("c")}
+	want := &PagesBuild{
+		URL:      String("u"),
+		Status:   String("errored"),
+		Error:    &PagesError{Message: String("m")},
+		Pusher:   &User{Login: String("l")},
+		Commit:   String("c"),
+		Duration: Int(42),
+	}
 	if !cmp.Equal(build, want) {
 		t.Errorf("Repositories.GetLatestPagesBuild returned %+v, want %+v", build, want)
 	}
@@ -357,3 +364,80 @@ func TestRepositoriesService_RequestPageBuild(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestRepositoriesService_CreatePagesDeployment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreatePagesDeploymentRequest{
+		ArtifactID:        Int64(1),
+		PagesBuildVersion: String("v"),
+		OIDCToken:         String("t"),
+	}
+
+	mux.HandleFunc("/repos/o/r/pages/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"artifact_id":1,"pages_build_version":"v","oidc_token":"t"}`+"\n")
+		fmt.Fprint(w, `{"id":"d","status_url":"s","page_url":"p"}`)
+	})
+
+	ctx := context.Background()
+	deployment, _, err := client.Repositories.CreatePagesDeployment(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.CreatePagesDeployment returned error: %v", err)
+	}
+
+	want := &PagesDeployment{ID: String("d"), StatusURL: String("s"), PageURL: String("p")}
+	if !cmp.Equal(deployment, want) {
+		t.Errorf("Repositories.CreatePagesDeployment returned %+v, want %+v", deployment, want)
+	}
+
+	const methodName = "CreatePagesDeployment"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.CreatePagesDeployment(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.CreatePagesDeployment(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetPagesDeploymentStatus(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pages/deployments/d", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"status":"succeed"}`)
+	})
+
+	ctx := context.Background()
+	status, _, err := client.Repositories.GetPagesDeploymentStatus(ctx, "o", "r", "d")
+	if err != nil {
+		t.Errorf("Repositories.GetPagesDeploymentStatus returned error: %v", err)
+	}
+
+	want := &PagesDeploymentStatus{Status: String("succeed")}
+	if !cmp.Equal(status, want) {
+		t.Errorf("Repositories.GetPagesDeploymentStatus returned %+v, want %+v", status, want)
+	}
+
+	const methodName = "GetPagesDeploymentStatus"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetPagesDeploymentStatus(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetPagesDeploymentStatus(ctx, "o", "r", "d")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
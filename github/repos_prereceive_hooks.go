@@ -10,12 +10,20 @@ import (
 	"fmt"
 )
 
-// PreReceiveHook represents a GitHub pre-receive hook for a repository.
+// PreReceiveHook represents a GitHub pre-receive hook for a repository, or,
+// when managed through the AdminService, for a GitHub Enterprise Server
+// instance.
 type PreReceiveHook struct {
 	ID          *int64  `json:"id,omitempty"`
 	Name        *string `json:"name,omitempty"`
 	Enforcement *string `json:"enforcement,omitempty"`
 	ConfigURL   *string `json:"configuration_url,omitempty"`
+
+	// The following fields are only used by the AdminService.
+	Script                       *string                `json:"script,omitempty"`
+	ScriptRepository             *Repository            `json:"script_repository,omitempty"`
+	Environment                  *PreReceiveEnvironment `json:"environment,omitempty"`
+	AllowDownstreamConfiguration *bool                  `json:"allow_downstream_configuration,omitempty"`
 }
 
 func (p PreReceiveHook) String() string {
@@ -0,0 +1,108 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RepositoryProfile combines a repository with its languages and topics, as
+// returned by RepositoriesService.GetRepositoryProfile.
+type RepositoryProfile struct {
+	Repository *Repository
+	// Languages maps languages to the number of bytes of code written in
+	// them, as returned by RepositoriesService.ListLanguages.
+	Languages map[string]int
+	Topics    []string
+}
+
+// RepositoryProfileError is returned by
+// RepositoriesService.GetRepositoryProfile when some parts of the profile
+// could not be fetched.
+type RepositoryProfileError struct {
+	// Errors maps the parts of the profile that could not be fetched,
+	// "repository", "languages" or "topics", to the error encountered.
+	Errors map[string]error
+}
+
+func (e *RepositoryProfileError) Error() string {
+	parts := make([]string, 0, len(e.Errors))
+	for part := range e.Errors {
+		parts = append(parts, part)
+	}
+	sort.Strings(parts)
+
+	msgs := make([]string, len(parts))
+	for i, part := range parts {
+		msgs[i] = fmt.Sprintf("%v: %v", part, e.Errors[part])
+	}
+	return fmt.Sprintf("failed to get repository profile: %v", strings.Join(msgs, "; "))
+}
+
+// GetRepositoryProfile fetches a repository together with its languages and
+// topics, calling Get, ListLanguages and ListAllTopics concurrently. The
+// returned Response is the one of the Get call.
+//
+// If some of the calls fail, the profile holds the parts that could be
+// fetched and a *RepositoryProfileError is returned with it. Each call is
+// subject to the client's rate limit handling and to ctx.
+func (s *RepositoriesService) GetRepositoryProfile(ctx context.Context, owner, repo string) (*RepositoryProfile, *Response, error) {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		profile = new(RepositoryProfile)
+		resp    *Response
+		errs    = make(map[string]error)
+	)
+
+	fail := func(part string, err error) {
+		mu.Lock()
+		errs[part] = err
+		mu.Unlock()
+	}
+
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		r, rr, err := s.Get(ctx, owner, repo)
+		mu.Lock()
+		resp = rr
+		mu.Unlock()
+		if err != nil {
+			fail("repository", err)
+			return
+		}
+		profile.Repository = r
+	}()
+	go func() {
+		defer wg.Done()
+		languages, _, err := s.ListLanguages(ctx, owner, repo)
+		if err != nil {
+			fail("languages", err)
+			return
+		}
+		profile.Languages = languages
+	}()
+	go func() {
+		defer wg.Done()
+		topics, _, err := s.ListAllTopics(ctx, owner, repo)
+		if err != nil {
+			fail("topics", err)
+			return
+		}
+		profile.Topics = topics
+	}()
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return profile, resp, &RepositoryProfileError{Errors: errs}
+	}
+	return profile, resp, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepositoriesService_GetRepositoryProfile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"name":"r"}`)
+	})
+	mux.HandleFunc("/repos/o/r/languages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"Go":1000,"Shell":10}`)
+	})
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeTopicsPreview)
+		fmt.Fprint(w, `{"names":["go","github"]}`)
+	})
+
+	ctx := context.Background()
+	profile, resp, err := client.Repositories.GetRepositoryProfile(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.GetRepositoryProfile returned error: %v", err)
+	}
+	if resp == nil {
+		t.Error("Repositories.GetRepositoryProfile returned nil Response")
+	}
+
+	want := &RepositoryProfile{
+		Repository: &Repository{ID: Int64(1), Name: String("r")},
+		Languages:  map[string]int{"Go": 1000, "Shell": 10},
+		Topics:     []string{"go", "github"},
+	}
+	if !cmp.Equal(profile, want) {
+		t.Errorf("Repositories.GetRepositoryProfile returned %+v, want %+v", profile, want)
+	}
+}
+
+func TestRepositoriesService_GetRepositoryProfile_partialError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1}`)
+	})
+	mux.HandleFunc("/repos/o/r/languages", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Server Error"}`, http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"names":["go"]}`)
+	})
+
+	ctx := context.Background()
+	profile, _, err := client.Repositories.GetRepositoryProfile(ctx, "o", "r")
+	profileErr, ok := err.(*RepositoryProfileError)
+	if !ok {
+		t.Fatalf("Repositories.GetRepositoryProfile returned error %v, want *RepositoryProfileError", err)
+	}
+	if len(profileErr.Errors) != 1 {
+		t.Errorf("RepositoryProfileError.Errors = %v, want a single error", profileErr.Errors)
+	}
+	if _, ok := profileErr.Errors["languages"].(*ErrorResponse); !ok {
+		t.Errorf("RepositoryProfileError.Errors[languages] = %v, want *ErrorResponse", profileErr.Errors["languages"])
+	}
+
+	want := &RepositoryProfile{
+		Repository: &Repository{ID: Int64(1)},
+		Topics:     []string{"go"},
+	}
+	if !cmp.Equal(profile, want) {
+		t.Errorf("Repositories.GetRepositoryProfile returned %+v, want %+v", profile, want)
+	}
+}
+
+func TestRepositoryProfileError_Error(t *testing.T) {
+	err := &RepositoryProfileError{Errors: map[string]error{
+		"topics":    fmt.Errorf("t"),
+		"languages": fmt.Errorf("l"),
+	}}
+	if got, want := err.Error(), "failed to get repository profile: languages: l; topics: t"; got != want {
+		t.Errorf("RepositoryProfileError.Error() = %q, want %q", got, want)
+	}
+}
@@ -14,9 +14,32 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 )
 
+// ErrNoRelease is matched with errors.Is by the *NoReleaseError returned by
+// GetLatestRelease and GetReleaseByTag when the repository exists but has no
+// matching release.
+var ErrNoRelease = errors.New("no matching release")
+
+// NoReleaseError occurs when GitHub responds to a request for a release with
+// 404 Not Found and the repository exists. It wraps the *ErrorResponse of
+// the 404 response, and errors.Is reports it as ErrNoRelease.
+type NoReleaseError struct {
+	*ErrorResponse
+}
+
+func (r *NoReleaseError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrNoRelease, r.ErrorResponse.Error())
+}
+
+// Is reports whether target is ErrNoRelease.
+func (r *NoReleaseError) Is(target error) bool { return target == ErrNoRelease }
+
+// Unwrap returns the *ErrorResponse of the 404 Not Found response.
+func (r *NoReleaseError) Unwrap() error { return r.ErrorResponse }
+
 // RepositoryRelease represents a GitHub release in a repository.
 type RepositoryRelease struct {
 	TagName                *string `json:"tag_name,omitempty"`
@@ -26,6 +49,12 @@ type RepositoryRelease struct {
 	Draft                  *bool   `json:"draft,omitempty"`
 	Prerelease             *bool   `json:"prerelease,omitempty"`
 	DiscussionCategoryName *string `json:"discussion_category_name,omitempty"`
+	// MakeLatest specifies whether the release is set as the latest release
+	// of the repository. Possible values are: "true", "false", "legacy".
+	// "legacy" sets the latest release based on its creation date and
+	// semantic version. GitHub does not return it, so it is never set on
+	// returned releases.
+	MakeLatest *string `json:"make_latest,omitempty"`
 
 	// The following fields are not used in CreateRelease or EditRelease:
 	ID          *int64          `json:"id,omitempty"`
@@ -99,19 +128,43 @@ func (s *RepositoriesService) GetRelease(ctx context.Context, owner, repo string
 }
 
 // GetLatestRelease fetches the latest published release for the repository.
+// If the repository has no published release, a *NoReleaseError is returned.
+// GitHub responds with the same 404 Not Found when the repository is missing
+// or inaccessible, so on a 404 GetLatestRelease makes an additional request
+// for the repository to tell the two apart.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-the-latest-release
 func (s *RepositoriesService) GetLatestRelease(ctx context.Context, owner, repo string) (*RepositoryRelease, *Response, error) {
 	u := fmt.Sprintf("repos/%s/%s/releases/latest", owner, repo)
-	return s.getSingleRelease(ctx, u)
+	release, resp, err := s.getSingleRelease(ctx, u)
+	return release, resp, s.checkNoRelease(ctx, owner, repo, resp, err)
 }
 
 // GetReleaseByTag fetches a release with the specified tag.
+// If the repository has no release with that tag, a *NoReleaseError is
+// returned. As with GetLatestRelease, this costs an additional request for
+// the repository on a 404 Not Found.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-release-by-tag-name
 func (s *RepositoriesService) GetReleaseByTag(ctx context.Context, owner, repo, tag string) (*RepositoryRelease, *Response, error) {
 	u := fmt.Sprintf("repos/%s/%s/releases/tags/%s", owner, repo, tag)
-	return s.getSingleRelease(ctx, u)
+	release, resp, err := s.getSingleRelease(ctx, u)
+	return release, resp, s.checkNoRelease(ctx, owner, repo, resp, err)
+}
+
+// checkNoRelease wraps err in a *NoReleaseError if err is the 404 returned
+// when getting a release and the repository itself exists. GitHub responds
+// with the same 404 for a missing release and a missing or inaccessible
+// repository, so telling them apart costs one more request.
+func (s *RepositoriesService) checkNoRelease(ctx context.Context, owner, repo string, resp *Response, err error) error {
+	errResp, ok := err.(*ErrorResponse)
+	if !ok || resp == nil || resp.StatusCode != http.StatusNotFound {
+		return err
+	}
+	if _, _, repoErr := s.Get(ctx, owner, repo); repoErr != nil {
+		return err
+	}
+	return &NoReleaseError{ErrorResponse: errResp}
 }
 
 func (s *RepositoriesService) getSingleRelease(ctx context.Context, url string) (*RepositoryRelease, *Response, error) {
@@ -142,6 +195,7 @@ type repositoryReleaseRequest struct {
 	Draft                  *bool   `json:"draft,omitempty"`
 	Prerelease             *bool   `json:"prerelease,omitempty"`
 	DiscussionCategoryName *string `json:"discussion_category_name,omitempty"`
+	MakeLatest             *string `json:"make_latest,omitempty"`
 }
 
 // CreateRelease adds a new release for a repository.
@@ -161,6 +215,7 @@ func (s *RepositoriesService) CreateRelease(ctx context.Context, owner, repo str
 		Draft:                  release.Draft,
 		Prerelease:             release.Prerelease,
 		DiscussionCategoryName: release.DiscussionCategoryName,
+		MakeLatest:             release.MakeLatest,
 	}
 
 	req, err := s.client.NewRequest("POST", u, releaseReq)
@@ -193,6 +248,7 @@ func (s *RepositoriesService) EditRelease(ctx context.Context, owner, repo strin
 		Draft:                  release.Draft,
 		Prerelease:             release.Prerelease,
 		DiscussionCategoryName: release.DiscussionCategoryName,
+		MakeLatest:             release.MakeLatest,
 	}
 
 	req, err := s.client.NewRequest("PATCH", u, releaseReq)
@@ -335,6 +391,93 @@ func (s *RepositoriesService) downloadReleaseAssetFromURL(ctx context.Context, f
 	return resp.Body, nil
 }
 
+// DownloadReleaseAssetRange downloads the bytes start through end, inclusive,
+// of a release asset. A negative end requests everything from start to the
+// end of the asset. It is the caller's responsibility to close the ReadCloser.
+//
+// If the server honors the range, partial is true and size is the total size
+// of the asset as reported by the Content-Range header, or -1 if unknown.
+// If the server ignores the range and responds with the whole asset, partial
+// is false, rc reads the full contents and size is its Content-Length.
+//
+// Redirects to the storage backend are followed without the client's
+// transport, so the client's credentials are not sent along.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-release-asset
+func (s *RepositoriesService) DownloadReleaseAssetRange(ctx context.Context, owner, repo string, id int64, start, end int64) (rc io.ReadCloser, size int64, partial bool, err error) {
+	if start < 0 || (end >= 0 && end < start) {
+		return nil, 0, false, fmt.Errorf("invalid range %d-%d", start, end)
+	}
+	byteRange := fmt.Sprintf("bytes=%d-", start)
+	if end >= 0 {
+		byteRange += strconv.FormatInt(end, 10)
+	}
+
+	u := fmt.Sprintf("repos/%s/%s/releases/assets/%d", owner, repo, id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	req.Header.Set("Accept", defaultMediaType)
+	req.Header.Set("Range", byteRange)
+
+	s.client.clientMu.Lock()
+	defer s.client.clientMu.Unlock()
+
+	var loc string
+	saveRedirect := s.client.client.CheckRedirect
+	s.client.client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		loc = req.URL.String()
+		return errors.New("disable redirect")
+	}
+	defer func() { s.client.client.CheckRedirect = saveRedirect }()
+
+	req = withContext(ctx, req)
+	resp, err := s.client.client.Do(req)
+	if err != nil {
+		if !strings.Contains(err.Error(), "disable redirect") {
+			return nil, 0, false, err
+		}
+
+		req, err := http.NewRequest("GET", loc, nil)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		req = withContext(ctx, req)
+		req.Header.Set("Accept", "*/*")
+		req.Header.Set("Range", byteRange)
+		resp, err = redirectClient().Do(req)
+		if err != nil {
+			return nil, 0, false, err
+		}
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		resp.Body.Close()
+		return nil, 0, false, err
+	}
+
+	if resp.StatusCode != http.StatusPartialContent {
+		return resp.Body, resp.ContentLength, false, nil
+	}
+	return resp.Body, parseContentRangeSize(resp.Header.Get("Content-Range")), true, nil
+}
+
+// parseContentRangeSize returns the complete length given by a Content-Range
+// header such as "bytes 0-99/1234", or -1 if it is missing or unknown.
+func parseContentRangeSize(contentRange string) int64 {
+	i := strings.LastIndex(contentRange, "/")
+	if i < 0 {
+		return -1
+	}
+	size, err := strconv.ParseInt(contentRange[i+1:], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return size
+}
+
 // EditReleaseAsset edits a repository release asset.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#update-a-release-asset
@@ -368,7 +511,8 @@ func (s *RepositoriesService) DeleteReleaseAsset(ctx context.Context, owner, rep
 }
 
 // UploadReleaseAsset creates an asset by uploading a file into a release repository.
-// To upload assets that cannot be represented by an os.File, call NewUploadRequest directly.
+// To upload assets that cannot be represented by an os.File, use
+// UploadReleaseAssetFromReader.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#upload-a-release-asset
 func (s *RepositoriesService) UploadReleaseAsset(ctx context.Context, owner, repo string, id int64, opts *UploadOptions, file *os.File) (*ReleaseAsset, *Response, error) {
@@ -403,3 +547,39 @@ func (s *RepositoriesService) UploadReleaseAsset(ctx context.Context, owner, rep
 	}
 	return asset, resp, nil
 }
+
+// UploadReleaseAssetFromReader creates an asset by uploading size bytes read
+// from r into a release repository, which allows uploading from pipes and
+// in-memory buffers. size must be positive and is sent as the Content-Length
+// of the request, so r must provide exactly size bytes. The body is streamed
+// without being buffered. contentType is the media type of the asset; if it
+// is empty, opts.MediaType or else "application/octet-stream" is used.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#upload-a-release-asset
+func (s *RepositoriesService) UploadReleaseAssetFromReader(ctx context.Context, owner, repo string, id int64, opts *UploadOptions, r io.Reader, size int64, contentType string) (*ReleaseAsset, *Response, error) {
+	if size <= 0 {
+		return nil, nil, fmt.Errorf("invalid asset size %d: must be positive", size)
+	}
+
+	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets", owner, repo, id)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if contentType == "" && opts != nil {
+		contentType = opts.MediaType
+	}
+
+	req, err := s.client.NewUploadRequest(u, r, size, contentType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	asset := new(ReleaseAsset)
+	resp, err := s.client.Do(ctx, req, asset)
+	if err != nil {
+		return nil, resp, err
+	}
+	return asset, resp, nil
+}
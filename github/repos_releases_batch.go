@@ -0,0 +1,116 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// AssetUpload describes a release asset to upload with UploadReleaseAssets.
+// The fields are passed to UploadReleaseAssetFromReader.
+type AssetUpload struct {
+	Options     *UploadOptions
+	Reader      io.Reader
+	Size        int64
+	ContentType string
+}
+
+// UploadReleaseAssetsOptions specifies optional parameters to
+// UploadReleaseAssets.
+type UploadReleaseAssetsOptions struct {
+	// Rollback specifies whether the assets that were uploaded are deleted
+	// again when any of the uploads fails.
+	Rollback bool
+}
+
+// ReleaseAssetsUploadError is returned by
+// RepositoriesService.UploadReleaseAssets when some of the assets could not
+// be uploaded.
+type ReleaseAssetsUploadError struct {
+	// Errors maps the index of each asset that could not be uploaded to the
+	// error encountered.
+	Errors map[int]error
+
+	// RollbackErrors maps the index of each uploaded asset that could not be
+	// deleted during rollback to the error encountered.
+	RollbackErrors map[int]error
+}
+
+func (e *ReleaseAssetsUploadError) Error() string {
+	msg := fmt.Sprintf("failed to upload release assets: %v", joinIndexedErrors(e.Errors))
+	if len(e.RollbackErrors) > 0 {
+		msg += fmt.Sprintf("; failed to roll back: %v", joinIndexedErrors(e.RollbackErrors))
+	}
+	return msg
+}
+
+func joinIndexedErrors(errs map[int]error) string {
+	indexes := make([]int, 0, len(errs))
+	for i := range errs {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+
+	msgs := make([]string, len(indexes))
+	for j, i := range indexes {
+		msgs[j] = fmt.Sprintf("asset %d: %v", i, errs[i])
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// UploadReleaseAssets uploads assets into the release with the given id,
+// calling UploadReleaseAssetFromReader for each of them with at most
+// concurrency uploads in flight, or defaultConcurrentRequests if
+// concurrency is not positive.
+//
+// The returned assets are in the order of the given ones. If some of the
+// uploads fail, a *ReleaseAssetsUploadError is returned, and the assets that
+// could not be uploaded are nil. If opts.Rollback is set, the assets that
+// were uploaded are then deleted again, and only those that could not be
+// deleted are returned. Once GitHub reports that the rate limit is exceeded,
+// no further uploads are made and the remaining assets fail with the same
+// rate limit error.
+func (s *RepositoriesService) UploadReleaseAssets(ctx context.Context, owner, repo string, id int64, assets []AssetUpload, concurrency int, opts *UploadReleaseAssetsOptions) ([]*ReleaseAsset, error) {
+	if concurrency <= 0 {
+		concurrency = defaultConcurrentRequests
+	}
+	uploaded := make([]*ReleaseAsset, len(assets))
+	errs := runBounded(ctx, len(assets), concurrency, func(i int) error {
+		a := assets[i]
+		asset, _, err := s.UploadReleaseAssetFromReader(ctx, owner, repo, id, a.Options, a.Reader, a.Size, a.ContentType)
+		if err != nil {
+			return err
+		}
+		uploaded[i] = asset
+		return nil
+	})
+
+	if len(errs) == 0 {
+		return uploaded, nil
+	}
+
+	uploadErr := &ReleaseAssetsUploadError{Errors: errs}
+	if opts != nil && opts.Rollback {
+		for i, asset := range uploaded {
+			if asset == nil {
+				continue
+			}
+			if _, err := s.DeleteReleaseAsset(ctx, owner, repo, asset.GetID()); err != nil {
+				if uploadErr.RollbackErrors == nil {
+					uploadErr.RollbackErrors = make(map[int]error)
+				}
+				uploadErr.RollbackErrors[i] = err
+				continue
+			}
+			uploaded[i] = nil
+		}
+	}
+	return uploaded, uploadErr
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// setupReleaseAssetUploads registers handlers that upload assets named
+// a0, a1, ... into release 1, failing for the names in fail, and that
+// record which asset IDs are deleted. Rollback deletes assets one at a
+// time, so the handlers need no locking.
+func setupReleaseAssetUploads(t *testing.T, mux *http.ServeMux, fail map[string]bool) map[int64]bool {
+	deleted := make(map[int64]bool)
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		name := r.FormValue("name")
+		if fail[name] {
+			http.Error(w, `{"message":"Validation Failed"}`, http.StatusUnprocessableEntity)
+			return
+		}
+		var id int
+		fmt.Sscanf(name, "a%d", &id)
+		fmt.Fprintf(w, `{"id":%d,"name":%q}`, id+1, name)
+	})
+	mux.HandleFunc("/repos/o/r/releases/assets/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		var id int64
+		fmt.Sscanf(strings.TrimPrefix(r.URL.Path, "/repos/o/r/releases/assets/"), "%d", &id)
+		deleted[id] = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return deleted
+}
+
+func testAssetUploads(n int) []AssetUpload {
+	assets := make([]AssetUpload, n)
+	for i := range assets {
+		assets[i] = AssetUpload{
+			Options:     &UploadOptions{Name: fmt.Sprintf("a%d", i)},
+			Reader:      strings.NewReader("data"),
+			Size:        4,
+			ContentType: "application/zip",
+		}
+	}
+	return assets
+}
+
+func TestRepositoriesService_UploadReleaseAssets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	deleted := setupReleaseAssetUploads(t, mux, nil)
+
+	ctx := context.Background()
+	assets, err := client.Repositories.UploadReleaseAssets(ctx, "o", "r", 1, testAssetUploads(3), 2, &UploadReleaseAssetsOptions{Rollback: true})
+	if err != nil {
+		t.Fatalf("Repositories.UploadReleaseAssets returned error: %v", err)
+	}
+
+	want := []*ReleaseAsset{
+		{ID: Int64(1), Name: String("a0")},
+		{ID: Int64(2), Name: String("a1")},
+		{ID: Int64(3), Name: String("a2")},
+	}
+	if !cmp.Equal(assets, want) {
+		t.Errorf("Repositories.UploadReleaseAssets returned %+v, want %+v", assets, want)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("Repositories.UploadReleaseAssets deleted %v, want none", deleted)
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAssets_rollback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	deleted := setupReleaseAssetUploads(t, mux, map[string]bool{"a1": true})
+
+	ctx := context.Background()
+	assets, err := client.Repositories.UploadReleaseAssets(ctx, "o", "r", 1, testAssetUploads(3), 0, &UploadReleaseAssetsOptions{Rollback: true})
+	uploadErr, ok := err.(*ReleaseAssetsUploadError)
+	if !ok {
+		t.Fatalf("Repositories.UploadReleaseAssets returned error %v, want *ReleaseAssetsUploadError", err)
+	}
+	if _, ok := uploadErr.Errors[1].(*ErrorResponse); !ok || len(uploadErr.Errors) != 1 {
+		t.Errorf("ReleaseAssetsUploadError.Errors = %v, want a single *ErrorResponse for asset 1", uploadErr.Errors)
+	}
+	if len(uploadErr.RollbackErrors) != 0 {
+		t.Errorf("ReleaseAssetsUploadError.RollbackErrors = %v, want none", uploadErr.RollbackErrors)
+	}
+
+	if want := []*ReleaseAsset{nil, nil, nil}; !cmp.Equal(assets, want) {
+		t.Errorf("Repositories.UploadReleaseAssets returned %+v, want %+v", assets, want)
+	}
+	if want := map[int64]bool{1: true, 3: true}; !cmp.Equal(deleted, want) {
+		t.Errorf("Repositories.UploadReleaseAssets deleted %v, want %v", deleted, want)
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAssets_noRollback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	deleted := setupReleaseAssetUploads(t, mux, map[string]bool{"a1": true})
+
+	ctx := context.Background()
+	assets, err := client.Repositories.UploadReleaseAssets(ctx, "o", "r", 1, testAssetUploads(3), 1, nil)
+	uploadErr, ok := err.(*ReleaseAssetsUploadError)
+	if !ok {
+		t.Fatalf("Repositories.UploadReleaseAssets returned error %v, want *ReleaseAssetsUploadError", err)
+	}
+	if _, ok := uploadErr.Errors[1].(*ErrorResponse); !ok || len(uploadErr.Errors) != 1 {
+		t.Errorf("ReleaseAssetsUploadError.Errors = %v, want a single *ErrorResponse for asset 1", uploadErr.Errors)
+	}
+
+	want := []*ReleaseAsset{
+		{ID: Int64(1), Name: String("a0")},
+		nil,
+		{ID: Int64(3), Name: String("a2")},
+	}
+	if !cmp.Equal(assets, want) {
+		t.Errorf("Repositories.UploadReleaseAssets returned %+v, want %+v", assets, want)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("Repositories.UploadReleaseAssets deleted %v, want none", deleted)
+	}
+}
+
+func TestReleaseAssetsUploadError_Error(t *testing.T) {
+	err := &ReleaseAssetsUploadError{
+		Errors:         map[int]error{2: fmt.Errorf("b"), 0: fmt.Errorf("a")},
+		RollbackErrors: map[int]error{1: fmt.Errorf("c")},
+	}
+	want := "failed to upload release assets: asset 0: a; asset 2: b; failed to roll back: asset 1: c"
+	if got := err.Error(); got != want {
+		t.Errorf("ReleaseAssetsUploadError.Error() = %q, want %q", got, want)
+	}
+}
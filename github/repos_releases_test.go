@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -160,6 +161,78 @@ func TestRepositoriesService_GetReleaseByTag(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetLatestRelease_noRelease(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/latest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := context.Background()
+	release, resp, err := client.Repositories.GetLatestRelease(ctx, "o", "r")
+	if !errors.Is(err, ErrNoRelease) {
+		t.Errorf("Repositories.GetLatestRelease returned error %v, want ErrNoRelease", err)
+	}
+	if _, ok := err.(*NoReleaseError); !ok {
+		t.Errorf("Repositories.GetLatestRelease returned error %v, want *NoReleaseError", err)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("Repositories.GetLatestRelease returned error %v, want it to match the 404 *ErrorResponse", err)
+	}
+	if release != nil {
+		t.Errorf("Repositories.GetLatestRelease returned %+v, want nil", release)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Repositories.GetLatestRelease returned response %v, want the 404 response", resp)
+	}
+}
+
+func TestRepositoriesService_GetReleaseByTag_noRelease(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/tags/v1.0", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.GetReleaseByTag(ctx, "o", "r", "v1.0")
+	if !errors.Is(err, ErrNoRelease) {
+		t.Errorf("Repositories.GetReleaseByTag returned error %v, want ErrNoRelease", err)
+	}
+}
+
+func TestRepositoriesService_GetLatestRelease_repoNotFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/latest", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.GetLatestRelease(ctx, "o", "r")
+	if errors.Is(err, ErrNoRelease) {
+		t.Error("Repositories.GetLatestRelease returned ErrNoRelease for a missing repository")
+	}
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Repositories.GetLatestRelease returned error %v, want *ErrorResponse", err)
+	}
+}
+
 func TestRepositoriesService_CreateRelease(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -285,6 +358,44 @@ func TestRepositoriesService_EditRelease(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_EditRelease_publishDraft(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"draft":false,"prerelease":false}`+"\n")
+		fmt.Fprint(w, `{"id":1,"draft":false,"prerelease":false}`)
+	})
+
+	ctx := context.Background()
+	input := &RepositoryRelease{Draft: Bool(false), Prerelease: Bool(false)}
+	release, _, err := client.Repositories.EditRelease(ctx, "o", "r", 1, input)
+	if err != nil {
+		t.Errorf("Repositories.EditRelease returned error: %v", err)
+	}
+	if want := (&RepositoryRelease{ID: Int64(1), Draft: Bool(false), Prerelease: Bool(false)}); !cmp.Equal(release, want) {
+		t.Errorf("Repositories.EditRelease returned = %+v, want %+v", release, want)
+	}
+}
+
+func TestRepositoriesService_EditRelease_makeLatest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"make_latest":"legacy"}`+"\n")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := context.Background()
+	input := &RepositoryRelease{MakeLatest: String("legacy")}
+	if _, _, err := client.Repositories.EditRelease(ctx, "o", "r", 1, input); err != nil {
+		t.Errorf("Repositories.EditRelease returned error: %v", err)
+	}
+}
+
 func TestRepositoriesService_DeleteRelease(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -492,6 +603,117 @@ func TestRepositoriesService_DownloadReleaseAsset_APIError(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_DownloadReleaseAssetRange(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testHeader(t, r, "Range", "bytes=6-10")
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Range", "bytes 6-10/11")
+		w.WriteHeader(http.StatusPartialContent)
+		fmt.Fprint(w, "World")
+	})
+
+	ctx := context.Background()
+	reader, size, partial, err := client.Repositories.DownloadReleaseAssetRange(ctx, "o", "r", 1, 6, 10)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadReleaseAssetRange returned error: %v", err)
+	}
+	defer reader.Close()
+
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Errorf("Repositories.DownloadReleaseAssetRange returned bad reader: %v", err)
+	}
+	if want := []byte("World"); !bytes.Equal(want, content) {
+		t.Errorf("Repositories.DownloadReleaseAssetRange returned %q, want %q", content, want)
+	}
+	if !partial {
+		t.Error("Repositories.DownloadReleaseAssetRange returned partial = false, want true")
+	}
+	if got, want := size, int64(11); got != want {
+		t.Errorf("Repositories.DownloadReleaseAssetRange returned size %d, want %d", got, want)
+	}
+
+	const methodName = "DownloadReleaseAssetRange"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, _, err = client.Repositories.DownloadReleaseAssetRange(ctx, "\n", "\n", -1, 0, -1)
+		return err
+	})
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, _, err = client.Repositories.DownloadReleaseAssetRange(ctx, "o", "r", 1, 10, 6)
+		return err
+	})
+}
+
+func TestRepositoriesService_DownloadReleaseAssetRange_FollowRedirect(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+	client.client.Transport = authTransport()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, serverURL+baseURLPath+"/yo", http.StatusFound)
+	})
+	mux.HandleFunc("/yo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testNoAuthorization(t, r)
+		testHeader(t, r, "Range", "bytes=6-")
+		w.Header().Set("Content-Range", "bytes 6-10/*")
+		w.WriteHeader(http.StatusPartialContent)
+		fmt.Fprint(w, "World")
+	})
+
+	ctx := context.Background()
+	reader, size, partial, err := client.Repositories.DownloadReleaseAssetRange(ctx, "o", "r", 1, 6, -1)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadReleaseAssetRange returned error: %v", err)
+	}
+	reader.Close()
+
+	if !partial {
+		t.Error("Repositories.DownloadReleaseAssetRange returned partial = false, want true")
+	}
+	if got, want := size, int64(-1); got != want {
+		t.Errorf("Repositories.DownloadReleaseAssetRange returned size %d, want %d", got, want)
+	}
+}
+
+func TestRepositoriesService_DownloadReleaseAssetRange_RangeIgnored(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set("Content-Length", "11")
+		fmt.Fprint(w, "Hello World")
+	})
+
+	ctx := context.Background()
+	reader, size, partial, err := client.Repositories.DownloadReleaseAssetRange(ctx, "o", "r", 1, 6, 10)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadReleaseAssetRange returned error: %v", err)
+	}
+	defer reader.Close()
+
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Errorf("Repositories.DownloadReleaseAssetRange returned bad reader: %v", err)
+	}
+	if want := []byte("Hello World"); !bytes.Equal(want, content) {
+		t.Errorf("Repositories.DownloadReleaseAssetRange returned %q, want %q", content, want)
+	}
+	if partial {
+		t.Error("Repositories.DownloadReleaseAssetRange returned partial = true, want false")
+	}
+	if got, want := size, int64(11); got != want {
+		t.Errorf("Repositories.DownloadReleaseAssetRange returned size %d, want %d", got, want)
+	}
+}
+
 func TestRepositoriesService_EditReleaseAsset(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -653,3 +875,71 @@ func TestRepositoriesService_UploadReleaseAsset(t *testing.T) {
 		})
 	}
 }
+
+func TestRepositoriesService_UploadReleaseAssetFromReader(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/zip")
+		testHeader(t, r, "Content-Length", "12")
+		testFormValues(t, r, values{"name": "n.zip"})
+		testBody(t, r, "Upload me !\n")
+
+		fmt.Fprintf(w, `{"id":1}`)
+	})
+
+	opts := &UploadOptions{Name: "n.zip", MediaType: "image/png"}
+	ctx := context.Background()
+	asset, _, err := client.Repositories.UploadReleaseAssetFromReader(ctx, "o", "r", 1, opts, bytes.NewReader([]byte("Upload me !\n")), 12, "application/zip")
+	if err != nil {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader returned error: %v", err)
+	}
+	want := &ReleaseAsset{ID: Int64(1)}
+	if !cmp.Equal(asset, want) {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader returned %+v, want %+v", asset, want)
+	}
+
+	const methodName = "UploadReleaseAssetFromReader"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.UploadReleaseAssetFromReader(ctx, "\n", "\n", 1, opts, strings.NewReader("x"), 1, "")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.UploadReleaseAssetFromReader(ctx, "o", "r", 1, opts, bytes.NewReader([]byte("Upload me !\n")), 12, "application/zip")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_UploadReleaseAssetFromReader_defaultMediaType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Content-Type", defaultMediaType)
+		testHeader(t, r, "Content-Length", "3")
+		fmt.Fprintf(w, `{"id":1}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.UploadReleaseAssetFromReader(ctx, "o", "r", 1, nil, strings.NewReader("abc"), 3, ""); err != nil {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAssetFromReader_invalidSize(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	for _, size := range []int64{0, -1} {
+		if _, _, err := client.Repositories.UploadReleaseAssetFromReader(ctx, "o", "r", 1, nil, strings.NewReader(""), size, ""); err == nil {
+			t.Errorf("Repositories.UploadReleaseAssetFromReader returned no error for size %d", size)
+		}
+	}
+}
@@ -0,0 +1,397 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// BypassActor represents an actor allowed to bypass the rules of a Ruleset.
+type BypassActor struct {
+	ActorID *int64 `json:"actor_id,omitempty"`
+	// Possible values for ActorType are: Integration, OrganizationAdmin,
+	// RepositoryRole, Team, DeployKey.
+	ActorType *string `json:"actor_type,omitempty"`
+	// Possible values for BypassMode are: always, pull_request.
+	BypassMode *string `json:"bypass_mode,omitempty"`
+}
+
+// RulesetLink represents a single link object from GitHub ruleset request _links.
+type RulesetLink struct {
+	HRef *string `json:"href,omitempty"`
+}
+
+// RulesetLinks represents the "_links" object in a Ruleset.
+type RulesetLinks struct {
+	Self *RulesetLink `json:"self,omitempty"`
+	HTML *RulesetLink `json:"html,omitempty"`
+}
+
+// RulesetRefConditionParameters represents the conditions object for ref_names.
+type RulesetRefConditionParameters struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+// RulesetRepositoryNamesConditionParameters represents the conditions object for repository_names.
+type RulesetRepositoryNamesConditionParameters struct {
+	Include   []string `json:"include"`
+	Exclude   []string `json:"exclude"`
+	Protected *bool    `json:"protected,omitempty"`
+}
+
+// RulesetRepositoryIDsConditionParameters represents the conditions object for repository_id.
+type RulesetRepositoryIDsConditionParameters struct {
+	RepositoryIDs []int64 `json:"repository_ids,omitempty"`
+}
+
+// RulesetRepositoryPropertyTargetParameters represents a repository_property
+// name and values to be used for targeting.
+type RulesetRepositoryPropertyTargetParameters struct {
+	Name   string   `json:"name"`
+	Values []string `json:"property_values"`
+	// Possible values for Source are: custom, system.
+	Source *string `json:"source,omitempty"`
+}
+
+// RulesetRepositoryPropertyConditionParameters represents the conditions object for repository_property.
+type RulesetRepositoryPropertyConditionParameters struct {
+	Include []*RulesetRepositoryPropertyTargetParameters `json:"include"`
+	Exclude []*RulesetRepositoryPropertyTargetParameters `json:"exclude"`
+}
+
+// RulesetConditions represents the conditions object in a Ruleset. For
+// organization rulesets, exactly one of RepositoryName, RepositoryID and
+// RepositoryProperty selects the repositories the ruleset targets.
+type RulesetConditions struct {
+	RefName            *RulesetRefConditionParameters                `json:"ref_name,omitempty"`
+	RepositoryName     *RulesetRepositoryNamesConditionParameters    `json:"repository_name,omitempty"`
+	RepositoryID       *RulesetRepositoryIDsConditionParameters      `json:"repository_id,omitempty"`
+	RepositoryProperty *RulesetRepositoryPropertyConditionParameters `json:"repository_property,omitempty"`
+}
+
+// RepositoryRule represents a GitHub rule. Parameters holds the raw JSON
+// parameters of the rule, whose shape depends on Type.
+type RepositoryRule struct {
+	Type       *string          `json:"type,omitempty"`
+	Parameters *json.RawMessage `json:"parameters,omitempty"`
+}
+
+// Ruleset represents a GitHub ruleset object.
+type Ruleset struct {
+	ID   *int64  `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	// Possible values for Target are: branch, tag, push.
+	Target *string `json:"target,omitempty"`
+	// Possible values for SourceType are: Repository, Organization.
+	SourceType *string `json:"source_type,omitempty"`
+	Source     *string `json:"source,omitempty"`
+	// Possible values for Enforcement are: disabled, active, evaluate.
+	Enforcement  *string            `json:"enforcement,omitempty"`
+	BypassActors []*BypassActor     `json:"bypass_actors,omitempty"`
+	NodeID       *string            `json:"node_id,omitempty"`
+	Links        *RulesetLinks      `json:"_links,omitempty"`
+	Conditions   *RulesetConditions `json:"conditions,omitempty"`
+	Rules        []*RepositoryRule  `json:"rules,omitempty"`
+	CreatedAt    *Timestamp         `json:"created_at,omitempty"`
+	UpdatedAt    *Timestamp         `json:"updated_at,omitempty"`
+}
+
+// RulesetVersionActor represents the actor that made the changes recorded in
+// a RulesetVersion.
+type RulesetVersionActor struct {
+	ID *int64 `json:"id,omitempty"`
+	// Possible values for Type are: User, Integration.
+	Type *string `json:"type,omitempty"`
+}
+
+// RulesetVersion represents a version in the history of a Ruleset.
+type RulesetVersion struct {
+	VersionID *int64               `json:"version_id,omitempty"`
+	Actor     *RulesetVersionActor `json:"actor,omitempty"`
+	UpdatedAt *Timestamp           `json:"updated_at,omitempty"`
+}
+
+// RulesetVersionWithState represents a version in the history of a Ruleset,
+// along with the state of the ruleset at that version.
+type RulesetVersionWithState struct {
+	VersionID *int64               `json:"version_id,omitempty"`
+	Actor     *RulesetVersionActor `json:"actor,omitempty"`
+	UpdatedAt *Timestamp           `json:"updated_at,omitempty"`
+	State     *Ruleset             `json:"state,omitempty"`
+}
+
+// rulesetBypassActorsRequest is used to update only the bypass actors of a
+// Ruleset. BypassActors is always sent, so an empty slice clears them.
+type rulesetBypassActorsRequest struct {
+	BypassActors []*BypassActor `json:"bypass_actors"`
+}
+
+// GetRuleset gets a ruleset for the specified repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos#get-a-repository-ruleset
+func (s *RepositoriesService) GetRuleset(ctx context.Context, owner, repo string, rulesetID int64) (*Ruleset, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets/%v", owner, repo, rulesetID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleset := new(Ruleset)
+	resp, err := s.client.Do(ctx, req, ruleset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleset, resp, nil
+}
+
+// UpdateRuleset updates a ruleset for the specified repository. Fields of rs
+// that are not set are left unchanged.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos#update-a-repository-ruleset
+func (s *RepositoriesService) UpdateRuleset(ctx context.Context, owner, repo string, rulesetID int64, rs *Ruleset) (*Ruleset, *Response, error) {
+	return s.updateRuleset(ctx, owner, repo, rulesetID, rs)
+}
+
+func (s *RepositoriesService) updateRuleset(ctx context.Context, owner, repo string, rulesetID int64, body interface{}) (*Ruleset, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets/%v", owner, repo, rulesetID)
+
+	req, err := s.client.NewRequest("PUT", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleset := new(Ruleset)
+	resp, err := s.client.Do(ctx, req, ruleset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleset, resp, nil
+}
+
+// UpdateRulesetClearBypassActors removes all bypass actors from a ruleset for
+// the specified repository, leaving the rest of the ruleset unchanged.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos#update-a-repository-ruleset
+func (s *RepositoriesService) UpdateRulesetClearBypassActors(ctx context.Context, owner, repo string, rulesetID int64) (*Ruleset, *Response, error) {
+	return s.updateRuleset(ctx, owner, repo, rulesetID, &rulesetBypassActorsRequest{BypassActors: []*BypassActor{}})
+}
+
+// AddRulesetBypassActor adds actor to the bypass actors of a ruleset for the
+// specified repository. If the ruleset already has a bypass actor with the
+// same ActorID and ActorType, it is replaced by actor.
+//
+// The bypass actors are fetched, modified and written back with separate
+// requests, so changes made to them by others in between are lost. Only the
+// bypass actors are written, so changes to other fields are kept.
+func (s *RepositoriesService) AddRulesetBypassActor(ctx context.Context, owner, repo string, rulesetID int64, actor *BypassActor) (*Ruleset, *Response, error) {
+	if actor == nil {
+		return nil, nil, errors.New("actor must be provided")
+	}
+	return s.modifyRulesetBypassActors(ctx, owner, repo, rulesetID, func(actors []*BypassActor) []*BypassActor {
+		actors = removeBypassActor(actors, actor.GetActorID(), actor.GetActorType())
+		return append(actors, actor)
+	})
+}
+
+// RemoveRulesetBypassActor removes the bypass actor with the given ID and
+// type from a ruleset for the specified repository. It is not an error if
+// the ruleset has no such bypass actor.
+//
+// Like AddRulesetBypassActor, it fetches, modifies and writes back the bypass
+// actors with separate requests, so changes made to them by others in
+// between are lost.
+func (s *RepositoriesService) RemoveRulesetBypassActor(ctx context.Context, owner, repo string, rulesetID, actorID int64, actorType string) (*Ruleset, *Response, error) {
+	return s.modifyRulesetBypassActors(ctx, owner, repo, rulesetID, func(actors []*BypassActor) []*BypassActor {
+		return removeBypassActor(actors, actorID, actorType)
+	})
+}
+
+// modifyRulesetBypassActors fetches the bypass actors of a ruleset, applies
+// modify to them and writes the result back.
+func (s *RepositoriesService) modifyRulesetBypassActors(ctx context.Context, owner, repo string, rulesetID int64, modify func([]*BypassActor) []*BypassActor) (*Ruleset, *Response, error) {
+	ruleset, resp, err := s.GetRuleset(ctx, owner, repo, rulesetID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	actors := modify(ruleset.BypassActors)
+	if actors == nil {
+		actors = []*BypassActor{}
+	}
+	return s.updateRuleset(ctx, owner, repo, rulesetID, &rulesetBypassActorsRequest{BypassActors: actors})
+}
+
+// removeBypassActor returns actors without those matching actorID and actorType.
+func removeBypassActor(actors []*BypassActor, actorID int64, actorType string) []*BypassActor {
+	var kept []*BypassActor
+	for _, a := range actors {
+		if a.GetActorID() == actorID && a.GetActorType() == actorType {
+			continue
+		}
+		kept = append(kept, a)
+	}
+	return kept
+}
+
+// GetRulesetHistory lists the versions of a repository ruleset.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos#get-repository-ruleset-history
+func (s *RepositoriesService) GetRulesetHistory(ctx context.Context, owner, repo string, rulesetID int64, opts *ListOptions) ([]*RulesetVersion, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets/%v/history", owner, repo, rulesetID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var versions []*RulesetVersion
+	resp, err := s.client.Do(ctx, req, &versions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return versions, resp, nil
+}
+
+// GetRulesetVersion gets a version of a repository ruleset, along with the
+// state of the ruleset at that version.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos#get-repository-ruleset-version
+func (s *RepositoriesService) GetRulesetVersion(ctx context.Context, owner, repo string, rulesetID, versionID int64) (*RulesetVersionWithState, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets/%v/history/%v", owner, repo, rulesetID, versionID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(RulesetVersionWithState)
+	resp, err := s.client.Do(ctx, req, version)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return version, resp, nil
+}
+
+// RuleSuiteOptions specifies the optional parameters to the
+// RepositoriesService.GetRepositoryRuleSuites method.
+type RuleSuiteOptions struct {
+	// Ref filters rule suites by the name of the ref. Only branch and tag
+	// refs are supported, e.g. "refs/heads/main".
+	Ref string `url:"ref,omitempty"`
+
+	// TimePeriod filters rule suites by the time period in which they were
+	// evaluated. Possible values are: hour, day, week, month.
+	TimePeriod string `url:"time_period,omitempty"`
+
+	// ActorName filters rule suites by the handle of the user who triggered
+	// the evaluation.
+	ActorName string `url:"actor_name,omitempty"`
+
+	// RuleSuiteResult filters rule suites by their result.
+	// Possible values are: pass, fail, bypass, all.
+	RuleSuiteResult string `url:"rule_suite_result,omitempty"`
+
+	ListOptions
+}
+
+// RuleSource represents the source of a rule evaluated in a RuleSuite.
+type RuleSource struct {
+	// Possible values for Type are: Repository, Organization, Enterprise.
+	Type *string `json:"type,omitempty"`
+	ID   *int64  `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// RuleEvaluation represents the result of evaluating a single rule within a
+// RuleSuite.
+type RuleEvaluation struct {
+	RuleSource *RuleSource `json:"rule_source,omitempty"`
+	// Possible values for Enforcement are: active, evaluate, deleted ruleset.
+	Enforcement *string `json:"enforcement,omitempty"`
+	// Possible values for Result are: pass, fail.
+	Result   *string `json:"result,omitempty"`
+	RuleType *string `json:"rule_type,omitempty"`
+	Details  *string `json:"details,omitempty"`
+}
+
+// RuleSuite represents the result of evaluating the rules that apply to a
+// push against a repository.
+type RuleSuite struct {
+	ID             *int64     `json:"id,omitempty"`
+	ActorID        *int64     `json:"actor_id,omitempty"`
+	ActorName      *string    `json:"actor_name,omitempty"`
+	BeforeSHA      *string    `json:"before_sha,omitempty"`
+	AfterSHA       *string    `json:"after_sha,omitempty"`
+	Ref            *string    `json:"ref,omitempty"`
+	RepositoryID   *int64     `json:"repository_id,omitempty"`
+	RepositoryName *string    `json:"repository_name,omitempty"`
+	PushedAt       *Timestamp `json:"pushed_at,omitempty"`
+	// Possible values for Result are: pass, fail, bypass.
+	Result *string `json:"result,omitempty"`
+	// Possible values for EvaluationResult are: pass, fail.
+	EvaluationResult *string `json:"evaluation_result,omitempty"`
+	// RuleEvaluations is only populated by GetRepositoryRuleSuite.
+	RuleEvaluations []*RuleEvaluation `json:"rule_evaluations,omitempty"`
+}
+
+// GetRepositoryRuleSuites lists the suites of rule evaluations for a
+// repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos#list-repository-rule-suites
+func (s *RepositoriesService) GetRepositoryRuleSuites(ctx context.Context, owner, repo string, opts *RuleSuiteOptions) ([]*RuleSuite, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets/rule-suites", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var suites []*RuleSuite
+	resp, err := s.client.Do(ctx, req, &suites)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return suites, resp, nil
+}
+
+// GetRepositoryRuleSuite gets a single suite of rule evaluations for a
+// repository, including the result of each evaluated rule.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos#get-a-repository-rule-suite
+func (s *RepositoriesService) GetRepositoryRuleSuite(ctx context.Context, owner, repo string, suiteID int64) (*RuleSuite, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets/rule-suites/%v", owner, repo, suiteID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	suite := new(RuleSuite)
+	resp, err := s.client.Do(ctx, req, suite)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return suite, resp, nil
+}
@@ -0,0 +1,491 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepositoriesService_GetRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":42,"name":"ruleset","source_type":"Repository","source":"o/r","enforcement":"active"}`)
+	})
+
+	ctx := context.Background()
+	ruleset, _, err := client.Repositories.GetRuleset(ctx, "o", "r", 42)
+	if err != nil {
+		t.Errorf("Repositories.GetRuleset returned error: %v", err)
+	}
+
+	want := &Ruleset{
+		ID:          Int64(42),
+		Name:        String("ruleset"),
+		SourceType:  String("Repository"),
+		Source:      String("o/r"),
+		Enforcement: String("active"),
+	}
+	if !cmp.Equal(ruleset, want) {
+		t.Errorf("Repositories.GetRuleset returned %+v, want %+v", ruleset, want)
+	}
+
+	const methodName = "GetRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetRuleset(ctx, "\n", "\n", 42)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetRuleset(ctx, "o", "r", 42)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_UpdateRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Ruleset{Enforcement: String("evaluate")}
+
+	mux.HandleFunc("/repos/o/r/rulesets/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"enforcement":"evaluate"}`+"\n")
+		fmt.Fprint(w, `{"id":42,"enforcement":"evaluate"}`)
+	})
+
+	ctx := context.Background()
+	ruleset, _, err := client.Repositories.UpdateRuleset(ctx, "o", "r", 42, input)
+	if err != nil {
+		t.Errorf("Repositories.UpdateRuleset returned error: %v", err)
+	}
+
+	want := &Ruleset{ID: Int64(42), Enforcement: String("evaluate")}
+	if !cmp.Equal(ruleset, want) {
+		t.Errorf("Repositories.UpdateRuleset returned %+v, want %+v", ruleset, want)
+	}
+
+	const methodName = "UpdateRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.UpdateRuleset(ctx, "\n", "\n", 42, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.UpdateRuleset(ctx, "o", "r", 42, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_UpdateRulesetClearBypassActors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"bypass_actors":[]}`+"\n")
+		fmt.Fprint(w, `{"id":42}`)
+	})
+
+	ctx := context.Background()
+	ruleset, _, err := client.Repositories.UpdateRulesetClearBypassActors(ctx, "o", "r", 42)
+	if err != nil {
+		t.Errorf("Repositories.UpdateRulesetClearBypassActors returned error: %v", err)
+	}
+	if want := (&Ruleset{ID: Int64(42)}); !cmp.Equal(ruleset, want) {
+		t.Errorf("Repositories.UpdateRulesetClearBypassActors returned %+v, want %+v", ruleset, want)
+	}
+}
+
+func TestRepositoriesService_AddRulesetBypassActor(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/42", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id":42,"name":"ruleset","bypass_actors":[{"actor_id":1,"actor_type":"Team","bypass_mode":"always"},{"actor_id":5,"actor_type":"RepositoryRole","bypass_mode":"always"}]}`)
+		case "PUT":
+			testBody(t, r, `{"bypass_actors":[{"actor_id":1,"actor_type":"Team","bypass_mode":"always"},{"actor_id":5,"actor_type":"RepositoryRole","bypass_mode":"pull_request"}]}`+"\n")
+			fmt.Fprint(w, `{"id":42,"name":"ruleset","bypass_actors":[{"actor_id":1,"actor_type":"Team","bypass_mode":"always"},{"actor_id":5,"actor_type":"RepositoryRole","bypass_mode":"pull_request"}]}`)
+		default:
+			t.Errorf("Request method: %v, want GET or PUT", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	actor := &BypassActor{ActorID: Int64(5), ActorType: String("RepositoryRole"), BypassMode: String("pull_request")}
+	ruleset, _, err := client.Repositories.AddRulesetBypassActor(ctx, "o", "r", 42, actor)
+	if err != nil {
+		t.Fatalf("Repositories.AddRulesetBypassActor returned error: %v", err)
+	}
+
+	want := []*BypassActor{
+		{ActorID: Int64(1), ActorType: String("Team"), BypassMode: String("always")},
+		actor,
+	}
+	if !cmp.Equal(ruleset.BypassActors, want) {
+		t.Errorf("Repositories.AddRulesetBypassActor returned bypass actors %+v, want %+v", ruleset.BypassActors, want)
+	}
+
+	if _, _, err := client.Repositories.AddRulesetBypassActor(ctx, "o", "r", 42, nil); err == nil {
+		t.Error("Repositories.AddRulesetBypassActor returned no error for a nil actor")
+	}
+}
+
+func TestRepositoriesService_RemoveRulesetBypassActor(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/42", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id":42,"bypass_actors":[{"actor_id":1,"actor_type":"Team","bypass_mode":"always"}]}`)
+		case "PUT":
+			testBody(t, r, `{"bypass_actors":[]}`+"\n")
+			fmt.Fprint(w, `{"id":42}`)
+		default:
+			t.Errorf("Request method: %v, want GET or PUT", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	ruleset, _, err := client.Repositories.RemoveRulesetBypassActor(ctx, "o", "r", 42, 1, "Team")
+	if err != nil {
+		t.Fatalf("Repositories.RemoveRulesetBypassActor returned error: %v", err)
+	}
+	if len(ruleset.BypassActors) != 0 {
+		t.Errorf("Repositories.RemoveRulesetBypassActor returned bypass actors %+v, want none", ruleset.BypassActors)
+	}
+}
+
+func TestRepositoriesService_RemoveRulesetBypassActor_getError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/42", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("Request method: %v, want GET", r.Method)
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.RemoveRulesetBypassActor(ctx, "o", "r", 42, 1, "Team"); err == nil {
+		t.Error("Repositories.RemoveRulesetBypassActor returned no error")
+	}
+}
+
+func TestRepositoriesService_GetRulesetHistory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/42/history", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[
+			{"version_id":3,"actor":{"id":1,"type":"User"},"updated_at":"2021-01-02T00:00:00Z"},
+			{"version_id":2,"actor":{"id":2,"type":"Integration"},"updated_at":"2021-01-01T00:00:00Z"}
+		]`)
+	})
+
+	opt := &ListOptions{Page: 2}
+	ctx := context.Background()
+	versions, _, err := client.Repositories.GetRulesetHistory(ctx, "o", "r", 42, opt)
+	if err != nil {
+		t.Errorf("Repositories.GetRulesetHistory returned error: %v", err)
+	}
+
+	want := []*RulesetVersion{
+		{
+			VersionID: Int64(3),
+			Actor:     &RulesetVersionActor{ID: Int64(1), Type: String("User")},
+			UpdatedAt: &Timestamp{time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			VersionID: Int64(2),
+			Actor:     &RulesetVersionActor{ID: Int64(2), Type: String("Integration")},
+			UpdatedAt: &Timestamp{time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	if !cmp.Equal(versions, want) {
+		t.Errorf("Repositories.GetRulesetHistory returned %+v, want %+v", versions, want)
+	}
+
+	const methodName = "GetRulesetHistory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetRulesetHistory(ctx, "\n", "\n", 42, opt)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetRulesetHistory(ctx, "o", "r", 42, opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetRulesetVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/42/history/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"version_id": 3,
+			"actor": {"id": 1, "type": "User"},
+			"updated_at": "2021-01-02T00:00:00Z",
+			"state": {
+				"id": 42,
+				"name": "main",
+				"target": "branch",
+				"source_type": "Repository",
+				"source": "o/r",
+				"enforcement": "active",
+				"conditions": {"ref_name": {"include": ["refs/heads/main"], "exclude": []}},
+				"rules": [{"type": "deletion"}]
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	version, _, err := client.Repositories.GetRulesetVersion(ctx, "o", "r", 42, 3)
+	if err != nil {
+		t.Errorf("Repositories.GetRulesetVersion returned error: %v", err)
+	}
+
+	want := &RulesetVersionWithState{
+		VersionID: Int64(3),
+		Actor:     &RulesetVersionActor{ID: Int64(1), Type: String("User")},
+		UpdatedAt: &Timestamp{time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		State: &Ruleset{
+			ID:          Int64(42),
+			Name:        String("main"),
+			Target:      String("branch"),
+			SourceType:  String("Repository"),
+			Source:      String("o/r"),
+			Enforcement: String("active"),
+			Conditions: &RulesetConditions{
+				RefName: &RulesetRefConditionParameters{
+					Include: []string{"refs/heads/main"},
+					Exclude: []string{},
+				},
+			},
+			Rules: []*RepositoryRule{{Type: String("deletion")}},
+		},
+	}
+	if !cmp.Equal(version, want) {
+		t.Errorf("Repositories.GetRulesetVersion returned %+v, want %+v", version, want)
+	}
+
+	const methodName = "GetRulesetVersion"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetRulesetVersion(ctx, "\n", "\n", 42, 3)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetRulesetVersion(ctx, "o", "r", 42, 3)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetRepositoryRuleSuites(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/rule-suites", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"ref":               "refs/heads/main",
+			"actor_name":        "octocat",
+			"rule_suite_result": "fail",
+			"page":              "2",
+		})
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"actor_id": 2,
+			"actor_name": "octocat",
+			"before_sha": "b",
+			"after_sha": "a",
+			"ref": "refs/heads/main",
+			"repository_id": 3,
+			"repository_name": "r",
+			"pushed_at": "2021-01-02T00:00:00Z",
+			"result": "fail",
+			"evaluation_result": "fail"
+		}]`)
+	})
+
+	opt := &RuleSuiteOptions{
+		Ref:             "refs/heads/main",
+		ActorName:       "octocat",
+		RuleSuiteResult: "fail",
+		ListOptions:     ListOptions{Page: 2},
+	}
+	ctx := context.Background()
+	suites, _, err := client.Repositories.GetRepositoryRuleSuites(ctx, "o", "r", opt)
+	if err != nil {
+		t.Errorf("Repositories.GetRepositoryRuleSuites returned error: %v", err)
+	}
+
+	want := []*RuleSuite{{
+		ID:               Int64(1),
+		ActorID:          Int64(2),
+		ActorName:        String("octocat"),
+		BeforeSHA:        String("b"),
+		AfterSHA:         String("a"),
+		Ref:              String("refs/heads/main"),
+		RepositoryID:     Int64(3),
+		RepositoryName:   String("r"),
+		PushedAt:         &Timestamp{time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		Result:           String("fail"),
+		EvaluationResult: String("fail"),
+	}}
+	if !cmp.Equal(suites, want) {
+		t.Errorf("Repositories.GetRepositoryRuleSuites returned %+v, want %+v", suites, want)
+	}
+
+	const methodName = "GetRepositoryRuleSuites"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetRepositoryRuleSuites(ctx, "\n", "\n", opt)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetRepositoryRuleSuites(ctx, "o", "r", opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetRepositoryRuleSuite(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/rule-suites/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"ref": "refs/heads/main",
+			"result": "bypass",
+			"evaluation_result": "fail",
+			"rule_evaluations": [{
+				"rule_source": {"type": "Repository", "id": 42, "name": "main"},
+				"enforcement": "active",
+				"result": "fail",
+				"rule_type": "pull_request",
+				"details": "d"
+			}]
+		}`)
+	})
+
+	ctx := context.Background()
+	suite, _, err := client.Repositories.GetRepositoryRuleSuite(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.GetRepositoryRuleSuite returned error: %v", err)
+	}
+
+	want := &RuleSuite{
+		ID:               Int64(1),
+		Ref:              String("refs/heads/main"),
+		Result:           String("bypass"),
+		EvaluationResult: String("fail"),
+		RuleEvaluations: []*RuleEvaluation{{
+			RuleSource:  &RuleSource{Type: String("Repository"), ID: Int64(42), Name: String("main")},
+			Enforcement: String("active"),
+			Result:      String("fail"),
+			RuleType:    String("pull_request"),
+			Details:     String("d"),
+		}},
+	}
+	if !cmp.Equal(suite, want) {
+		t.Errorf("Repositories.GetRepositoryRuleSuite returned %+v, want %+v", suite, want)
+	}
+
+	const methodName = "GetRepositoryRuleSuite"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetRepositoryRuleSuite(ctx, "\n", "\n", 1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetRepositoryRuleSuite(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRuleset_Marshal(t *testing.T) {
+	testJSONMarshal(t, &Ruleset{}, "{}")
+
+	r := &Ruleset{
+		ID:          Int64(1),
+		Name:        String("n"),
+		Target:      String("branch"),
+		SourceType:  String("Repository"),
+		Source:      String("o/r"),
+		Enforcement: String("active"),
+		BypassActors: []*BypassActor{
+			{ActorID: Int64(2), ActorType: String("Team"), BypassMode: String("always")},
+		},
+		NodeID: String("nid"),
+		Links: &RulesetLinks{
+			Self: &RulesetLink{HRef: String("s")},
+			HTML: &RulesetLink{HRef: String("h")},
+		},
+		Conditions: &RulesetConditions{
+			RefName: &RulesetRefConditionParameters{
+				Include: []string{"~DEFAULT_BRANCH"},
+				Exclude: []string{},
+			},
+		},
+		Rules:     []*RepositoryRule{{Type: String("creation")}},
+		CreatedAt: &Timestamp{referenceTime},
+		UpdatedAt: &Timestamp{referenceTime},
+	}
+
+	want := `{
+		"id": 1,
+		"name": "n",
+		"target": "branch",
+		"source_type": "Repository",
+		"source": "o/r",
+		"enforcement": "active",
+		"bypass_actors": [{"actor_id": 2, "actor_type": "Team", "bypass_mode": "always"}],
+		"node_id": "nid",
+		"_links": {"self": {"href": "s"}, "html": {"href": "h"}},
+		"conditions": {"ref_name": {"include": ["~DEFAULT_BRANCH"], "exclude": []}},
+		"rules": [{"type": "creation"}],
+		"created_at": ` + referenceTimeStr + `,
+		"updated_at": ` + referenceTimeStr + `
+	}`
+
+	testJSONMarshal(t, r, want)
+}
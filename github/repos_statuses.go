@@ -7,7 +7,10 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -43,6 +46,44 @@ func (r RepoStatus) String() string {
 	return Stringify(r)
 }
 
+// StatusState represents the state of a RepoStatus.
+type StatusState string
+
+// This is the set of commit status states accepted by the GitHub API.
+const (
+	StatusStateError   StatusState = "error"
+	StatusStateFailure StatusState = "failure"
+	StatusStatePending StatusState = "pending"
+	StatusStateSuccess StatusState = "success"
+)
+
+// IsValid reports whether s is one of the commit status states accepted by
+// the GitHub API.
+func (s StatusState) IsValid() bool {
+	switch s {
+	case StatusStateError, StatusStateFailure, StatusStatePending, StatusStateSuccess:
+		return true
+	}
+	return false
+}
+
+// validateRepoStatus checks that status can be sent to CreateStatus.
+func validateRepoStatus(status *RepoStatus) error {
+	if status == nil || !StatusState(status.GetState()).IsValid() {
+		return fmt.Errorf("invalid status state %q: must be one of error, failure, pending or success", status.GetState())
+	}
+	if status.TargetURL != nil {
+		u, err := url.Parse(*status.TargetURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid status target URL %q: must be an absolute http or https URL", *status.TargetURL)
+		}
+	}
+	if status.Context != nil && strings.TrimSpace(*status.Context) == "" {
+		return errors.New("invalid status context: must not be blank")
+	}
+	return nil
+}
+
 // ListStatuses lists the statuses of a repository at the specified
 // reference. ref can be a SHA, a branch name, or a tag name.
 //
@@ -71,8 +112,16 @@ func (s *RepositoriesService) ListStatuses(ctx context.Context, owner, repo, ref
 // CreateStatus creates a new status for a repository at the specified
 // reference. Ref can be a SHA, a branch name, or a tag name.
 //
+// An error is returned without sending a request unless status.State is a
+// valid StatusState, status.TargetURL, if set, is an absolute http or https
+// URL, and status.Context, if set, is not blank.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#create-a-commit-status
 func (s *RepositoriesService) CreateStatus(ctx context.Context, owner, repo, ref string, status *RepoStatus) (*RepoStatus, *Response, error) {
+	if err := validateRepoStatus(status); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/statuses/%v", owner, repo, refURLEscape(ref))
 	req, err := s.client.NewRequest("POST", u, status)
 	if err != nil {
@@ -65,7 +65,7 @@ func TestRepositoriesService_CreateStatus(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &RepoStatus{State: String("s"), TargetURL: String("t"), Description: String("d")}
+	input := &RepoStatus{State: String("success"), TargetURL: String("https://ci.example.com/build/1"), Description: String("d")}
 
 	mux.HandleFunc("/repos/o/r/statuses/r", func(w http.ResponseWriter, r *http.Request) {
 		v := new(RepoStatus)
@@ -109,10 +109,59 @@ func TestRepositoriesService_CreateStatus_invalidOwner(t *testing.T) {
 	defer teardown()
 
 	ctx := context.Background()
-	_, _, err := client.Repositories.CreateStatus(ctx, "%", "r", "r", nil)
+	_, _, err := client.Repositories.CreateStatus(ctx, "%", "r", "r", &RepoStatus{State: String("success")})
 	testURLParseError(t, err)
 }
 
+func TestRepositoriesService_CreateStatus_validStates(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/statuses/r", func(w http.ResponseWriter, r *http.Request) {
+		v := new(RepoStatus)
+		json.NewDecoder(r.Body).Decode(v)
+		fmt.Fprintf(w, `{"id":1,"state":%q}`, v.GetState())
+	})
+
+	ctx := context.Background()
+	for _, state := range []StatusState{StatusStateError, StatusStateFailure, StatusStatePending, StatusStateSuccess} {
+		input := &RepoStatus{State: String(string(state)), Context: String("ci/build")}
+		status, _, err := client.Repositories.CreateStatus(ctx, "o", "r", "r", input)
+		if err != nil {
+			t.Errorf("Repositories.CreateStatus returned error for state %q: %v", state, err)
+			continue
+		}
+		if got := StatusState(status.GetState()); got != state {
+			t.Errorf("Repositories.CreateStatus returned state %q, want %q", got, state)
+		}
+	}
+}
+
+func TestRepositoriesService_CreateStatus_invalid(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/statuses/r", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Repositories.CreateStatus sent a request for an invalid status")
+	})
+
+	tests := []*RepoStatus{
+		nil,
+		{},
+		{State: String("passed")},
+		{State: String("success"), TargetURL: String("ci.example.com/build/1")},
+		{State: String("success"), TargetURL: String("ftp://ci.example.com/build/1")},
+		{State: String("success"), Context: String(" ")},
+	}
+
+	ctx := context.Background()
+	for _, input := range tests {
+		if _, _, err := client.Repositories.CreateStatus(ctx, "o", "r", "r", input); err == nil {
+			t.Errorf("Repositories.CreateStatus(%v) returned no error", input)
+		}
+	}
+}
+
 func TestRepositoriesService_GetCombinedStatus(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
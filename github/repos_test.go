@@ -175,6 +175,69 @@ func TestRepositoriesService_ListByOrg(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListByOrg_internalSortedByPushed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"type":      "internal",
+			"sort":      "pushed",
+			"direction": "desc",
+		})
+		fmt.Fprint(w, `[{"id":1,"visibility":"internal"}]`)
+	})
+
+	ctx := context.Background()
+	opt := &RepositoryListByOrgOptions{
+		Type:      string(RepositoryListTypeInternal),
+		Sort:      string(RepositorySortPushed),
+		Direction: string(SortDirectionDesc),
+	}
+	got, _, err := client.Repositories.ListByOrg(ctx, "o", opt)
+	if err != nil {
+		t.Errorf("Repositories.ListByOrg returned error: %v", err)
+	}
+
+	want := []*Repository{{ID: Int64(1), Visibility: String("internal")}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.ListByOrg returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_ListByOrg_invalidType(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.ListByOrg(ctx, "o", &RepositoryListByOrgOptions{Type: "owner"})
+	if err == nil {
+		t.Error("Repositories.ListByOrg returned nil error, want error")
+	}
+}
+
+func TestRepositoryListByOrgOptions_IsValid(t *testing.T) {
+	for _, v := range []RepositoryListType{RepositoryListTypeAll, RepositoryListTypePublic, RepositoryListTypePrivate, RepositoryListTypeForks, RepositoryListTypeSources, RepositoryListTypeMember, RepositoryListTypeInternal} {
+		if !v.IsValid() {
+			t.Errorf("RepositoryListType(%q).IsValid() = false, want true", v)
+		}
+	}
+	for _, v := range []RepositorySort{RepositorySortCreated, RepositorySortUpdated, RepositorySortPushed, RepositorySortFullName} {
+		if !v.IsValid() {
+			t.Errorf("RepositorySort(%q).IsValid() = false, want true", v)
+		}
+	}
+	for _, v := range []SortDirection{SortDirectionAsc, SortDirectionDesc} {
+		if !v.IsValid() {
+			t.Errorf("SortDirection(%q).IsValid() = false, want true", v)
+		}
+	}
+	if RepositoryListType("owner").IsValid() || RepositorySort("s").IsValid() || SortDirection("d").IsValid() {
+		t.Error("IsValid() = true for an unknown value, want false")
+	}
+}
+
 func TestRepositoriesService_ListByOrg_invalidOrg(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -512,6 +575,59 @@ func TestRepositoriesService_Edit(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_Edit_onlySettableFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Repository{
+		ID:            Int64(1),
+		FullName:      String("o/r"),
+		HTMLURL:       String("https://github.com/o/r"),
+		Name:          String("n"),
+		HasDownloads:  Bool(false),
+		DefaultBranch: String("main"),
+		Archived:      Bool(true),
+	}
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"name":"n","has_downloads":false,"default_branch":"main","archived":true}`+"\n")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.Edit(ctx, "o", "r", input); err != nil {
+		t.Errorf("Repositories.Edit returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_Edit_falseBooleans(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Repository{
+		Private:   Bool(false),
+		HasIssues: Bool(false),
+	}
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"private":false,"has_issues":false}`+"\n")
+		fmt.Fprint(w, `{"id":1,"private":false,"has_issues":false,"has_wiki":true}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.Edit(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.Edit returned error: %v", err)
+	}
+
+	want := &Repository{ID: Int64(1), Private: Bool(false), HasIssues: Bool(false), HasWiki: Bool(true)}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.Edit returned %+v, want %+v", got, want)
+	}
+}
+
 func TestRepositoriesService_Delete(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -679,6 +795,64 @@ func TestRepositoriesService_DisableAutomatedSecurityFixes(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_EnablePrivateReporting(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/private-vulnerability-reporting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Repositories.EnablePrivateReporting(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.EnablePrivateReporting returned error: %v", err)
+	}
+	if got, want := resp.StatusCode, http.StatusNoContent; got != want {
+		t.Errorf("Repositories.EnablePrivateReporting returned status %d, want %d", got, want)
+	}
+
+	const methodName = "EnablePrivateReporting"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.EnablePrivateReporting(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.EnablePrivateReporting(ctx, "o", "r")
+	})
+}
+
+func TestRepositoriesService_DisablePrivateReporting(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/private-vulnerability-reporting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Repositories.DisablePrivateReporting(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.DisablePrivateReporting returned error: %v", err)
+	}
+	if got, want := resp.StatusCode, http.StatusNoContent; got != want {
+		t.Errorf("Repositories.DisablePrivateReporting returned status %d, want %d", got, want)
+	}
+
+	const methodName = "DisablePrivateReporting"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.DisablePrivateReporting(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.DisablePrivateReporting(ctx, "o", "r")
+	})
+}
+
 func TestRepositoriesService_ListContributors(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -719,6 +893,41 @@ func TestRepositoriesService_ListContributors(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListContributors_anonymous(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contributors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"anon": "1"})
+		fmt.Fprint(w, `[
+			{"login":"u","id":1,"type":"User","contributions":42},
+			{"name":"n","email":"e@example.com","type":"Anonymous","contributions":7}
+		]`)
+	})
+
+	opts := &ListContributorsOptions{Anon: "1"}
+	ctx := context.Background()
+	contributors, _, err := client.Repositories.ListContributors(ctx, "o", "r", opts)
+	if err != nil {
+		t.Fatalf("Repositories.ListContributors returned error: %v", err)
+	}
+
+	want := []*Contributor{
+		{Login: String("u"), ID: Int64(1), Type: String("User"), Contributions: Int(42)},
+		{Name: String("n"), Email: String("e@example.com"), Type: String("Anonymous"), Contributions: Int(7)},
+	}
+	if !cmp.Equal(contributors, want) {
+		t.Errorf("Repositories.ListContributors returned %+v, want %+v", contributors, want)
+	}
+
+	if contributors[0].IsAnonymous() {
+		t.Errorf("Contributor %+v IsAnonymous = true, want false", contributors[0])
+	}
+	if !contributors[1].IsAnonymous() {
+		t.Errorf("Contributor %+v IsAnonymous = false, want true", contributors[1])
+	}
+}
 func TestRepositoriesService_ListLanguages(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -1306,7 +1515,7 @@ func TestRepositoriesService_License(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/license", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		fmt.Fprint(w, `{"name": "LICENSE", "path": "LICENSE", "license":{"key":"mit","name":"MIT License","spdx_id":"MIT","url":"https://api.github.com/licenses/mit","featured":true}}`)
+		fmt.Fprint(w, `{"name": "LICENSE", "path": "LICENSE", "license":{"key":"mit","name":"MIT License","spdx_id":"MIT","url":"https://api.github.com/licenses/mit","node_id":"MDc6TGljZW5zZW1pdA==","featured":true}}`)
 	})
 
 	ctx := context.Background()
@@ -1323,6 +1532,7 @@ func TestRepositoriesService_License(t *testing.T) {
 			Key:      String("mit"),
 			SPDXID:   String("MIT"),
 			URL:      String("https://api.github.com/licenses/mit"),
+			NodeID:   String("MDc6TGljZW5zZW1pdA=="),
 			Featured: Bool(true),
 		},
 	}
@@ -1330,6 +1540,9 @@ func TestRepositoriesService_License(t *testing.T) {
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.License returned %+v, want %+v", got, want)
 	}
+	if !got.GetLicense().IsOSIApproved() {
+		t.Errorf("Repositories.License returned a license that is not OSI approved, want approved")
+	}
 
 	const methodName = "License"
 	testBadOptions(t, methodName, func() (err error) {
@@ -1437,6 +1650,70 @@ func TestRepositoriesService_UpdateRequiredStatusChecks(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_UpdateRequiredStatusChecks_checks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &RequiredStatusChecksRequest{
+		Strict: Bool(true),
+		Checks: []*RequiredStatusCheck{
+			{Context: "ci"},
+			{Context: "lint", AppID: Int64(123)},
+		},
+	}
+
+	mux.HandleFunc("/repos/o/r/branches/b/protection/required_status_checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"strict":true,"checks":[{"context":"ci"},{"context":"lint","app_id":123}]}`+"\n")
+		fmt.Fprint(w, `{
+			"strict": true,
+			"contexts": ["ci", "lint"],
+			"checks": [
+				{"context": "ci", "app_id": null},
+				{"context": "lint", "app_id": 123}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	statusChecks, _, err := client.Repositories.UpdateRequiredStatusChecks(ctx, "o", "r", "b", input)
+	if err != nil {
+		t.Errorf("Repositories.UpdateRequiredStatusChecks returned error: %v", err)
+	}
+
+	want := &RequiredStatusChecks{
+		Strict:   true,
+		Contexts: []string{"ci", "lint"},
+		Checks: []*RequiredStatusCheck{
+			{Context: "ci"},
+			{Context: "lint", AppID: Int64(123)},
+		},
+	}
+	if !cmp.Equal(statusChecks, want) {
+		t.Errorf("Repositories.UpdateRequiredStatusChecks returned %+v, want %+v", statusChecks, want)
+	}
+}
+
+func TestRequiredStatusChecks_Marshal(t *testing.T) {
+	testJSONMarshal(t, &RequiredStatusChecks{}, `{"strict":false,"contexts":null}`)
+
+	u := &RequiredStatusChecks{
+		Strict:   true,
+		Contexts: []string{"ci"},
+		Checks: []*RequiredStatusCheck{
+			{Context: "ci", AppID: Int64(-1)},
+		},
+	}
+
+	want := `{
+		"strict": true,
+		"contexts": ["ci"],
+		"checks": [{"context": "ci", "app_id": -1}]
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
 func TestRepositoriesService_RemoveRequiredStatusChecks(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -200,3 +200,41 @@ func TestRepositoriesService_ListTrafficClones(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestTrafficReferrer_Marshal(t *testing.T) {
+	testJSONMarshal(t, &TrafficReferrer{}, "{}")
+
+	u := &TrafficReferrer{
+		Referrer: String("Google"),
+		Count:    Int(4),
+		Uniques:  Int(3),
+	}
+
+	want := `{
+		"referrer": "Google",
+		"count": 4,
+		"uniques": 3
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestTrafficPath_Marshal(t *testing.T) {
+	testJSONMarshal(t, &TrafficPath{}, "{}")
+
+	u := &TrafficPath{
+		Path:    String("/github/hubot"),
+		Title:   String("github/hubot: A customizable life embetterment robot."),
+		Count:   Int(3542),
+		Uniques: Int(2225),
+	}
+
+	want := `{
+		"path": "/github/hubot",
+		"title": "github/hubot: A customizable life embetterment robot.",
+		"count": 3542,
+		"uniques": 2225
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -0,0 +1,303 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"time"
+)
+
+// maxSearchResults is the number of results GitHub returns for a search at
+// most, however many results match the query.
+const maxSearchResults = 1000
+
+// defaultSearchPerPage is the number of results GitHub returns per page of a
+// search when SearchOptions.PerPage is not set.
+const defaultSearchPerPage = 30
+
+// maxSearchRateLimitRetries is the number of times a search iterator retries
+// a page after GitHub reports that a rate limit is exceeded.
+const maxSearchRateLimitRetries = 3
+
+// searchPageFunc fetches a single page of search results, returning its
+// items and whether GitHub reported the results as incomplete.
+type searchPageFunc func(ctx context.Context, opts *SearchOptions) ([]interface{}, bool, *Response, error)
+
+// searchIterator holds the state shared by the search iterators.
+type searchIterator struct {
+	fetch searchPageFunc
+	opts  SearchOptions
+
+	items       []interface{}
+	cur         interface{}
+	seen        int
+	done        bool
+	incomplete  bool
+	pausedUntil time.Time
+	resp        *Response
+	err         error
+}
+
+func newSearchIterator(opts *SearchOptions, fetch searchPageFunc) *searchIterator {
+	it := &searchIterator{fetch: fetch}
+	if opts != nil {
+		it.opts = *opts
+	}
+	// Results on the pages before the first one count towards
+	// maxSearchResults too.
+	if it.opts.Page > 1 {
+		perPage := it.opts.PerPage
+		if perPage <= 0 {
+			perPage = defaultSearchPerPage
+		}
+		it.seen = (it.opts.Page - 1) * perPage
+		it.done = it.seen >= maxSearchResults
+	}
+	return it
+}
+
+// next advances the iterator to the next item, fetching the next page of
+// results when the current one is exhausted.
+func (it *searchIterator) next(ctx context.Context) bool {
+	it.cur = nil
+	for len(it.items) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+		if err := it.fetchPage(ctx); err != nil {
+			it.err = err
+			return false
+		}
+	}
+
+	it.cur, it.items = it.items[0], it.items[1:]
+	it.seen++
+	return true
+}
+
+// fetchPage fetches the next page of results, waiting out the search rate
+// limit when it is exhausted.
+func (it *searchIterator) fetchPage(ctx context.Context) error {
+	for attempt := 0; ; attempt++ {
+		if err := sleepUntil(ctx, it.pausedUntil); err != nil {
+			return err
+		}
+
+		items, incomplete, resp, err := it.fetch(ctx, &it.opts)
+		it.resp = resp
+		if err == nil {
+			it.handlePage(items, incomplete, resp)
+			return nil
+		}
+
+		var resume time.Time
+		switch e := err.(type) {
+		case *RateLimitError:
+			resume = e.Rate.Reset.Time
+		case *AbuseRateLimitError:
+			if e.RetryAfter != nil {
+				resume = time.Now().Add(*e.RetryAfter)
+			}
+		default:
+			return err
+		}
+		if attempt == maxSearchRateLimitRetries {
+			return err
+		}
+		if resume.IsZero() {
+			resume = time.Now().Add(defaultRateLimitBackoff)
+		}
+		it.pausedUntil = resume
+	}
+}
+
+// handlePage records a page of results, truncating it at maxSearchResults,
+// and prepares the options for the following page.
+func (it *searchIterator) handlePage(items []interface{}, incomplete bool, resp *Response) {
+	if incomplete {
+		it.incomplete = true
+	}
+	if remaining := maxSearchResults - it.seen; len(items) >= remaining {
+		items = items[:remaining]
+		it.done = true
+	}
+	it.items = items
+
+	if resp.NextPage == 0 || len(items) == 0 {
+		it.done = true
+	}
+	it.opts.Page = resp.NextPage
+
+	// Pause before the next page rather than have the client reject it.
+	if resp.Rate.Remaining == 0 && !resp.Rate.Reset.Time.IsZero() {
+		it.pausedUntil = resp.Rate.Reset.Time
+	}
+}
+
+// searchIteratorState exposes the state shared by the search iterators.
+type searchIteratorState struct {
+	it *searchIterator
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (s searchIteratorState) Err() error { return s.it.err }
+
+// IncompleteResults reports whether GitHub marked any page of results as
+// incomplete, because the search timed out before finding every match.
+func (s searchIteratorState) IncompleteResults() bool { return s.it.incomplete }
+
+// Response returns the response to the last page requested, if any.
+func (s searchIteratorState) Response() *Response { return s.it.resp }
+
+// IssuesSearchIterator iterates over the results of an issues search.
+type IssuesSearchIterator struct {
+	searchIteratorState
+}
+
+// IterateIssues returns an iterator over the issues matching query. See
+// Issues for the format of query and opts.
+//
+// The iterator requests pages of results as needed, starting at opts.Page,
+// and stops after the 1000 results that GitHub returns for a search at most,
+// counting the results on the pages before opts.Page.
+// When the search rate limit is exceeded, it waits until the limit resets
+// before requesting the next page, retrying up to a few times.
+//
+// Iterate over the results with:
+//
+//	it := client.Search.IterateIssues(query, opts)
+//	for it.Next(ctx) {
+//		issue := it.Issue()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+func (s *SearchService) IterateIssues(query string, opts *SearchOptions) *IssuesSearchIterator {
+	it := newSearchIterator(opts, func(ctx context.Context, opts *SearchOptions) ([]interface{}, bool, *Response, error) {
+		result, resp, err := s.Issues(ctx, query, opts)
+		if err != nil {
+			return nil, false, resp, err
+		}
+		items := make([]interface{}, len(result.Issues))
+		for i, v := range result.Issues {
+			items[i] = v
+		}
+		return items, result.GetIncompleteResults(), resp, nil
+	})
+	return &IssuesSearchIterator{searchIteratorState{it}}
+}
+
+// Next advances the iterator to the next issue, reporting whether there is
+// one. It returns false when the results are exhausted or an error occurs.
+func (it *IssuesSearchIterator) Next(ctx context.Context) bool { return it.it.next(ctx) }
+
+// Issue returns the current issue.
+func (it *IssuesSearchIterator) Issue() *Issue {
+	v, _ := it.it.cur.(*Issue)
+	return v
+}
+
+// CodeSearchIterator iterates over the results of a code search.
+type CodeSearchIterator struct {
+	searchIteratorState
+}
+
+// IterateCode returns an iterator over the code matching query. See Code for
+// the format of query and opts, and IterateIssues for how the iterator
+// paginates.
+func (s *SearchService) IterateCode(query string, opts *SearchOptions) *CodeSearchIterator {
+	it := newSearchIterator(opts, func(ctx context.Context, opts *SearchOptions) ([]interface{}, bool, *Response, error) {
+		result, resp, err := s.Code(ctx, query, opts)
+		if err != nil {
+			return nil, false, resp, err
+		}
+		items := make([]interface{}, len(result.CodeResults))
+		for i, v := range result.CodeResults {
+			items[i] = v
+		}
+		return items, result.GetIncompleteResults(), resp, nil
+	})
+	return &CodeSearchIterator{searchIteratorState{it}}
+}
+
+// Next advances the iterator to the next code result, reporting whether
+// there is one. It returns false when the results are exhausted or an error
+// occurs.
+func (it *CodeSearchIterator) Next(ctx context.Context) bool { return it.it.next(ctx) }
+
+// CodeResult returns the current code result.
+func (it *CodeSearchIterator) CodeResult() *CodeResult {
+	v, _ := it.it.cur.(*CodeResult)
+	return v
+}
+
+// RepositoriesSearchIterator iterates over the results of a repositories
+// search.
+type RepositoriesSearchIterator struct {
+	searchIteratorState
+}
+
+// IterateRepositories returns an iterator over the repositories matching
+// query. See Repositories for the format of query and opts, and
+// IterateIssues for how the iterator paginates.
+func (s *SearchService) IterateRepositories(query string, opts *SearchOptions) *RepositoriesSearchIterator {
+	it := newSearchIterator(opts, func(ctx context.Context, opts *SearchOptions) ([]interface{}, bool, *Response, error) {
+		result, resp, err := s.Repositories(ctx, query, opts)
+		if err != nil {
+			return nil, false, resp, err
+		}
+		items := make([]interface{}, len(result.Repositories))
+		for i, v := range result.Repositories {
+			items[i] = v
+		}
+		return items, result.GetIncompleteResults(), resp, nil
+	})
+	return &RepositoriesSearchIterator{searchIteratorState{it}}
+}
+
+// Next advances the iterator to the next repository, reporting whether there
+// is one. It returns false when the results are exhausted or an error occurs.
+func (it *RepositoriesSearchIterator) Next(ctx context.Context) bool { return it.it.next(ctx) }
+
+// Repository returns the current repository.
+func (it *RepositoriesSearchIterator) Repository() *Repository {
+	v, _ := it.it.cur.(*Repository)
+	return v
+}
+
+// UsersSearchIterator iterates over the results of a users search.
+type UsersSearchIterator struct {
+	searchIteratorState
+}
+
+// IterateUsers returns an iterator over the users matching query. See Users
+// for the format of query and opts, and IterateIssues for how the iterator
+// paginates.
+func (s *SearchService) IterateUsers(query string, opts *SearchOptions) *UsersSearchIterator {
+	it := newSearchIterator(opts, func(ctx context.Context, opts *SearchOptions) ([]interface{}, bool, *Response, error) {
+		result, resp, err := s.Users(ctx, query, opts)
+		if err != nil {
+			return nil, false, resp, err
+		}
+		items := make([]interface{}, len(result.Users))
+		for i, v := range result.Users {
+			items[i] = v
+		}
+		return items, result.GetIncompleteResults(), resp, nil
+	})
+	return &UsersSearchIterator{searchIteratorState{it}}
+}
+
+// Next advances the iterator to the next user, reporting whether there is
+// one. It returns false when the results are exhausted or an error occurs.
+func (it *UsersSearchIterator) Next(ctx context.Context) bool { return it.it.next(ctx) }
+
+// User returns the current user.
+func (it *UsersSearchIterator) User() *User {
+	v, _ := it.it.cur.(*User)
+	return v
+}
@@ -0,0 +1,249 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// searchPage returns a page of n issues numbered from first, as returned by
+// the search API.
+func searchPage(first, n int, incomplete bool) string {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = fmt.Sprintf(`{"number":%d}`, first+i)
+	}
+	return fmt.Sprintf(`{"total_count":5000,"incomplete_results":%v,"items":[%v]}`, incomplete, strings.Join(items, ","))
+}
+
+func TestSearchService_IterateIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "", "1":
+			testFormValues(t, r, values{"q": "blah", "per_page": "2"})
+			w.Header().Set("Link", `<https://api.github.com/search/issues?q=blah&per_page=2&page=2>; rel="next"`)
+			fmt.Fprint(w, searchPage(1, 2, false))
+		case "2":
+			fmt.Fprint(w, searchPage(3, 1, false))
+		default:
+			t.Errorf("unexpected page %v", page)
+		}
+	})
+
+	ctx := context.Background()
+	it := client.Search.IterateIssues("blah", &SearchOptions{ListOptions: ListOptions{PerPage: 2}})
+	var numbers []int
+	for it.Next(ctx) {
+		numbers = append(numbers, it.Issue().GetNumber())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("IssuesSearchIterator returned error: %v", err)
+	}
+
+	if got, want := fmt.Sprint(numbers), "[1 2 3]"; got != want {
+		t.Errorf("IssuesSearchIterator returned issues %v, want %v", got, want)
+	}
+	if it.IncompleteResults() {
+		t.Error("IssuesSearchIterator.IncompleteResults returned true, want false")
+	}
+}
+
+func TestSearchService_IterateIssues_resultCap(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page, _ := strconv.Atoi(r.FormValue("page"))
+		if page == 0 {
+			page = 1
+		}
+		// Keep claiming there are more pages, and return more results on the
+		// last page than the cap allows.
+		w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/search/issues?q=blah&page=%d>; rel="next"`, page+1))
+		fmt.Fprint(w, searchPage((page-1)*300+1, 300, false))
+	})
+
+	ctx := context.Background()
+	it := client.Search.IterateIssues("blah", nil)
+	var n, last int
+	for it.Next(ctx) {
+		n++
+		last = it.Issue().GetNumber()
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("IssuesSearchIterator returned error: %v", err)
+	}
+
+	if n != maxSearchResults {
+		t.Errorf("IssuesSearchIterator returned %v issues, want %v", n, maxSearchResults)
+	}
+	if last != maxSearchResults {
+		t.Errorf("IssuesSearchIterator returned last issue %v, want %v", last, maxSearchResults)
+	}
+	if requests != 4 {
+		t.Errorf("IssuesSearchIterator made %v requests, want 4", requests)
+	}
+}
+
+func TestSearchService_IterateIssues_resultCapFromPage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"q": "blah", "page": "10", "per_page": "100"})
+		w.Header().Set("Link", `<https://api.github.com/search/issues?q=blah&page=11>; rel="next"`)
+		fmt.Fprint(w, searchPage(901, 100, false))
+	})
+
+	ctx := context.Background()
+	it := client.Search.IterateIssues("blah", &SearchOptions{ListOptions: ListOptions{Page: 10, PerPage: 100}})
+	var n int
+	for it.Next(ctx) {
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("IssuesSearchIterator returned error: %v", err)
+	}
+	if n != 100 {
+		t.Errorf("IssuesSearchIterator returned %v issues, want 100", n)
+	}
+
+	it = client.Search.IterateIssues("blah", &SearchOptions{ListOptions: ListOptions{Page: 11, PerPage: 100}})
+	if it.Next(ctx) {
+		t.Errorf("IssuesSearchIterator returned issue %v past the result cap", it.Issue().GetNumber())
+	}
+}
+
+func TestSearchService_IterateIssues_incompleteResults(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/search/issues?q=blah&page=2>; rel="next"`)
+			fmt.Fprint(w, searchPage(1, 1, true))
+		default:
+			fmt.Fprint(w, searchPage(2, 1, false))
+		}
+	})
+
+	ctx := context.Background()
+	it := client.Search.IterateIssues("blah", nil)
+	var n int
+	for it.Next(ctx) {
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("IssuesSearchIterator returned error: %v", err)
+	}
+
+	if n != 2 {
+		t.Errorf("IssuesSearchIterator returned %v issues, want 2", n)
+	}
+	if !it.IncompleteResults() {
+		t.Error("IssuesSearchIterator.IncompleteResults returned false, want true")
+	}
+}
+
+func TestSearchService_IterateIssues_rateLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set(headerRateLimit, "30")
+			w.Header().Set(headerRateRemaining, "0")
+			w.Header().Set(headerRateReset, fmt.Sprint(time.Now().Unix()))
+			w.Header().Set(headerRateResource, "search")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"API rate limit exceeded for xxx.xxx.xxx.xxx."}`)
+			return
+		}
+		fmt.Fprint(w, searchPage(1, 1, false))
+	})
+
+	ctx := context.Background()
+	it := client.Search.IterateIssues("blah", nil)
+	var n int
+	for it.Next(ctx) {
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("IssuesSearchIterator returned error: %v", err)
+	}
+
+	if n != 1 {
+		t.Errorf("IssuesSearchIterator returned %v issues, want 1", n)
+	}
+	if calls != 2 {
+		t.Errorf("IssuesSearchIterator made %v requests, want 2", calls)
+	}
+}
+
+func TestSearchService_IterateIssues_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	})
+
+	ctx := context.Background()
+	it := client.Search.IterateIssues("blah", nil)
+	if it.Next(ctx) {
+		t.Error("IssuesSearchIterator.Next returned true, want false")
+	}
+	if _, ok := it.Err().(*ErrorResponse); !ok {
+		t.Errorf("IssuesSearchIterator.Err returned %v, want *ErrorResponse", it.Err())
+	}
+	if it.Response() == nil {
+		t.Error("IssuesSearchIterator.Response returned nil")
+	}
+}
+
+func TestSearchService_IterateOtherTypes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/code", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items":[{"name":"n"}]}`)
+	})
+	mux.HandleFunc("/search/repositories", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items":[{"id":1}]}`)
+	})
+	mux.HandleFunc("/search/users", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items":[{"login":"l"}]}`)
+	})
+
+	ctx := context.Background()
+	code := client.Search.IterateCode("blah", nil)
+	if !code.Next(ctx) || code.CodeResult().GetName() != "n" || code.Next(ctx) {
+		t.Errorf("CodeSearchIterator did not return the single code result, err: %v", code.Err())
+	}
+	repos := client.Search.IterateRepositories("blah", nil)
+	if !repos.Next(ctx) || repos.Repository().GetID() != 1 || repos.Next(ctx) {
+		t.Errorf("RepositoriesSearchIterator did not return the single repository, err: %v", repos.Err())
+	}
+	users := client.Search.IterateUsers("blah", nil)
+	if !users.Next(ctx) || users.User().GetLogin() != "l" || users.Next(ctx) {
+		t.Errorf("UsersSearchIterator did not return the single user, err: %v", users.Err())
+	}
+}
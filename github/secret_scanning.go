@@ -0,0 +1,106 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// SecretScanningService handles communication with the secret scanning
+// related methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning
+type SecretScanningService service
+
+// SecretScanningAlert represents a GitHub secret scanning alert.
+type SecretScanningAlert struct {
+	Number  *int    `json:"number,omitempty"`
+	URL     *string `json:"url,omitempty"`
+	HTMLURL *string `json:"html_url,omitempty"`
+	// Possible values for State are: open, resolved.
+	State *string `json:"state,omitempty"`
+	// Possible values for Resolution are: false_positive, wont_fix, revoked,
+	// used_in_tests.
+	Resolution            *string    `json:"resolution,omitempty"`
+	ResolutionComment     *string    `json:"resolution_comment,omitempty"`
+	ResolvedAt            *Timestamp `json:"resolved_at,omitempty"`
+	ResolvedBy            *User      `json:"resolved_by,omitempty"`
+	SecretType            *string    `json:"secret_type,omitempty"`
+	SecretTypeDisplayName *string    `json:"secret_type_display_name,omitempty"`
+	Secret                *string    `json:"secret,omitempty"`
+	CreatedAt             *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt             *Timestamp `json:"updated_at,omitempty"`
+}
+
+// SecretScanningAlertUpdateOptions specifies the state of a secret scanning
+// alert to update.
+type SecretScanningAlertUpdateOptions struct {
+	// State is the state of the alert. Possible values are: open, resolved.
+	State string `json:"state"`
+
+	// Resolution is the reason for resolving the alert. It is required when
+	// State is resolved. Possible values are: false_positive, wont_fix,
+	// revoked, used_in_tests.
+	Resolution *string `json:"resolution,omitempty"`
+
+	// ResolutionComment is an optional comment on resolving the alert.
+	ResolutionComment *string `json:"resolution_comment,omitempty"`
+}
+
+// validate checks that opts describes a state GitHub accepts.
+func (opts *SecretScanningAlertUpdateOptions) validate() error {
+	if opts == nil {
+		return errors.New("alert update options must be provided")
+	}
+	return validateAlertState(opts.State, opts.GetResolution(), "open", "resolved",
+		"false_positive", "wont_fix", "revoked", "used_in_tests")
+}
+
+// GetAlert gets a single secret scanning alert of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning#get-a-secret-scanning-alert
+func (s *SecretScanningService) GetAlert(ctx context.Context, owner, repo string, number int64) (*SecretScanningAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v", owner, repo, number)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(SecretScanningAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, nil
+}
+
+// UpdateAlert updates the state of a secret scanning alert of a repository,
+// to resolve it with a resolution or to reopen it.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning#update-a-secret-scanning-alert
+func (s *SecretScanningService) UpdateAlert(ctx context.Context, owner, repo string, number int64, opts *SecretScanningAlertUpdateOptions) (*SecretScanningAlert, *Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v", owner, repo, number)
+	req, err := s.client.NewRequest("PATCH", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(SecretScanningAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSecretScanningService_GetAlert(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":1,"state":"open","secret_type":"mailchimp_api_key","created_at":"2021-01-02T00:00:00Z"}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.SecretScanning.GetAlert(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("SecretScanning.GetAlert returned error: %v", err)
+	}
+
+	want := &SecretScanningAlert{
+		Number:     Int(1),
+		State:      String("open"),
+		SecretType: String("mailchimp_api_key"),
+		CreatedAt:  &Timestamp{time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC)},
+	}
+	if !cmp.Equal(alert, want) {
+		t.Errorf("SecretScanning.GetAlert returned %+v, want %+v", alert, want)
+	}
+
+	const methodName = "GetAlert"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.GetAlert(ctx, "\n", "\n", 1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.GetAlert(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_UpdateAlert_resolve(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	opts := &SecretScanningAlertUpdateOptions{State: "resolved", Resolution: String("revoked")}
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"resolved","resolution":"revoked"}`+"\n")
+		fmt.Fprint(w, `{"number":1,"state":"resolved","resolution":"revoked","resolved_by":{"login":"l"}}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.SecretScanning.UpdateAlert(ctx, "o", "r", 1, opts)
+	if err != nil {
+		t.Errorf("SecretScanning.UpdateAlert returned error: %v", err)
+	}
+
+	want := &SecretScanningAlert{
+		Number:     Int(1),
+		State:      String("resolved"),
+		Resolution: String("revoked"),
+		ResolvedBy: &User{Login: String("l")},
+	}
+	if !cmp.Equal(alert, want) {
+		t.Errorf("SecretScanning.UpdateAlert returned %+v, want %+v", alert, want)
+	}
+
+	const methodName = "UpdateAlert"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.UpdateAlert(ctx, "\n", "\n", 1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.UpdateAlert(ctx, "o", "r", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_UpdateAlert_reopen(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"open"}`+"\n")
+		fmt.Fprint(w, `{"number":1,"state":"open"}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.SecretScanning.UpdateAlert(ctx, "o", "r", 1, &SecretScanningAlertUpdateOptions{State: "open"})
+	if err != nil {
+		t.Errorf("SecretScanning.UpdateAlert returned error: %v", err)
+	}
+	if want := (&SecretScanningAlert{Number: Int(1), State: String("open")}); !cmp.Equal(alert, want) {
+		t.Errorf("SecretScanning.UpdateAlert returned %+v, want %+v", alert, want)
+	}
+}
+
+func TestSecretScanningService_UpdateAlert_invalid(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts/1", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("SecretScanning.UpdateAlert sent a request for an invalid state")
+	})
+
+	tests := []*SecretScanningAlertUpdateOptions{
+		nil,
+		{State: "dismissed"},
+		{State: "resolved"},
+		{State: "resolved", Resolution: String("false positive")},
+	}
+
+	ctx := context.Background()
+	for _, opts := range tests {
+		if _, _, err := client.SecretScanning.UpdateAlert(ctx, "o", "r", 1, opts); err == nil {
+			t.Errorf("SecretScanning.UpdateAlert(%+v) returned no error", opts)
+		}
+	}
+}
@@ -0,0 +1,350 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SecurityAdvisoriesService provides access to the security advisory related
+// functions in the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories
+type SecurityAdvisoriesService service
+
+// AdvisoryCVSS represents the CVSS score and vector of a security advisory.
+type AdvisoryCVSS struct {
+	Score        *float64 `json:"score,omitempty"`
+	VectorString *string  `json:"vector_string,omitempty"`
+}
+
+// AdvisoryCWEs represents a Common Weakness Enumeration entry of a security advisory.
+type AdvisoryCWEs struct {
+	CWEID *string `json:"cwe_id,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// AdvisoryIdentifier represents an identifier of a security advisory.
+type AdvisoryIdentifier struct {
+	// Possible values for Type are: CVE, GHSA.
+	Type  *string `json:"type,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// AdvisoryReference represents a reference URL of a security advisory.
+type AdvisoryReference struct {
+	URL *string `json:"url,omitempty"`
+}
+
+// VulnerabilityPackage represents the package affected by a vulnerability.
+type VulnerabilityPackage struct {
+	Ecosystem *string `json:"ecosystem,omitempty"`
+	Name      *string `json:"name,omitempty"`
+}
+
+// GlobalSecurityVulnerability represents a vulnerability of a package
+// described by a GlobalSecurityAdvisory.
+type GlobalSecurityVulnerability struct {
+	Package                *VulnerabilityPackage `json:"package,omitempty"`
+	VulnerableVersionRange *string               `json:"vulnerable_version_range,omitempty"`
+	FirstPatchedVersion    *string               `json:"first_patched_version,omitempty"`
+	VulnerableFunctions    []string              `json:"vulnerable_functions,omitempty"`
+}
+
+// GlobalSecurityAdvisory represents an advisory in the GitHub Advisory Database.
+type GlobalSecurityAdvisory struct {
+	GHSAID                *string `json:"ghsa_id,omitempty"`
+	CVEID                 *string `json:"cve_id,omitempty"`
+	URL                   *string `json:"url,omitempty"`
+	HTMLURL               *string `json:"html_url,omitempty"`
+	RepositoryAdvisoryURL *string `json:"repository_advisory_url,omitempty"`
+	Summary               *string `json:"summary,omitempty"`
+	Description           *string `json:"description,omitempty"`
+	// Possible values for Type are: reviewed, unreviewed, malware.
+	Type *string `json:"type,omitempty"`
+	// Possible values for Severity are: critical, high, medium, low, unknown.
+	Severity           *string                        `json:"severity,omitempty"`
+	SourceCodeLocation *string                        `json:"source_code_location,omitempty"`
+	Identifiers        []*AdvisoryIdentifier          `json:"identifiers,omitempty"`
+	References         []string                       `json:"references,omitempty"`
+	PublishedAt        *Timestamp                     `json:"published_at,omitempty"`
+	UpdatedAt          *Timestamp                     `json:"updated_at,omitempty"`
+	GitHubReviewedAt   *Timestamp                     `json:"github_reviewed_at,omitempty"`
+	NVDPublishedAt     *Timestamp                     `json:"nvd_published_at,omitempty"`
+	WithdrawnAt        *Timestamp                     `json:"withdrawn_at,omitempty"`
+	Vulnerabilities    []*GlobalSecurityVulnerability `json:"vulnerabilities,omitempty"`
+	CVSS               *AdvisoryCVSS                  `json:"cvss,omitempty"`
+	CWEs               []*AdvisoryCWEs                `json:"cwes,omitempty"`
+}
+
+// ListGlobalSecurityAdvisoriesOptions specifies the optional parameters to
+// the SecurityAdvisoriesService.ListGlobalSecurityAdvisories method.
+type ListGlobalSecurityAdvisoriesOptions struct {
+	// GHSAID filters advisories by GitHub Security Advisory identifier.
+	GHSAID string `url:"ghsa_id,omitempty"`
+
+	// Type filters advisories by type. Possible values are: reviewed,
+	// unreviewed, malware. Default is "reviewed".
+	Type string `url:"type,omitempty"`
+
+	// CVEID filters advisories by CVE identifier.
+	CVEID string `url:"cve_id,omitempty"`
+
+	// Ecosystem filters advisories by the ecosystem of the affected packages,
+	// e.g. "npm", "go", "pip".
+	Ecosystem string `url:"ecosystem,omitempty"`
+
+	// Severity filters advisories by severity. Possible values are: unknown,
+	// low, medium, high, critical.
+	Severity string `url:"severity,omitempty"`
+
+	// Published and Updated filter advisories by date, using GitHub's date
+	// range syntax, e.g. ">=2021-01-01" or "2021-01-01..2021-06-30".
+	Published string `url:"published,omitempty"`
+	Updated   string `url:"updated,omitempty"`
+
+	ListCursorOptions
+}
+
+// ListGlobalSecurityAdvisories lists the advisories in the GitHub Advisory
+// Database. Results are paginated with cursors; set opts.After to
+// Response.After to fetch the next page.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories#list-global-security-advisories
+func (s *SecurityAdvisoriesService) ListGlobalSecurityAdvisories(ctx context.Context, opts *ListGlobalSecurityAdvisoriesOptions) ([]*GlobalSecurityAdvisory, *Response, error) {
+	u, err := addOptions("advisories", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var advisories []*GlobalSecurityAdvisory
+	resp, err := s.client.Do(ctx, req, &advisories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisories, resp, nil
+}
+
+// AdvisoryVulnerability represents a vulnerability of a package described by
+// a repository SecurityAdvisory.
+type AdvisoryVulnerability struct {
+	Package                *VulnerabilityPackage `json:"package,omitempty"`
+	VulnerableVersionRange *string               `json:"vulnerable_version_range,omitempty"`
+	PatchedVersions        *string               `json:"patched_versions,omitempty"`
+	VulnerableFunctions    []string              `json:"vulnerable_functions,omitempty"`
+}
+
+// SecurityAdvisory represents a repository security advisory.
+type SecurityAdvisory struct {
+	GHSAID      *string `json:"ghsa_id,omitempty"`
+	CVEID       *string `json:"cve_id,omitempty"`
+	URL         *string `json:"url,omitempty"`
+	HTMLURL     *string `json:"html_url,omitempty"`
+	Summary     *string `json:"summary,omitempty"`
+	Description *string `json:"description,omitempty"`
+	// Possible values for Severity are: critical, high, medium, low.
+	Severity *string `json:"severity,omitempty"`
+	// Possible values for State are: published, closed, withdrawn, draft, triage.
+	State           *string                  `json:"state,omitempty"`
+	Author          *User                    `json:"author,omitempty"`
+	Publisher       *User                    `json:"publisher,omitempty"`
+	Identifiers     []*AdvisoryIdentifier    `json:"identifiers,omitempty"`
+	CreatedAt       *Timestamp               `json:"created_at,omitempty"`
+	UpdatedAt       *Timestamp               `json:"updated_at,omitempty"`
+	PublishedAt     *Timestamp               `json:"published_at,omitempty"`
+	ClosedAt        *Timestamp               `json:"closed_at,omitempty"`
+	WithdrawnAt     *Timestamp               `json:"withdrawn_at,omitempty"`
+	Vulnerabilities []*AdvisoryVulnerability `json:"vulnerabilities,omitempty"`
+	CVSS            *AdvisoryCVSS            `json:"cvss,omitempty"`
+	CWEs            []*AdvisoryCWEs          `json:"cwes,omitempty"`
+	CWEIDs          []string                 `json:"cwe_ids,omitempty"`
+}
+
+// SecurityAdvisoryRequest represents a request to create or update a
+// repository security advisory.
+type SecurityAdvisoryRequest struct {
+	Summary     *string `json:"summary,omitempty"`
+	Description *string `json:"description,omitempty"`
+	CVEID       *string `json:"cve_id,omitempty"`
+	// Severity and CVSSVectorString are mutually exclusive.
+	Severity         *string                  `json:"severity,omitempty"`
+	CVSSVectorString *string                  `json:"cvss_vector_string,omitempty"`
+	Vulnerabilities  []*AdvisoryVulnerability `json:"vulnerabilities,omitempty"`
+	CWEIDs           []string                 `json:"cwe_ids,omitempty"`
+	// State is only used by UpdateRepositorySecurityAdvisory.
+	// Possible values are: published, closed, draft.
+	State *string `json:"state,omitempty"`
+}
+
+// ListRepositorySecurityAdvisoriesOptions specifies the optional parameters
+// to the SecurityAdvisoriesService.ListRepositorySecurityAdvisories method.
+type ListRepositorySecurityAdvisoriesOptions struct {
+	// Direction in which to sort advisories. Possible values are: asc, desc.
+	// Default is "desc".
+	Direction string `url:"direction,omitempty"`
+
+	// Sort specifies how to sort advisories. Possible values are: created,
+	// updated, published. Default is "created".
+	Sort string `url:"sort,omitempty"`
+
+	// State filters advisories by state. Possible values are: triage, draft,
+	// published, closed.
+	State string `url:"state,omitempty"`
+
+	ListCursorOptions
+}
+
+// ListRepositorySecurityAdvisories lists the security advisories of a
+// repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories#list-repository-security-advisories
+func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisories(ctx context.Context, owner, repo string, opts *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var advisories []*SecurityAdvisory
+	resp, err := s.client.Do(ctx, req, &advisories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisories, resp, nil
+}
+
+// GetRepositorySecurityAdvisory gets a single repository security advisory
+// by its GHSA identifier.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories#get-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) GetRepositorySecurityAdvisory(ctx context.Context, owner, repo, ghsaID string) (*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	advisory := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, advisory)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisory, resp, nil
+}
+
+// CreateRepositorySecurityAdvisory creates a new draft security advisory for
+// a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories#create-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) CreateRepositorySecurityAdvisory(ctx context.Context, owner, repo string, advisory *SecurityAdvisoryRequest) (*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories", owner, repo)
+
+	req, err := s.client.NewRequest("POST", u, advisory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// UpdateRepositorySecurityAdvisory updates a repository security advisory.
+// Setting State to "published" publishes the advisory.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories#update-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) UpdateRepositorySecurityAdvisory(ctx context.Context, owner, repo, ghsaID string, advisory *SecurityAdvisoryRequest) (*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("PATCH", u, advisory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// RequestCVE requests a CVE identifier from GitHub for a repository security
+// advisory. GitHub responds with 202 Accepted, which is not reported as an
+// error by this method.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories#request-a-cve-for-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) RequestCVE(ctx context.Context, owner, repo, ghsaID string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v/cve", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		if _, ok := err.(*AcceptedError); ok {
+			return resp, nil
+		}
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// CreateTemporaryPrivateFork creates a temporary private fork of a repository
+// in which to collaborate on a fix for a repository security advisory.
+//
+// The fork is created asynchronously, so this method usually returns an
+// *AcceptedError along with the partially populated Repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories#create-a-temporary-private-fork
+func (s *SecurityAdvisoriesService) CreateTemporaryPrivateFork(ctx context.Context, owner, repo, ghsaID string) (*Repository, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v/forks", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fork := new(Repository)
+	resp, err := s.client.Do(ctx, req, fork)
+	if err != nil {
+		// Persist AcceptedError's metadata to the Repository object.
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, fork); err != nil {
+				return fork, resp, err
+			}
+
+			return fork, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return fork, resp, nil
+}
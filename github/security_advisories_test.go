@@ -0,0 +1,359 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSecurityAdvisoriesService_ListGlobalSecurityAdvisories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"ecosystem": "go",
+			"severity":  "high",
+			"per_page":  "2",
+			"after":     "c1",
+		})
+		w.Header().Set("Link", `<https://api.github.com/advisories?after=c2&per_page=2>; rel="next"`)
+		fmt.Fprint(w, `[{
+			"ghsa_id": "GHSA-xxxx-xxxx-xxxx",
+			"cve_id": "CVE-2021-0001",
+			"summary": "s",
+			"type": "reviewed",
+			"severity": "high",
+			"identifiers": [{"type": "GHSA", "value": "GHSA-xxxx-xxxx-xxxx"}, {"type": "CVE", "value": "CVE-2021-0001"}],
+			"published_at": "2021-01-02T00:00:00Z",
+			"vulnerabilities": [{
+				"package": {"ecosystem": "go", "name": "example.com/m"},
+				"vulnerable_version_range": "< 1.2.3",
+				"first_patched_version": "1.2.3",
+				"vulnerable_functions": ["m.F"]
+			}],
+			"cvss": {"score": 7.5, "vector_string": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H"},
+			"cwes": [{"cwe_id": "CWE-400", "name": "Uncontrolled Resource Consumption"}]
+		}]`)
+	})
+
+	opts := &ListGlobalSecurityAdvisoriesOptions{
+		Ecosystem:         "go",
+		Severity:          "high",
+		ListCursorOptions: ListCursorOptions{PerPage: 2, After: "c1"},
+	}
+	ctx := context.Background()
+	advisories, resp, err := client.SecurityAdvisories.ListGlobalSecurityAdvisories(ctx, opts)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListGlobalSecurityAdvisories returned error: %v", err)
+	}
+
+	want := []*GlobalSecurityAdvisory{{
+		GHSAID:   String("GHSA-xxxx-xxxx-xxxx"),
+		CVEID:    String("CVE-2021-0001"),
+		Summary:  String("s"),
+		Type:     String("reviewed"),
+		Severity: String("high"),
+		Identifiers: []*AdvisoryIdentifier{
+			{Type: String("GHSA"), Value: String("GHSA-xxxx-xxxx-xxxx")},
+			{Type: String("CVE"), Value: String("CVE-2021-0001")},
+		},
+		PublishedAt: &Timestamp{time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		Vulnerabilities: []*GlobalSecurityVulnerability{{
+			Package:                &VulnerabilityPackage{Ecosystem: String("go"), Name: String("example.com/m")},
+			VulnerableVersionRange: String("< 1.2.3"),
+			FirstPatchedVersion:    String("1.2.3"),
+			VulnerableFunctions:    []string{"m.F"},
+		}},
+		CVSS: &AdvisoryCVSS{Score: Float64(7.5), VectorString: String("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H")},
+		CWEs: []*AdvisoryCWEs{{CWEID: String("CWE-400"), Name: String("Uncontrolled Resource Consumption")}},
+	}}
+	if !cmp.Equal(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListGlobalSecurityAdvisories returned %+v, want %+v", advisories, want)
+	}
+	if got, want := resp.After, "c2"; got != want {
+		t.Errorf("SecurityAdvisories.ListGlobalSecurityAdvisories returned resp.After %q, want %q", got, want)
+	}
+
+	const methodName = "ListGlobalSecurityAdvisories"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.ListGlobalSecurityAdvisories(ctx, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_ListGlobalSecurityAdvisories_cveID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"cve_id": "CVE-2021-0001"})
+		fmt.Fprint(w, `[{"ghsa_id": "GHSA-xxxx-xxxx-xxxx", "cve_id": "CVE-2021-0001"}]`)
+	})
+
+	opts := &ListGlobalSecurityAdvisoriesOptions{CVEID: "CVE-2021-0001"}
+	ctx := context.Background()
+	advisories, _, err := client.SecurityAdvisories.ListGlobalSecurityAdvisories(ctx, opts)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListGlobalSecurityAdvisories returned error: %v", err)
+	}
+
+	want := []*GlobalSecurityAdvisory{{
+		GHSAID: String("GHSA-xxxx-xxxx-xxxx"),
+		CVEID:  String("CVE-2021-0001"),
+	}}
+	if !cmp.Equal(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListGlobalSecurityAdvisories returned %+v, want %+v", advisories, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_ListRepositorySecurityAdvisories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "draft", "sort": "updated"})
+		fmt.Fprint(w, `[{"ghsa_id": "GHSA-xxxx-xxxx-xxxx", "state": "draft"}]`)
+	})
+
+	opts := &ListRepositorySecurityAdvisoriesOptions{State: "draft", Sort: "updated"}
+	ctx := context.Background()
+	advisories, _, err := client.SecurityAdvisories.ListRepositorySecurityAdvisories(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisories returned error: %v", err)
+	}
+
+	want := []*SecurityAdvisory{{GHSAID: String("GHSA-xxxx-xxxx-xxxx"), State: String("draft")}}
+	if !cmp.Equal(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisories returned %+v, want %+v", advisories, want)
+	}
+
+	const methodName = "ListRepositorySecurityAdvisories"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.ListRepositorySecurityAdvisories(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.ListRepositorySecurityAdvisories(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_GetRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-xxxx-xxxx-xxxx", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ghsa_id": "GHSA-xxxx-xxxx-xxxx", "cve_id": "CVE-2021-0001", "state": "published"}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.GetRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-xxxx-xxxx-xxxx")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.GetRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{GHSAID: String("GHSA-xxxx-xxxx-xxxx"), CVEID: String("CVE-2021-0001"), State: String("published")}
+	if !cmp.Equal(advisory, want) {
+		t.Errorf("SecurityAdvisories.GetRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "GetRepositorySecurityAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.GetRepositorySecurityAdvisory(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.GetRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-xxxx-xxxx-xxxx")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_CreateRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SecurityAdvisoryRequest{
+		Summary:     String("s"),
+		Description: String("d"),
+		Severity:    String("high"),
+		Vulnerabilities: []*AdvisoryVulnerability{{
+			Package:                &VulnerabilityPackage{Ecosystem: String("go"), Name: String("example.com/m")},
+			VulnerableVersionRange: String("< 1.2.3"),
+			PatchedVersions:        String("1.2.3"),
+		}},
+		CWEIDs: []string{"CWE-400"},
+	}
+
+	mux.HandleFunc("/repos/o/r/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"summary":"s","description":"d","severity":"high","vulnerabilities":[{"package":{"ecosystem":"go","name":"example.com/m"},"vulnerable_version_range":"< 1.2.3","patched_versions":"1.2.3"}],"cwe_ids":["CWE-400"]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"ghsa_id": "GHSA-xxxx-xxxx-xxxx",
+			"summary": "s",
+			"description": "d",
+			"severity": "high",
+			"state": "draft",
+			"vulnerabilities": [{
+				"package": {"ecosystem": "go", "name": "example.com/m"},
+				"vulnerable_version_range": "< 1.2.3",
+				"patched_versions": "1.2.3"
+			}],
+			"cwe_ids": ["CWE-400"]
+		}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.CreateRepositorySecurityAdvisory(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.CreateRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{
+		GHSAID:          String("GHSA-xxxx-xxxx-xxxx"),
+		Summary:         String("s"),
+		Description:     String("d"),
+		Severity:        String("high"),
+		State:           String("draft"),
+		Vulnerabilities: input.Vulnerabilities,
+		CWEIDs:          []string{"CWE-400"},
+	}
+	if !cmp.Equal(advisory, want) {
+		t.Errorf("SecurityAdvisories.CreateRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "CreateRepositorySecurityAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.CreateRepositorySecurityAdvisory(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.CreateRepositorySecurityAdvisory(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_UpdateRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SecurityAdvisoryRequest{State: String("published")}
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-xxxx-xxxx-xxxx", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"published"}`+"\n")
+		fmt.Fprint(w, `{"ghsa_id": "GHSA-xxxx-xxxx-xxxx", "state": "published"}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.UpdateRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-xxxx-xxxx-xxxx", input)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.UpdateRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{GHSAID: String("GHSA-xxxx-xxxx-xxxx"), State: String("published")}
+	if !cmp.Equal(advisory, want) {
+		t.Errorf("SecurityAdvisories.UpdateRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "UpdateRepositorySecurityAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.UpdateRepositorySecurityAdvisory(ctx, "\n", "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.UpdateRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-xxxx-xxxx-xxxx", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_RequestCVE(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-xxxx-xxxx-xxxx/cve", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	resp, err := client.SecurityAdvisories.RequestCVE(ctx, "o", "r", "GHSA-xxxx-xxxx-xxxx")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.RequestCVE returned error: %v", err)
+	}
+	if got, want := resp.StatusCode, http.StatusAccepted; got != want {
+		t.Errorf("SecurityAdvisories.RequestCVE returned status %d, want %d", got, want)
+	}
+
+	const methodName = "RequestCVE"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.SecurityAdvisories.RequestCVE(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.SecurityAdvisories.RequestCVE(ctx, "o", "r", "GHSA-xxxx-xxxx-xxxx")
+	})
+}
+
+func TestSecurityAdvisoriesService_CreateTemporaryPrivateFork(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-xxxx-xxxx-xxxx/forks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id": 1, "full_name": "o/r-ghsa-xxxx-xxxx-xxxx", "private": true}`)
+	})
+
+	ctx := context.Background()
+	fork, _, err := client.SecurityAdvisories.CreateTemporaryPrivateFork(ctx, "o", "r", "GHSA-xxxx-xxxx-xxxx")
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("SecurityAdvisories.CreateTemporaryPrivateFork returned error: %v (want AcceptedError)", err)
+	}
+
+	want := &Repository{ID: Int64(1), FullName: String("o/r-ghsa-xxxx-xxxx-xxxx"), Private: Bool(true)}
+	if !cmp.Equal(fork, want) {
+		t.Errorf("SecurityAdvisories.CreateTemporaryPrivateFork returned %+v, want %+v", fork, want)
+	}
+
+	const methodName = "CreateTemporaryPrivateFork"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.CreateTemporaryPrivateFork(ctx, "\n", "\n", "\n")
+		return err
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// stringOrObject decodes a JSON value that the GitHub API returns either as a
+// string or as an object, depending on the endpoint or the event it is part
+// of. Fields known to do so are decoded through it by the UnmarshalJSON
+// method of their struct, which keeps the field's exported type.
+//
+// At most one of text and object is set after unmarshaling; neither is for
+// null.
+type stringOrObject struct {
+	text   *string
+	object json.RawMessage
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *stringOrObject) UnmarshalJSON(data []byte) error {
+	s.text, s.object = nil, nil
+
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		return nil
+	case len(data) > 0 && data[0] == '"':
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		s.text = &text
+	default:
+		if !json.Valid(data) {
+			return errors.New("invalid JSON value, want a string or an object")
+		}
+		s.object = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
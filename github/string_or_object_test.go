@@ -0,0 +1,36 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStringOrObject_Unmarshal(t *testing.T) {
+	tests := []struct {
+		data       string
+		wantText   *string
+		wantObject json.RawMessage
+	}{
+		{`"text"`, String("text"), nil},
+		{`""`, String(""), nil},
+		{`{"a":1}`, nil, json.RawMessage(`{"a":1}`)},
+		{`null`, nil, nil},
+	}
+
+	for _, tt := range tests {
+		got := new(stringOrObject)
+		if err := json.Unmarshal([]byte(tt.data), got); err != nil {
+			t.Errorf("json.Unmarshal(%s) returned error: %v", tt.data, err)
+		}
+		if !cmp.Equal(got.text, tt.wantText) || !cmp.Equal(got.object, tt.wantObject) {
+			t.Errorf("json.Unmarshal(%s) = {%v %s}, want {%v %s}", tt.data, got.text, got.object, tt.wantText, tt.wantObject)
+		}
+	}
+}
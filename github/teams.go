@@ -450,8 +450,84 @@ func (s *TeamsService) IsTeamRepoBySlug(ctx context.Context, org, slug, owner, r
 	return repository, resp, nil
 }
 
+// TeamRepoPermission is the permission level a team has on a repository, as
+// returned by TeamsService.CheckTeamRepoPermissionByID and
+// TeamsService.CheckTeamRepoPermissionBySlug.
+type TeamRepoPermission string
+
+// This is the set of permission levels a team can be granted on a
+// repository, from the least to the most privileged. Organizations with
+// custom repository roles also accept the name of such a role.
+const (
+	// TeamRepoPermissionPull lets team members pull, but not push to or
+	// administer the repository.
+	TeamRepoPermissionPull TeamRepoPermission = "pull"
+	// TeamRepoPermissionTriage lets team members proactively manage issues
+	// and pull requests without write access.
+	TeamRepoPermissionTriage TeamRepoPermission = "triage"
+	// TeamRepoPermissionPush lets team members pull and push, but not
+	// administer the repository.
+	TeamRepoPermissionPush TeamRepoPermission = "push"
+	// TeamRepoPermissionMaintain lets team members manage the repository
+	// without access to sensitive or destructive actions.
+	TeamRepoPermissionMaintain TeamRepoPermission = "maintain"
+	// TeamRepoPermissionAdmin lets team members pull, push and administer
+	// the repository.
+	TeamRepoPermissionAdmin TeamRepoPermission = "admin"
+)
+
+// teamRepoPermissionsByRank lists the permission levels from the most to the
+// least privileged.
+var teamRepoPermissionsByRank = []TeamRepoPermission{
+	TeamRepoPermissionAdmin,
+	TeamRepoPermissionMaintain,
+	TeamRepoPermissionPush,
+	TeamRepoPermissionTriage,
+	TeamRepoPermissionPull,
+}
+
+// CheckTeamRepoPermissionByID returns the permission level a team, given its
+// ID, has on the specified repository. The highest level is returned when
+// the team has several, and an empty level with a nil error when the team
+// does not manage the repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/teams/#check-team-permissions-for-a-repository
+func (s *TeamsService) CheckTeamRepoPermissionByID(ctx context.Context, orgID, teamID int64, owner, repo string) (TeamRepoPermission, *Response, error) {
+	repository, resp, err := s.IsTeamRepoByID(ctx, orgID, teamID, owner, repo)
+	return teamRepoPermission(repository, resp, err)
+}
+
+// CheckTeamRepoPermissionBySlug returns the permission level a team, given
+// its slug, has on the specified repository. The highest level is returned
+// when the team has several, and an empty level with a nil error when the
+// team does not manage the repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/teams/#check-team-permissions-for-a-repository
+func (s *TeamsService) CheckTeamRepoPermissionBySlug(ctx context.Context, org, slug, owner, repo string) (TeamRepoPermission, *Response, error) {
+	repository, resp, err := s.IsTeamRepoBySlug(ctx, org, slug, owner, repo)
+	return teamRepoPermission(repository, resp, err)
+}
+
+// teamRepoPermission returns the highest permission level set in the
+// permissions of repository, as returned by IsTeamRepoByID or
+// IsTeamRepoBySlug.
+func teamRepoPermission(repository *Repository, resp *Response, err error) (TeamRepoPermission, *Response, error) {
+	if err != nil {
+		// A 404 means the team does not manage the repository.
+		_, err = parseBoolResponse(err)
+		return "", resp, err
+	}
+
+	for _, p := range teamRepoPermissionsByRank {
+		if repository.Permissions[string(p)] {
+			return p, resp, nil
+		}
+	}
+	return "", resp, nil
+}
+
 // TeamAddTeamRepoOptions specifies the optional parameters to the
-// TeamsService.AddTeamRepo method.
+// TeamsService.AddTeamRepoByID and TeamsService.AddTeamRepoBySlug methods.
 type TeamAddTeamRepoOptions struct {
 	// Permission specifies the permission to grant the team on this repository.
 	// Possible values are:
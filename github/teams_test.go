@@ -860,6 +860,75 @@ func TestTeamsService_AddTeamRepoBySlug(t *testing.T) {
 	})
 }
 
+func TestTeamsService_AddTeamRepoBySlug_maintain(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org/teams/slug/repos/owner/repo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"permission":"maintain"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	opt := &TeamAddTeamRepoOptions{Permission: "maintain"}
+	if _, err := client.Teams.AddTeamRepoBySlug(ctx, "org", "slug", "owner", "repo", opt); err != nil {
+		t.Errorf("Teams.AddTeamRepoBySlug returned error: %v", err)
+	}
+}
+
+func TestTeamsService_CheckTeamRepoPermissionBySlug(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org/teams/slug/repos/owner/repo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeOrgPermissionRepo)
+		fmt.Fprint(w, `{"id":1,"permissions":{"admin":false,"maintain":true,"push":true,"triage":true,"pull":true}}`)
+	})
+
+	ctx := context.Background()
+	permission, _, err := client.Teams.CheckTeamRepoPermissionBySlug(ctx, "org", "slug", "owner", "repo")
+	if err != nil {
+		t.Errorf("Teams.CheckTeamRepoPermissionBySlug returned error: %v", err)
+	}
+	if want := TeamRepoPermissionMaintain; permission != want {
+		t.Errorf("Teams.CheckTeamRepoPermissionBySlug returned %v, want %v", permission, want)
+	}
+
+	const methodName = "CheckTeamRepoPermissionBySlug"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Teams.CheckTeamRepoPermissionBySlug(ctx, "\n", "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Teams.CheckTeamRepoPermissionBySlug(ctx, "org", "slug", "owner", "repo")
+		if got != "" {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want empty", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestTeamsService_CheckTeamRepoPermissionByID_notManaged(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/organizations/1/team/1/repos/owner/repo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	permission, _, err := client.Teams.CheckTeamRepoPermissionByID(ctx, 1, 1, "owner", "repo")
+	if err != nil {
+		t.Errorf("Teams.CheckTeamRepoPermissionByID returned error: %v", err)
+	}
+	if permission != "" {
+		t.Errorf("Teams.CheckTeamRepoPermissionByID returned %v, want empty", permission)
+	}
+}
 func TestTeamsService_AddTeamRepoByID_noAccess(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -17,6 +17,11 @@ import (
 type UsersService service
 
 // User represents a GitHub user.
+//
+// The private fields TotalPrivateRepos, OwnedPrivateRepos, PrivateGists,
+// DiskUsage, Collaborators, TwoFactorAuthentication and Plan are only
+// populated for the authenticated user, as returned by UsersService.Get with
+// an empty user.
 type User struct {
 	Login                   *string    `json:"login,omitempty"`
 	ID                      *int64     `json:"id,omitempty"`
@@ -69,6 +74,11 @@ type User struct {
 	// Permissions identifies the permissions that a user has on a given
 	// repository. This is only populated when calling Repositories.ListCollaborators.
 	Permissions map[string]bool `json:"permissions,omitempty"`
+
+	// RoleName identifies the role of a user within an organization. This is
+	// only populated when calling Organizations.ListMembers, see
+	// ListMembersOptions.IncludeRole.
+	RoleName *string `json:"role_name,omitempty"`
 }
 
 func (u User) String() string {
@@ -76,7 +86,8 @@ func (u User) String() string {
 }
 
 // Get fetches a user. Passing the empty string will fetch the authenticated
-// user.
+// user, including its private fields such as Plan and
+// TwoFactorAuthentication.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#get-the-authenticated-user
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#get-a-user
@@ -103,6 +114,9 @@ func (s *UsersService) Get(ctx context.Context, user string) (*User, *Response,
 
 // GetByID fetches a user.
 //
+// The id is the database ID of the user, its ID field, and not its node ID;
+// use ParseNodeID to get the database ID from a node ID.
+//
 // Note: GetByID uses the undocumented GitHub API endpoint /user/:id.
 func (s *UsersService) GetByID(ctx context.Context, id int64) (*User, *Response, error) {
 	u := fmt.Sprintf("user/%d", id)
@@ -144,10 +158,10 @@ func (s *UsersService) Edit(ctx context.Context, user *User) (*User, *Response,
 type HovercardOptions struct {
 	// SubjectType specifies the additional information to be received about the hovercard.
 	// Possible values are: organization, repository, issue, pull_request. (Required when using subject_id.)
-	SubjectType string `url:"subject_type"`
+	SubjectType string `url:"subject_type,omitempty"`
 
 	// SubjectID specifies the ID for the SubjectType. (Required when using subject_type.)
-	SubjectID string `url:"subject_id"`
+	SubjectID string `url:"subject_id,omitempty"`
 }
 
 // Hovercard represents hovercard information about a user.
@@ -0,0 +1,63 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// GetMany fetches the users with the given logins, calling Get for each
+// distinct login with at most concurrency requests in flight, or
+// defaultConcurrentRequests if concurrency is not positive.
+//
+// The users that could be fetched are returned keyed by login, and the
+// errors encountered for the others, such as an *ErrorResponse for logins
+// that don't exist, are keyed by login as well. An empty login is reported
+// as an error rather than fetching the authenticated user. Once GitHub
+// reports that the rate limit is exceeded, no further requests are made and
+// the remaining logins fail with the same rate limit error.
+func (s *UsersService) GetMany(ctx context.Context, logins []string, concurrency int) (map[string]*User, map[string]error) {
+	var (
+		mu    sync.Mutex
+		users = make(map[string]*User)
+		errs  = make(map[string]error)
+	)
+
+	seen := make(map[string]bool)
+	var distinct []string
+	for _, login := range logins {
+		if seen[login] {
+			continue
+		}
+		seen[login] = true
+		if login == "" {
+			errs[login] = errors.New("empty login")
+			continue
+		}
+		distinct = append(distinct, login)
+	}
+
+	if concurrency <= 0 {
+		concurrency = defaultConcurrentRequests
+	}
+	failed := runBounded(ctx, len(distinct), concurrency, func(i int) error {
+		user, _, err := s.Get(ctx, distinct[i])
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		users[distinct[i]] = user
+		mu.Unlock()
+		return nil
+	})
+	for i, err := range failed {
+		errs[distinct[i]] = err
+	}
+
+	return users, errs
+}
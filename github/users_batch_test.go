@@ -0,0 +1,135 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUsersService_GetMany(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var (
+		mu                sync.Mutex
+		calls             = make(map[string]int)
+		inFlight, maxSeen int
+	)
+	logins := make([]string, 10)
+	for i := range logins {
+		login := fmt.Sprintf("u%d", i)
+		logins[i] = login
+		mux.HandleFunc("/users/"+login, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+
+			mu.Lock()
+			calls[login]++
+			inFlight++
+			if inFlight > maxSeen {
+				maxSeen = inFlight
+			}
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+
+			fmt.Fprintf(w, `{"login":%q}`, login)
+		})
+	}
+
+	ctx := context.Background()
+	const concurrency = 3
+	users, errs := client.Users.GetMany(ctx, append(logins, logins...), concurrency)
+	if len(errs) != 0 {
+		t.Errorf("Users.GetMany returned errors: %v", errs)
+	}
+
+	want := make(map[string]*User)
+	for _, login := range logins {
+		want[login] = &User{Login: String(login)}
+		if calls[login] != 1 {
+			t.Errorf("Users.GetMany fetched %v %d times, want once", login, calls[login])
+		}
+	}
+	if !cmp.Equal(users, want) {
+		t.Errorf("Users.GetMany returned %+v, want %+v", users, want)
+	}
+	if maxSeen > concurrency {
+		t.Errorf("Users.GetMany made %d concurrent requests, want at most %d", maxSeen, concurrency)
+	}
+}
+
+func TestUsersService_GetMany_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/a", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"login":"a"}`)
+	})
+	mux.HandleFunc("/users/missing", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	users, errs := client.Users.GetMany(ctx, []string{"a", "missing", ""}, 0)
+
+	if want := map[string]*User{"a": {Login: String("a")}}; !cmp.Equal(users, want) {
+		t.Errorf("Users.GetMany returned %+v, want %+v", users, want)
+	}
+	if len(errs) != 2 {
+		t.Errorf("Users.GetMany returned errors %v, want 2", errs)
+	}
+	if err, ok := errs["missing"].(*ErrorResponse); !ok || err.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("Users.GetMany returned error %v for missing, want a 404 *ErrorResponse", errs["missing"])
+	}
+	if errs[""] == nil {
+		t.Error("Users.GetMany returned no error for an empty login")
+	}
+}
+
+func TestUsersService_GetMany_rateLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	mux.HandleFunc("/users/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"API rate limit exceeded for xxx.xxx.xxx.xxx."}`)
+	})
+
+	ctx := context.Background()
+	users, errs := client.Users.GetMany(ctx, []string{"a", "b", "c"}, 1)
+	if len(users) != 0 {
+		t.Errorf("Users.GetMany returned %+v, want none", users)
+	}
+	for _, login := range []string{"a", "b", "c"} {
+		if _, ok := errs[login].(*RateLimitError); !ok {
+			t.Errorf("Users.GetMany returned error %v for %v, want *RateLimitError", errs[login], login)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Users.GetMany made %d requests, want 1", calls)
+	}
+}
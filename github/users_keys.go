@@ -8,6 +8,8 @@ package github
 import (
 	"context"
 	"fmt"
+
+	"golang.org/x/crypto/ssh"
 )
 
 // Key represents a public SSH key used to authenticate a user or deploy script.
@@ -19,12 +21,34 @@ type Key struct {
 	ReadOnly  *bool      `json:"read_only,omitempty"`
 	Verified  *bool      `json:"verified,omitempty"`
 	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	AddedBy   *string    `json:"added_by,omitempty"`
+	LastUsed  *Timestamp `json:"last_used,omitempty"`
 }
 
 func (k Key) String() string {
 	return Stringify(k)
 }
 
+// Fingerprint returns the SHA256 fingerprint of the key in the format used by
+// OpenSSH and the GitHub audit log, e.g. "SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8".
+// It returns an error if the key is not a valid OpenSSH public key.
+func (k *Key) Fingerprint() (string, error) {
+	pub, err := parseSSHKey(k.GetKey())
+	if err != nil {
+		return "", err
+	}
+	return ssh.FingerprintSHA256(pub), nil
+}
+
+// parseSSHKey parses key as a public key in the OpenSSH authorized_keys format.
+func parseSSHKey(key string) (ssh.PublicKey, error) {
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OpenSSH public key: %v", err)
+	}
+	return pub, nil
+}
+
 // ListKeys lists the verified public keys for a user. Passing the empty
 // string will fetch keys for the authenticated user.
 //
@@ -15,6 +15,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 )
 
+const testSSHKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFTnAgbPiLHwVJNo9hqNFyKQKOSJ3VjodCdPu9JodtF8"
+
 func TestUsersService_ListKeys_authenticatedUser(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -180,3 +182,19 @@ func TestUsersService_DeleteKey(t *testing.T) {
 		return client.Users.DeleteKey(ctx, 1)
 	})
 }
+
+func TestKey_Fingerprint(t *testing.T) {
+	k := &Key{Key: String(testSSHKey + " octocat@example.com")}
+	got, err := k.Fingerprint()
+	if err != nil {
+		t.Fatalf("Key.Fingerprint returned error: %v", err)
+	}
+	if want := "SHA256:DMQhrq/CvvSELfTseMseUIzpaxQKQ1BTXC9IJTUhr6I"; got != want {
+		t.Errorf("Key.Fingerprint = %v, want %v", got, want)
+	}
+
+	k = &Key{Key: String("ssh-rsa not-a-key")}
+	if _, err := k.Fingerprint(); err == nil {
+		t.Error("Key.Fingerprint returned nil error for malformed key, want error")
+	}
+}
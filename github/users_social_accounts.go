@@ -0,0 +1,102 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// SocialAccount represents a social account linked to a user's profile.
+type SocialAccount struct {
+	Provider *string `json:"provider,omitempty"`
+	URL      *string `json:"url,omitempty"`
+}
+
+// socialAccountsRequest is used internally by AddSocialAccounts and
+// DeleteSocialAccounts to pass the URLs of the accounts.
+type socialAccountsRequest struct {
+	AccountURLs []string `json:"account_urls"`
+}
+
+// ListSocialAccounts lists all social accounts for the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users#list-social-accounts-for-the-authenticated-user
+func (s *UsersService) ListSocialAccounts(ctx context.Context, opts *ListOptions) ([]*SocialAccount, *Response, error) {
+	u, err := addOptions("user/social_accounts", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var accounts []*SocialAccount
+	resp, err := s.client.Do(ctx, req, &accounts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return accounts, resp, nil
+}
+
+// AddSocialAccounts adds social accounts, given by their URLs, to the
+// authenticated user's profile.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users#add-social-accounts-for-the-authenticated-user
+func (s *UsersService) AddSocialAccounts(ctx context.Context, accountURLs []string) ([]*SocialAccount, *Response, error) {
+	req, err := s.client.NewRequest("POST", "user/social_accounts", &socialAccountsRequest{AccountURLs: accountURLs})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var accounts []*SocialAccount
+	resp, err := s.client.Do(ctx, req, &accounts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return accounts, resp, nil
+}
+
+// DeleteSocialAccounts deletes social accounts, given by their URLs, from the
+// authenticated user's profile.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users#delete-social-accounts-for-the-authenticated-user
+func (s *UsersService) DeleteSocialAccounts(ctx context.Context, accountURLs []string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", "user/social_accounts", &socialAccountsRequest{AccountURLs: accountURLs})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListUserSocialAccounts lists the public social accounts of the specified user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users#list-social-accounts-for-a-user
+func (s *UsersService) ListUserSocialAccounts(ctx context.Context, username string, opts *ListOptions) ([]*SocialAccount, *Response, error) {
+	u := fmt.Sprintf("users/%v/social_accounts", username)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var accounts []*SocialAccount
+	resp, err := s.client.Do(ctx, req, &accounts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return accounts, resp, nil
+}
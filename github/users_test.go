@@ -96,6 +96,57 @@ func TestUsersService_Get_authenticatedUser(t *testing.T) {
 	})
 }
 
+func TestUsersService_Get_authenticatedUserPrivateFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"login": "octocat",
+			"id": 1,
+			"private_gists": 81,
+			"total_private_repos": 100,
+			"owned_private_repos": 100,
+			"disk_usage": 10000,
+			"collaborators": 8,
+			"two_factor_authentication": true,
+			"plan": {
+				"name": "Medium",
+				"space": 400,
+				"private_repos": 20,
+				"collaborators": 0
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	user, _, err := client.Users.Get(ctx, "")
+	if err != nil {
+		t.Fatalf("Users.Get returned error: %v", err)
+	}
+
+	want := &User{
+		Login:                   String("octocat"),
+		ID:                      Int64(1),
+		PrivateGists:            Int(81),
+		TotalPrivateRepos:       Int(100),
+		OwnedPrivateRepos:       Int(100),
+		DiskUsage:               Int(10000),
+		Collaborators:           Int(8),
+		TwoFactorAuthentication: Bool(true),
+		Plan: &Plan{
+			Name:          String("Medium"),
+			Space:         Int(400),
+			PrivateRepos:  Int(20),
+			Collaborators: Int(0),
+		},
+	}
+	if !cmp.Equal(user, want) {
+		t.Errorf("Users.Get returned %+v, want %+v", user, want)
+	}
+}
+
 func TestUsersService_Get_specifiedUser(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -237,6 +288,30 @@ func TestUsersService_GetHovercard(t *testing.T) {
 	})
 }
 
+func TestUsersService_GetHovercard_noSubject(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/hovercard", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.RawQuery != "" {
+			t.Errorf("Request query = %q, want none", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"contexts": [{"message":"Member of @o", "octicon": "organization"}]}`)
+	})
+
+	ctx := context.Background()
+	hovercard, _, err := client.Users.GetHovercard(ctx, "u", &HovercardOptions{})
+	if err != nil {
+		t.Errorf("Users.GetHovercard returned error: %v", err)
+	}
+
+	want := &Hovercard{Contexts: []*UserContext{{Message: String("Member of @o"), Octicon: String("organization")}}}
+	if !cmp.Equal(hovercard, want) {
+		t.Errorf("Users.GetHovercard returned %+v, want %+v", hovercard, want)
+	}
+}
+
 func TestUsersService_ListAll(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()